@@ -17,6 +17,7 @@ import (
 	_ "github.com/wader/fq/format/bzip2"
 	_ "github.com/wader/fq/format/caff"
 	_ "github.com/wader/fq/format/cbor"
+	_ "github.com/wader/fq/format/compress"
 	_ "github.com/wader/fq/format/crypto"
 	_ "github.com/wader/fq/format/csv"
 	_ "github.com/wader/fq/format/dns"
@@ -26,13 +27,16 @@ import (
 	_ "github.com/wader/fq/format/flac"
 	_ "github.com/wader/fq/format/gif"
 	_ "github.com/wader/fq/format/gzip"
+	_ "github.com/wader/fq/format/http"
 	_ "github.com/wader/fq/format/icc"
 	_ "github.com/wader/fq/format/id3"
 	_ "github.com/wader/fq/format/inet"
 	_ "github.com/wader/fq/format/jpeg"
 	_ "github.com/wader/fq/format/json"
+	_ "github.com/wader/fq/format/kaitai"
 	_ "github.com/wader/fq/format/leveldb"
 	_ "github.com/wader/fq/format/luajit"
+	_ "github.com/wader/fq/format/lz4"
 	_ "github.com/wader/fq/format/markdown"
 	_ "github.com/wader/fq/format/math"
 	_ "github.com/wader/fq/format/matroska"
@@ -48,6 +52,7 @@ import (
 	_ "github.com/wader/fq/format/opentimestamps"
 	_ "github.com/wader/fq/format/opus"
 	_ "github.com/wader/fq/format/pcap"
+	_ "github.com/wader/fq/format/pe"
 	_ "github.com/wader/fq/format/png"
 	_ "github.com/wader/fq/format/postgres"
 	_ "github.com/wader/fq/format/prores"
@@ -66,6 +71,8 @@ import (
 	_ "github.com/wader/fq/format/vpx"
 	_ "github.com/wader/fq/format/wasm"
 	_ "github.com/wader/fq/format/xml"
+	_ "github.com/wader/fq/format/xz"
 	_ "github.com/wader/fq/format/yaml"
 	_ "github.com/wader/fq/format/zip"
+	_ "github.com/wader/fq/format/zstd"
 )
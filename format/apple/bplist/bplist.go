@@ -23,6 +23,7 @@ func init() {
 			ProbeOrder:  format.ProbeOrderBinUnique,
 			Description: "Apple Binary Property List",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("bplist00")}},
 			DecodeFn:    bplistDecode,
 			Functions:   []string{"torepr"},
 		})
@@ -105,7 +106,16 @@ func decodeItem(d *decode.D, p *plist) bool {
 			boolTrue:  scalar.Uint{Sym: true},
 			boolFalse: scalar.Uint{Sym: false},
 		})
-	case elementTypeInt, elementTypeUID:
+	case elementTypeUID:
+		// uid size is number of bytes minus one
+		n := d.FieldUintFn("size", func(d *decode.D) uint64 {
+			return d.U4() + 1
+		})
+		if n > 8 {
+			d.Errorf("invalid uid size %d", n)
+		}
+		d.FieldU("value", int(n*8))
+	case elementTypeInt:
 		n := d.FieldUintFn("size", func(d *decode.D) uint64 {
 			return 1 << d.U4()
 		})
@@ -143,7 +153,8 @@ func decodeItem(d *decode.D, p *plist) bool {
 	case elementTypeUnicodeString:
 		n := decodeSize(d)
 		d.FieldValueUint("size", n)
-		d.FieldUTF16BE("value", int(n))
+		// size is number of UTF-16 code units
+		d.FieldUTF16BE("value", int(n*2))
 		return true
 	case elementTypeArray:
 		n := decodeSize(d)
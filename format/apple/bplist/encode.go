@@ -0,0 +1,339 @@
+package bplist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/bits"
+	"sort"
+	"time"
+	"unicode/utf16"
+
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	interp.RegisterFunc0("to_bplist", toBplist)
+}
+
+// UIDs and dates have no jq type so they are represented as {"cfuid": number}
+// and {"cfdate": number or string}, date number is seconds since cocoa epoch
+// same as torepr and string is RFC3339.
+func plistUID(v map[string]any) (uint64, bool, error) {
+	u, ok := v["cfuid"]
+	if !ok || len(v) != 1 {
+		return 0, false, nil
+	}
+	if jv, ok := u.(gojq.JQValue); ok {
+		u = jv.JQValueToGoJQ()
+	}
+	switch u := u.(type) {
+	case int:
+		if u >= 0 {
+			return uint64(u), true, nil
+		}
+	case *big.Int:
+		if u.IsUint64() {
+			return u.Uint64(), true, nil
+		}
+	case float64:
+		if u >= 0 && u <= math.MaxUint32 && u == math.Trunc(u) {
+			return uint64(u), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("cfuid should be a positive integer")
+}
+
+func plistDate(v map[string]any) (float64, bool, error) {
+	d, ok := v["cfdate"]
+	if !ok || len(v) != 1 {
+		return 0, false, nil
+	}
+	if jv, ok := d.(gojq.JQValue); ok {
+		d = jv.JQValueToGoJQ()
+	}
+	switch d := d.(type) {
+	case int:
+		return float64(d), true, nil
+	case float64:
+		return d, true, nil
+	case string:
+		t, err := time.Parse(time.RFC3339, d)
+		if err != nil {
+			return 0, false, err
+		}
+		return cocoaSecondsFromTime(t), true, nil
+	}
+	return 0, false, fmt.Errorf("cfdate should be a number or a RFC3339 string")
+}
+
+// done using seconds as time.Duration overflows for dates about 292 years from epoch
+func cocoaSecondsFromTime(t time.Time) float64 {
+	return float64(t.Unix()-cocoaTimeEpochDate.Unix()) + float64(t.Nanosecond())/float64(time.Second)
+}
+
+var (
+	cocoaSecondsMin = cocoaSecondsFromTime(time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC))
+	cocoaSecondsMax = cocoaSecondsFromTime(time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC))
+)
+
+// limited to years 0-9999 as that is what can be formatted as a RFC3339 date
+func cocoaSecondsToTime(s float64) (time.Time, error) {
+	if math.IsNaN(s) || s < cocoaSecondsMin || s > cocoaSecondsMax {
+		return time.Time{}, fmt.Errorf("cfdate %v out of range", s)
+	}
+	sec, frac := math.Modf(s)
+	return time.Unix(cocoaTimeEpochDate.Unix()+int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+}
+
+func toBytes(v any) ([]byte, error) {
+	br, err := interp.ToBitReader(v)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(bitio.NewIOReader(br))
+}
+
+func sortedKeys(v map[string]any) []string {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type bplistObject struct {
+	b    []byte // marker, size and value for non-containers
+	refs []int
+}
+
+type bplistEncoder struct {
+	objs    []bplistObject
+	uniques map[string]int
+}
+
+// number of bytes needed for n, 1, 2, 4 or 8
+func bplistUintSize(n uint64) int {
+	switch {
+	case n <= math.MaxUint8:
+		return 1
+	case n <= math.MaxUint16:
+		return 2
+	case n <= math.MaxUint32:
+		return 4
+	default:
+		return 8
+	}
+}
+
+func appendUintN(b []byte, n uint64, size int) []byte {
+	for i := size - 1; i >= 0; i-- {
+		b = append(b, byte(n>>(i*8)))
+	}
+	return b
+}
+
+func appendBplistInt(b []byte, v *big.Int) ([]byte, error) {
+	switch {
+	case v.Sign() >= 0 && v.IsUint64() && v.Uint64() <= math.MaxInt64:
+		n := v.Uint64()
+		size := bplistUintSize(n)
+		b = append(b, elementTypeInt<<4|byte(bits.TrailingZeros(uint(size))))
+		return appendUintN(b, n, size), nil
+	case v.IsInt64():
+		// negative is always 8 bytes
+		b = append(b, elementTypeInt<<4|3)
+		return binary.BigEndian.AppendUint64(b, uint64(v.Int64())), nil
+	case v.Sign() >= 0 && v.BitLen() <= 127, v.Sign() < 0 && new(big.Int).Not(v).BitLen() <= 127:
+		// 16 byte two's complement
+		n := new(big.Int).Set(v)
+		if v.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), 128))
+		}
+		b = append(b, elementTypeInt<<4|4)
+		return append(b, n.FillBytes(make([]byte, 16))...), nil
+	default:
+		return nil, fmt.Errorf("integer %s does not fit in 128 bits", v)
+	}
+}
+
+// marker with size in low 4 bits or 0xf followed by a int object
+func appendBplistMarker(b []byte, typ byte, n int) []byte {
+	if n < 0x0f {
+		return append(b, typ<<4|byte(n))
+	}
+	b = append(b, typ<<4|0x0f)
+	b, _ = appendBplistInt(b, big.NewInt(int64(n)))
+	return b
+}
+
+func appendBplistFloat(b []byte, typ byte, f float64) []byte {
+	b = append(b, typ<<4|3)
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(f))
+}
+
+func appendBplistString(b []byte, s string) []byte {
+	isASCII := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			isASCII = false
+			break
+		}
+	}
+	if isASCII {
+		b = appendBplistMarker(b, elementTypeASCIIString, len(s))
+		return append(b, s...)
+	}
+	u := utf16.Encode([]rune(s))
+	b = appendBplistMarker(b, elementTypeUnicodeString, len(u))
+	for _, c := range u {
+		b = binary.BigEndian.AppendUint16(b, c)
+	}
+	return b
+}
+
+// non-container objects are only stored once
+func (e *bplistEncoder) unique(b []byte) int {
+	if i, ok := e.uniques[string(b)]; ok {
+		return i
+	}
+	i := len(e.objs)
+	e.objs = append(e.objs, bplistObject{b: b})
+	e.uniques[string(b)] = i
+	return i
+}
+
+// container index is allocated before children so top object is 0
+func (e *bplistEncoder) container(b []byte, fn func() ([]int, error)) (int, error) {
+	i := len(e.objs)
+	e.objs = append(e.objs, bplistObject{b: b})
+	refs, err := fn()
+	if err != nil {
+		return 0, err
+	}
+	e.objs[i].refs = refs
+	return i, nil
+}
+
+func (e *bplistEncoder) add(v any) (int, error) {
+	switch v := v.(type) {
+	case interp.Binary:
+		b, err := toBytes(v)
+		if err != nil {
+			return 0, err
+		}
+		return e.unique(append(appendBplistMarker(nil, elementTypeData, len(b)), b...)), nil
+	case gojq.JQValue:
+		return e.add(v.JQValueToGoJQ())
+	case nil:
+		return e.unique([]byte{elementTypeNullOrBoolOrFill<<4 | null}), nil
+	case bool:
+		if v {
+			return e.unique([]byte{elementTypeNullOrBoolOrFill<<4 | boolTrue}), nil
+		}
+		return e.unique([]byte{elementTypeNullOrBoolOrFill<<4 | boolFalse}), nil
+	case int:
+		b, _ := appendBplistInt(nil, big.NewInt(int64(v)))
+		return e.unique(b), nil
+	case *big.Int:
+		b, err := appendBplistInt(nil, v)
+		if err != nil {
+			return 0, err
+		}
+		return e.unique(b), nil
+	case float64:
+		return e.unique(appendBplistFloat(nil, elementTypeReal, v)), nil
+	case string:
+		return e.unique(appendBplistString(nil, v)), nil
+	case []any:
+		return e.container(appendBplistMarker(nil, elementTypeArray, len(v)), func() ([]int, error) {
+			refs := make([]int, len(v))
+			for i, ve := range v {
+				r, err := e.add(ve)
+				if err != nil {
+					return nil, err
+				}
+				refs[i] = r
+			}
+			return refs, nil
+		})
+	case map[string]any:
+		if u, ok, err := plistUID(v); err != nil {
+			return 0, err
+		} else if ok {
+			size := bplistUintSize(u)
+			// uid size is stored as number of bytes minus one
+			return e.unique(appendUintN([]byte{elementTypeUID<<4 | byte(size-1)}, u, size)), nil
+		}
+		if d, ok, err := plistDate(v); err != nil {
+			return 0, err
+		} else if ok {
+			return e.unique(appendBplistFloat(nil, elementTypeDate, d)), nil
+		}
+
+		keys := sortedKeys(v)
+		return e.container(appendBplistMarker(nil, elementTypeDict, len(v)), func() ([]int, error) {
+			// all key references followed by all value references
+			refs := make([]int, len(keys)*2)
+			for i, k := range keys {
+				refs[i] = e.unique(appendBplistString(nil, k))
+			}
+			for i, k := range keys {
+				r, err := e.add(v[k])
+				if err != nil {
+					return nil, err
+				}
+				refs[len(keys)+i] = r
+			}
+			return refs, nil
+		})
+	default:
+		return 0, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+func (e *bplistEncoder) bytes() []byte {
+	refSize := bplistUintSize(uint64(len(e.objs) - 1))
+
+	b := []byte("bplist00")
+	offsets := make([]uint64, len(e.objs))
+	for i, o := range e.objs {
+		offsets[i] = uint64(len(b))
+		b = append(b, o.b...)
+		for _, r := range o.refs {
+			b = appendUintN(b, uint64(r), refSize)
+		}
+	}
+
+	offsetTableStart := uint64(len(b))
+	offsetSize := bplistUintSize(offsetTableStart)
+	for _, o := range offsets {
+		b = appendUintN(b, o, offsetSize)
+	}
+
+	// trailer: 5 unused bytes, sort version, sizes, object count, top object and offset table start
+	b = append(b, 0, 0, 0, 0, 0, 0, byte(offsetSize), byte(refSize))
+	b = binary.BigEndian.AppendUint64(b, uint64(len(e.objs)))
+	b = binary.BigEndian.AppendUint64(b, 0)
+	b = binary.BigEndian.AppendUint64(b, offsetTableStart)
+
+	return b
+}
+
+func toBplist(_ *interp.Interp, c any) any {
+	e := &bplistEncoder{uniques: map[string]int{}}
+	if _, err := e.add(c); err != nil {
+		return err
+	}
+
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(e.bytes(), -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
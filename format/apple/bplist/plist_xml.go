@@ -0,0 +1,345 @@
+package bplist
+
+// https://www.apple.com/DTDs/PropertyList-1.0.dtd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	interp.RegisterFunc0("from_plist_xml", fromPlistXML)
+	interp.RegisterFunc0("to_plist_xml", toPlistXML)
+}
+
+const plistXMLDateLayout = "2006-01-02T15:04:05Z"
+
+type plistXMLDecoder struct {
+	d *xml.Decoder
+}
+
+// next start or end element, skips whitespace, comments, doctype etc
+func (p plistXMLDecoder) next() (xml.Token, error) {
+	for {
+		t, err := p.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement, xml.EndElement:
+			return t, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) != 0 {
+				return nil, fmt.Errorf("unexpected text %q", string(t))
+			}
+		}
+	}
+}
+
+// text content of current element
+func (p plistXMLDecoder) text() (string, error) {
+	sb := &strings.Builder{}
+	for {
+		t, err := p.d.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			return "", fmt.Errorf("unexpected element %s", t.Name.Local)
+		case xml.EndElement:
+			return sb.String(), nil
+		case xml.CharData:
+			sb.Write(t)
+		}
+	}
+}
+
+func (p plistXMLDecoder) value(se xml.StartElement) (any, error) {
+	switch se.Name.Local {
+	case "dict":
+		d := map[string]any{}
+		for {
+			t, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			kse, ok := t.(xml.StartElement)
+			if !ok {
+				break
+			}
+			if kse.Name.Local != "key" {
+				return nil, fmt.Errorf("expected key element got %s", kse.Name.Local)
+			}
+			k, err := p.text()
+			if err != nil {
+				return nil, err
+			}
+			t, err = p.next()
+			if err != nil {
+				return nil, err
+			}
+			vse, ok := t.(xml.StartElement)
+			if !ok {
+				return nil, fmt.Errorf("missing value for key %q", k)
+			}
+			v, err := p.value(vse)
+			if err != nil {
+				return nil, err
+			}
+			d[k] = v
+		}
+		// NSKeyedArchiver UIDs are dicts with a CF$UID integer
+		if u, ok := d["CF$UID"]; ok && len(d) == 1 {
+			switch u.(type) {
+			case int, *big.Int:
+				return map[string]any{"cfuid": u}, nil
+			}
+		}
+		return d, nil
+	case "array":
+		a := []any{}
+		for {
+			t, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			vse, ok := t.(xml.StartElement)
+			if !ok {
+				break
+			}
+			v, err := p.value(vse)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		return a, nil
+	}
+
+	s, err := p.text()
+	if err != nil {
+		return nil, err
+	}
+	switch se.Name.Local {
+	case "string":
+		return s, nil
+	case "integer":
+		s = strings.TrimSpace(s)
+		base := 10
+		if h, ok := strings.CutPrefix(s, "0x"); ok {
+			s, base = h, 16
+		}
+		n, ok := new(big.Int).SetString(s, base)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		if n.IsInt64() && n.Int64() >= math.MinInt && n.Int64() <= math.MaxInt {
+			return int(n.Int64()), nil
+		}
+		return n, nil
+	case "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "date":
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"cfdate": cocoaSecondsFromTime(t)}, nil
+	case "data":
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+		if err != nil {
+			return nil, err
+		}
+		return interp.NewBinaryFromBitReader(bitio.NewBitReader(b, -1), 8, 0)
+	default:
+		return nil, fmt.Errorf("unknown element %s", se.Name.Local)
+	}
+}
+
+func fromPlistXML(_ *interp.Interp, c any) any {
+	var r io.Reader
+	if s, ok := c.(string); ok {
+		r = strings.NewReader(s)
+	} else {
+		br, err := interp.ToBitReader(c)
+		if err != nil {
+			return err
+		}
+		r = bitio.NewIOReader(br)
+	}
+
+	p := plistXMLDecoder{d: xml.NewDecoder(r)}
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	se, ok := t.(xml.StartElement)
+	if !ok {
+		return errors.New("expected root element")
+	}
+	if se.Name.Local != "plist" {
+		// allow bare value without plist element
+		v, err := p.value(se)
+		if err != nil {
+			return err
+		}
+		return v
+	}
+
+	t, err = p.next()
+	if err != nil {
+		return err
+	}
+	se, ok = t.(xml.StartElement)
+	if !ok {
+		return errors.New("empty plist")
+	}
+	v, err := p.value(se)
+	if err != nil {
+		return err
+	}
+	return v
+}
+
+var plistXMLEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+type plistXMLEncoder struct {
+	sb *strings.Builder
+}
+
+func (e plistXMLEncoder) element(indent int, name string, text string) {
+	e.sb.WriteString(strings.Repeat("\t", indent))
+	fmt.Fprintf(e.sb, "<%s>%s</%s>\n", name, text, name)
+}
+
+func (e plistXMLEncoder) encode(indent int, v any) error {
+	tabs := strings.Repeat("\t", indent)
+
+	switch v := v.(type) {
+	case interp.Binary:
+		b, err := toBytes(v)
+		if err != nil {
+			return err
+		}
+		e.element(indent, "data", base64.StdEncoding.EncodeToString(b))
+	case gojq.JQValue:
+		return e.encode(indent, v.JQValueToGoJQ())
+	case nil:
+		return errors.New("null can't be represented in a XML plist")
+	case bool:
+		e.sb.WriteString(tabs)
+		if v {
+			e.sb.WriteString("<true/>\n")
+		} else {
+			e.sb.WriteString("<false/>\n")
+		}
+	case int:
+		e.element(indent, "integer", strconv.Itoa(v))
+	case *big.Int:
+		e.element(indent, "integer", v.String())
+	case float64:
+		var s string
+		switch {
+		case math.IsNaN(v):
+			s = "nan"
+		case math.IsInf(v, 1):
+			s = "+infinity"
+		case math.IsInf(v, -1):
+			s = "-infinity"
+		default:
+			// same as JSON, avoid exponent for "normal" sized numbers
+			f := byte('f')
+			if a := math.Abs(v); a != 0 && (a < 1e-6 || a >= 1e21) {
+				f = 'e'
+			}
+			s = strconv.FormatFloat(v, f, -1, 64)
+		}
+		e.element(indent, "real", s)
+	case string:
+		e.element(indent, "string", plistXMLEscaper.Replace(v))
+	case []any:
+		if len(v) == 0 {
+			e.sb.WriteString(tabs + "<array/>\n")
+			return nil
+		}
+		e.sb.WriteString(tabs + "<array>\n")
+		for _, ve := range v {
+			if err := e.encode(indent+1, ve); err != nil {
+				return err
+			}
+		}
+		e.sb.WriteString(tabs + "</array>\n")
+	case map[string]any:
+		if u, ok, err := plistUID(v); err != nil {
+			return err
+		} else if ok {
+			e.sb.WriteString(tabs + "<dict>\n")
+			e.element(indent+1, "key", "CF$UID")
+			e.element(indent+1, "integer", strconv.FormatUint(u, 10))
+			e.sb.WriteString(tabs + "</dict>\n")
+			return nil
+		}
+		if d, ok, err := plistDate(v); err != nil {
+			return err
+		} else if ok {
+			t, err := cocoaSecondsToTime(d)
+			if err != nil {
+				return err
+			}
+			e.element(indent, "date", t.Format(plistXMLDateLayout))
+			return nil
+		}
+
+		if len(v) == 0 {
+			e.sb.WriteString(tabs + "<dict/>\n")
+			return nil
+		}
+		e.sb.WriteString(tabs + "<dict>\n")
+		for _, k := range sortedKeys(v) {
+			e.element(indent+1, "key", plistXMLEscaper.Replace(k))
+			if err := e.encode(indent+1, v[k]); err != nil {
+				return err
+			}
+		}
+		e.sb.WriteString(tabs + "</dict>\n")
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	return nil
+}
+
+func toPlistXML(_ *interp.Interp, c any) any {
+	sb := &strings.Builder{}
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	sb.WriteString(`<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">` + "\n")
+	sb.WriteString(`<plist version="1.0">` + "\n")
+	if err := (plistXMLEncoder{sb: sb}).encode(0, c); err != nil {
+		return err
+	}
+	sb.WriteString("</plist>\n")
+
+	return sb.String()
+}
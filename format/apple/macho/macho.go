@@ -23,7 +23,13 @@ func init() {
 		&decode.Format{
 			Description: "Mach-O macOS executable",
 			Groups:      []*decode.Group{format.Probe},
-			DecodeFn:    machoDecode,
+			Signatures: []decode.Signature{
+				{Bytes: []byte{0xce, 0xfa, 0xed, 0xfe}},
+				{Bytes: []byte{0xcf, 0xfa, 0xed, 0xfe}},
+				{Bytes: []byte{0xfe, 0xed, 0xfa, 0xce}},
+				{Bytes: []byte{0xfe, 0xed, 0xfa, 0xcf}},
+			},
+			DecodeFn: machoDecode,
 		})
 	interp.RegisterFS(machoFS)
 }
@@ -17,6 +17,7 @@ func init() {
 		&decode.Format{
 			Description: "Fat Mach-O macOS executable (multi-architecture)",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{0xca, 0xfe, 0xba, 0xbe}}},
 			DecodeFn:    machoFatDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.MachO}, Out: &machoFormat},
@@ -15,6 +15,7 @@ func init() {
 		&decode.Format{
 			Description: "Unix archive",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("!<arch>\n")}},
 			DecodeFn:    decodeAr,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
@@ -25,6 +25,7 @@ func init() {
 		&decode.Format{
 			Description: "Avro object container file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{'O', 'b', 'j', 1}}},
 			DecodeFn:    decodeAvroOCF,
 		})
 	interp.RegisterFS(avroOcfFS)
@@ -120,7 +121,7 @@ func decodeBlockCodec(d *decode.D, dataSize int64, codec string) *bytes.Buffer {
 		// Check the checksum
 		crc32W := crc32.NewIEEE()
 		d.Copy(crc32W, bytes.NewReader(bb.Bytes()))
-		d.FieldU32("crc", d.UintValidateBytes(crc32W.Sum(nil)), scalar.UintHex)
+		d.FieldChecksumU("crc", 32, "crc32", crc32W.Sum(nil), scalar.UintHex)
 	} else {
 		// Unknown codec, just dump the compressed data.
 		d.FieldRawLen("compressed", dataSize*8, scalar.BitBufDescription(codec+" encoded"))
@@ -8,6 +8,7 @@ package bzip2
 import (
 	"compress/bzip2"
 	"encoding/binary"
+	"errors"
 	"hash/crc32"
 	"io"
 	"math/bits"
@@ -27,10 +28,12 @@ func init() {
 		&decode.Format{
 			Description: "bzip2 compression",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("BZh")}},
 			DecodeFn:    bzip2Decode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
 			},
+			SkipFromFunction: true, // from_bzip2 is defined in compress and returns uncompressed binary
 		})
 }
 
@@ -49,6 +52,29 @@ func (bfr bitFlipReader) Read(p []byte) (n int, err error) {
 	return n, err
 }
 
+// checksumIgnoreReader ends at checksum mismatch instead of failing so that
+// checksums can be validated and shown as invalid. Go's reader checks the
+// block checksum after all block data has been read but before the footer.
+type checksumIgnoreReader struct {
+	r             io.Reader
+	blockMismatch bool
+}
+
+func (cir *checksumIgnoreReader) Read(p []byte) (n int, err error) {
+	n, err = cir.r.Read(p)
+	var se bzip2.StructuralError
+	if errors.As(err, &se) {
+		switch se {
+		case "block checksum mismatch":
+			cir.blockMismatch = true
+			err = io.EOF
+		case "file checksum mismatch":
+			err = io.EOF
+		}
+	}
+	return n, err
+}
+
 func bzip2Decode(d *decode.D) any {
 	// moreStreams := true
 
@@ -110,8 +136,12 @@ func bzip2Decode(d *decode.D) any {
 
 	compressedStart := d.Pos()
 
+	cir := &checksumIgnoreReader{}
 	readCompressedSize, uncompressedBR, dv, _, _ :=
-		d.TryFieldReaderRangeFormat("uncompressed", 0, d.Len(), bzip2.NewReader, &probeGroup, format.Probe_In{})
+		d.TryFieldReaderRangeFormat("uncompressed", 0, d.Len(), func(r io.Reader) io.Reader {
+			cir.r = bzip2.NewReader(r)
+			return cir
+		}, &probeGroup, format.Probe_In{})
 	if uncompressedBR != nil {
 		if dv == nil {
 			d.FieldRootBitBuf("uncompressed", uncompressedBR)
@@ -120,14 +150,21 @@ func bzip2Decode(d *decode.D) any {
 		blockCRC32W := crc32.NewIEEE()
 		d.Copy(blockCRC32W, bitFlipReader{bitio.NewIOReader(uncompressedBR)})
 		blockCRC32N := bits.Reverse32(binary.BigEndian.Uint32(blockCRC32W.Sum(nil)))
-		_ = blockCRCValue.TryUintScalarFn(d.UintValidate(uint64(blockCRC32N)))
+		d.ValidateChecksum(blockCRCValue, "crc32_bzip2", binary.BigEndian.AppendUint32(nil, blockCRC32N))
 		streamCRCN = blockCRC32N ^ ((streamCRCN << 1) | (streamCRCN >> 31))
 
 		// HACK: bzip2.NewReader will read from start of whole buffer and then we figure out compressedSize ourself
 		// "It is important to note that none of the fields within a StreamBlock or StreamFooter are necessarily byte-aligned"
 		const footerByteSize = 10
 		compressedSize := (readCompressedSize - compressedStart) - footerByteSize*8
-		for i := 0; i < 8; i++ {
+		searchBits := int64(8)
+		if cir.blockMismatch {
+			// footer was not read and reader might have read ahead, search
+			// backwards from end for footer
+			compressedSize = min(readCompressedSize, d.Len()-footerByteSize*8) - compressedStart
+			searchBits = compressedSize
+		}
+		for i := int64(0); i < searchBits; i++ {
 			d.SeekAbs(compressedStart + compressedSize)
 			if d.PeekUintBits(48) == footerMagic {
 				break
@@ -140,8 +177,8 @@ func bzip2Decode(d *decode.D) any {
 
 		d.FieldStruct("footer", func(d *decode.D) {
 			d.FieldU48("magic", d.UintAssert(footerMagic), scalar.UintHex)
-			// TODO: crc of block crcs
-			d.FieldU32("crc", scalar.UintHex, d.UintValidate(uint64(streamCRCN)))
+			// combined crc of block crcs
+			d.FieldChecksumU("crc", 32, "bzip2_combined", binary.BigEndian.AppendUint32(nil, streamCRCN), scalar.UintHex)
 			d.FieldRawLen("padding", int64(d.ByteAlignBits()))
 		})
 	}
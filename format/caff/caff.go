@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "Live2D Cubism archive",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("CAFF")}},
 			DecodeFn:    decodeCAFF,
 			DefaultInArg: format.CAFF_In{
 				Uncompress: true,
@@ -9,15 +9,22 @@ package cbor
 import (
 	"bytes"
 	"embed"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
 	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/wader/fq/format"
+	"github.com/wader/fq/internal/gojqx"
 	"github.com/wader/fq/internal/mathx"
 	"github.com/wader/fq/pkg/bitio"
 	"github.com/wader/fq/pkg/decode"
 	"github.com/wader/fq/pkg/interp"
 	"github.com/wader/fq/pkg/scalar"
+	"github.com/wader/gojq"
 )
 
 //go:embed cbor.jq
@@ -33,6 +40,7 @@ func init() {
 			Functions:   []string{"torepr"},
 		})
 	interp.RegisterFS(cborFS)
+	interp.RegisterFunc1("_to_cbor", toCBOR)
 }
 
 type majorTypeEntry struct {
@@ -268,3 +276,192 @@ func decodeCBOR(d *decode.D) any {
 	decodeCBORValue(d)
 	return nil
 }
+
+type toCBOROpts struct {
+	// RFC 8949 4.2.1 core deterministic encoding, sorted keys and shortest floats
+	Canonical  bool
+	FloatWidth int `default:"64"`
+	// encode {tag: number, value: any} objects as tagged values
+	Tags bool
+}
+
+type cborEncoder struct {
+	opts toCBOROpts
+}
+
+func (e cborEncoder) head(w *bytes.Buffer, major byte, n uint64) {
+	major <<= 5
+	switch {
+	case n < shortCountVariable8Bit:
+		w.WriteByte(major | byte(n))
+	case n <= math.MaxUint8:
+		w.Write([]byte{major | shortCountVariable8Bit, byte(n)})
+	case n <= math.MaxUint16:
+		w.Write(binary.BigEndian.AppendUint16([]byte{major | shortCountVariable16Bit}, uint16(n)))
+	case n <= math.MaxUint32:
+		w.Write(binary.BigEndian.AppendUint32([]byte{major | shortCountVariable32Bit}, uint32(n)))
+	default:
+		w.Write(binary.BigEndian.AppendUint64([]byte{major | shortCountVariable64Bit}, n))
+	}
+}
+
+func (e cborEncoder) bigInt(w *bytes.Buffer, v *big.Int) {
+	if v.Sign() >= 0 {
+		if v.IsUint64() {
+			e.head(w, majorTypePositiveInt, v.Uint64())
+			return
+		}
+		e.head(w, majorTypeSematic, 2)
+		b := v.Bytes()
+		e.head(w, majorTypeBytes, uint64(len(b)))
+		w.Write(b)
+		return
+	}
+
+	// negative is encoded as -1-n
+	n := new(big.Int).Neg(v)
+	n.Sub(n, mathx.BigIntOne)
+	if n.IsUint64() {
+		e.head(w, majorTypeNegativeInt, n.Uint64())
+		return
+	}
+	e.head(w, majorTypeSematic, 3)
+	b := n.Bytes()
+	e.head(w, majorTypeBytes, uint64(len(b)))
+	w.Write(b)
+}
+
+func (e cborEncoder) float(w *bytes.Buffer, f float64) {
+	// NaN is encoded as quiet NaN with no payload
+	isNaN := math.IsNaN(f)
+	if e.opts.FloatWidth <= 16 {
+		f16 := mathx.NewFloat16(float32(f))
+		if isNaN {
+			f16 = 0x7e00
+		}
+		if isNaN || float64(f16.Float32()) == f {
+			w.Write(binary.BigEndian.AppendUint16([]byte{majorTypeSpecialFloat<<5 | shortCountSpecialFloat16Bit}, uint16(f16)))
+			return
+		}
+	}
+	if e.opts.FloatWidth <= 32 {
+		f32 := math.Float32bits(float32(f))
+		if isNaN {
+			f32 = 0x7fc00000
+		}
+		if isNaN || float64(float32(f)) == f {
+			w.Write(binary.BigEndian.AppendUint32([]byte{majorTypeSpecialFloat<<5 | shortCountSpecialFloat32Bit}, f32))
+			return
+		}
+	}
+	f64 := math.Float64bits(f)
+	if isNaN {
+		f64 = 0x7ff8000000000000
+	}
+	w.Write(binary.BigEndian.AppendUint64([]byte{majorTypeSpecialFloat<<5 | shortCountSpecialFloat64Bit}, f64))
+}
+
+func (e cborEncoder) encode(w *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case interp.Binary:
+		br, err := interp.ToBitReader(v)
+		if err != nil {
+			return err
+		}
+		b, err := io.ReadAll(bitio.NewIOReader(br))
+		if err != nil {
+			return err
+		}
+		e.head(w, majorTypeBytes, uint64(len(b)))
+		w.Write(b)
+	case gojq.JQValue:
+		return e.encode(w, v.JQValueToGoJQ())
+	case nil:
+		w.WriteByte(majorTypeSpecialFloat<<5 | shortCountSpecialNull)
+	case bool:
+		if v {
+			w.WriteByte(majorTypeSpecialFloat<<5 | shortCountSpecialTrue)
+		} else {
+			w.WriteByte(majorTypeSpecialFloat<<5 | shortCountSpecialFalse)
+		}
+	case int:
+		e.bigInt(w, big.NewInt(int64(v)))
+	case *big.Int:
+		e.bigInt(w, v)
+	case float64:
+		// integral numbers are encoded as integers
+		if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+			e.bigInt(w, big.NewInt(int64(v)))
+		} else {
+			e.float(w, v)
+		}
+	case string:
+		e.head(w, majorTypeUTF8, uint64(len(v)))
+		w.WriteString(v)
+	case []any:
+		e.head(w, majorTypeArray, uint64(len(v)))
+		for _, ve := range v {
+			if err := e.encode(w, ve); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		if e.opts.Tags && len(v) == 2 {
+			if tag, ok := gojqx.Cast[int](v["tag"]); ok && tag >= 0 {
+				if tv, ok := v["value"]; ok {
+					e.head(w, majorTypeSematic, uint64(tag))
+					return e.encode(w, tv)
+				}
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		if e.opts.Canonical {
+			// bytewise order of encoded text keys is shortest first
+			sort.Slice(keys, func(i, j int) bool {
+				if len(keys[i]) != len(keys[j]) {
+					return len(keys[i]) < len(keys[j])
+				}
+				return keys[i] < keys[j]
+			})
+		} else {
+			sort.Strings(keys)
+		}
+		e.head(w, majorTypeMap, uint64(len(v)))
+		for _, k := range keys {
+			e.head(w, majorTypeUTF8, uint64(len(k)))
+			w.WriteString(k)
+			if err := e.encode(w, v[k]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	return nil
+}
+
+func toCBOR(_ *interp.Interp, c any, opts toCBOROpts) any {
+	switch opts.FloatWidth {
+	case 16, 32, 64:
+	default:
+		return fmt.Errorf("float width %d should be 16, 32 or 64", opts.FloatWidth)
+	}
+	if opts.Canonical {
+		opts.FloatWidth = 16
+	}
+
+	w := &bytes.Buffer{}
+	if err := (cborEncoder{opts: opts}).encode(w, c); err != nil {
+		return err
+	}
+
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(w.Bytes(), -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
@@ -0,0 +1,289 @@
+package compress
+
+import (
+	"bytes"
+	"embed"
+	"encoding/binary"
+	"fmt"
+	"hash/adler32"
+	"hash/crc32"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/zlib"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/ulikunitz/xz"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/interp"
+)
+
+//go:embed compress.jq
+var compressFS embed.FS
+
+func init() {
+	interp.RegisterFunc1("_from_compress", fromCompress)
+	interp.RegisterFunc1("_to_compress", toCompress)
+	interp.RegisterFS(compressFS)
+}
+
+type compressOpts struct {
+	Name string
+	// -1 is default level for all compressions
+	Level      int `default:"-1"`
+	WindowBits int
+	Framed     bool
+}
+
+func newBinary(b []byte) any {
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(b, -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
+
+// gzip, bzip2, zstd, xz and lz4 are decoded using their formats, see compress.jq
+func fromCompress(_ *interp.Interp, c any, opts compressOpts) any {
+	inBR, err := interp.ToBitReader(c)
+	if err != nil {
+		return err
+	}
+	r := bitio.NewIOReader(inBR)
+
+	var dr io.Reader
+	switch opts.Name {
+	case "deflate":
+		dr = flate.NewReader(r)
+	case "zlib":
+		if dr, err = zlib.NewReader(r); err != nil {
+			return err
+		}
+	case "snappy":
+		if opts.Framed {
+			dr = snappy.NewReader(r)
+			break
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		b, err = snappy.Decode(nil, b)
+		if err != nil {
+			return err
+		}
+		return newBinary(b)
+	case "brotli":
+		dr = brotli.NewReader(r)
+	default:
+		return fmt.Errorf("unknown compression %s", opts.Name)
+	}
+
+	b, err := io.ReadAll(dr)
+	if err != nil {
+		return err
+	}
+	return newBinary(b)
+}
+
+// deflateFastWindow is true if window bits is less than 15, a fast compressor
+// with custom window size is then used and level is ignored
+func deflateFastWindow(opts compressOpts) bool {
+	return opts.WindowBits != 0 && opts.WindowBits < 15
+}
+
+func newDeflateWriter(w io.Writer, opts compressOpts) (*flate.Writer, error) {
+	if deflateFastWindow(opts) {
+		if opts.WindowBits < 8 {
+			return nil, fmt.Errorf("window bits %d not in range 8-15", opts.WindowBits)
+		}
+		return flate.NewWriterWindow(w, 1<<opts.WindowBits)
+	} else if opts.WindowBits > 15 {
+		return nil, fmt.Errorf("window bits %d not in range 8-15", opts.WindowBits)
+	}
+	return flate.NewWriter(w, opts.Level)
+}
+
+func writeDeflate(w io.Writer, b []byte, opts compressOpts) error {
+	fw, err := newDeflateWriter(w, opts)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(b); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// zlib and gzip framing is done here so that deflate window size can be set
+// https://www.rfc-editor.org/rfc/rfc1950
+func writeZlib(w *bytes.Buffer, b []byte, opts compressOpts) error {
+	windowBits := opts.WindowBits
+	if windowBits == 0 {
+		windowBits = 15
+	}
+	cmf := byte(max(0, windowBits-8)<<4 | 8)
+	// level hint in FLEVEL, fast window compressor is reported as fastest
+	var flevel byte
+	switch {
+	case deflateFastWindow(opts):
+		flevel = 0
+	case opts.Level == -1 || opts.Level == 6:
+		flevel = 2
+	case opts.Level >= 7:
+		flevel = 3
+	case opts.Level >= 2:
+		flevel = 1
+	}
+	flg := flevel << 6
+	flg += byte(31 - (uint16(cmf)<<8|uint16(flg))%31)
+	w.Write([]byte{cmf, flg})
+	if err := writeDeflate(w, b, opts); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, adler32.Checksum(b))
+}
+
+// https://www.rfc-editor.org/rfc/rfc1952
+func writeGzip(w *bytes.Buffer, b []byte, opts compressOpts) error {
+	var xfl byte
+	switch {
+	case deflateFastWindow(opts), opts.Level == flate.BestSpeed:
+		xfl = 4
+	case opts.Level == flate.BestCompression:
+		xfl = 2
+	}
+	// no flags, zero mtime and unknown OS like Go's compress/gzip
+	w.Write([]byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, xfl, 255})
+	if err := writeDeflate(w, b, opts); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, crc32.ChecksumIEEE(b)); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, uint32(len(b)))
+}
+
+var lz4Levels = []lz4.CompressionLevel{
+	lz4.Fast,
+	lz4.Level1,
+	lz4.Level2,
+	lz4.Level3,
+	lz4.Level4,
+	lz4.Level5,
+	lz4.Level6,
+	lz4.Level7,
+	lz4.Level8,
+	lz4.Level9,
+}
+
+// dictionary sizes used by xz presets 0-9
+var xzLevelDictCaps = []int{
+	256 << 10,
+	1 << 20,
+	2 << 20,
+	4 << 20,
+	4 << 20,
+	8 << 20,
+	8 << 20,
+	16 << 20,
+	32 << 20,
+	64 << 20,
+}
+
+func writeCloser(w io.WriteCloser, b []byte) error {
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func toCompress(_ *interp.Interp, c any, opts compressOpts) any {
+	inBR, err := interp.ToBitReader(c)
+	if err != nil {
+		return err
+	}
+	b, err := io.ReadAll(bitio.NewIOReader(inBR))
+	if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	switch opts.Name {
+	case "deflate":
+		err = writeDeflate(buf, b, opts)
+	case "zlib":
+		err = writeZlib(buf, b, opts)
+	case "gzip":
+		err = writeGzip(buf, b, opts)
+	case "snappy":
+		if !opts.Framed {
+			return newBinary(snappy.Encode(nil, b))
+		}
+		err = writeCloser(snappy.NewBufferedWriter(buf), b)
+	case "zstd":
+		zopts := []zstd.EOption{}
+		if opts.Level != -1 {
+			zopts = append(zopts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(opts.Level)))
+		}
+		if opts.WindowBits != 0 {
+			if opts.WindowBits < 10 || opts.WindowBits > 29 {
+				return fmt.Errorf("window bits %d not in range 10-29", opts.WindowBits)
+			}
+			zopts = append(zopts, zstd.WithWindowSize(1<<opts.WindowBits))
+		}
+		var zw *zstd.Encoder
+		if zw, err = zstd.NewWriter(buf, zopts...); err == nil {
+			err = writeCloser(zw, b)
+		}
+	case "xz":
+		var cfg xz.WriterConfig
+		if opts.Level != -1 {
+			if opts.Level < 0 || opts.Level >= len(xzLevelDictCaps) {
+				return fmt.Errorf("level %d not in range 0-9", opts.Level)
+			}
+			cfg.DictCap = xzLevelDictCaps[opts.Level]
+		}
+		if opts.WindowBits != 0 {
+			if opts.WindowBits < 12 || opts.WindowBits > 31 {
+				return fmt.Errorf("window bits %d not in range 12-31", opts.WindowBits)
+			}
+			cfg.DictCap = 1 << opts.WindowBits
+		}
+		var xw *xz.Writer
+		if xw, err = cfg.NewWriter(buf); err == nil {
+			err = writeCloser(xw, b)
+		}
+	case "lz4":
+		lw := lz4.NewWriter(buf)
+		if opts.Level != -1 {
+			if opts.Level < 0 || opts.Level >= len(lz4Levels) {
+				return fmt.Errorf("level %d not in range 0-9", opts.Level)
+			}
+			if err := lw.Apply(lz4.CompressionLevelOption(lz4Levels[opts.Level])); err != nil {
+				return err
+			}
+		}
+		err = writeCloser(lw, b)
+	case "brotli":
+		level := opts.Level
+		if level == -1 {
+			level = brotli.DefaultCompression
+		} else if level < brotli.BestSpeed || level > brotli.BestCompression {
+			return fmt.Errorf("level %d not in range 0-11", level)
+		}
+		if opts.WindowBits != 0 && (opts.WindowBits < 10 || opts.WindowBits > 24) {
+			return fmt.Errorf("window bits %d not in range 10-24", opts.WindowBits)
+		}
+		err = writeCloser(brotli.NewWriterOptions(buf, brotli.WriterOptions{Quality: level, LGWin: opts.WindowBits}), b)
+	default:
+		return fmt.Errorf("unknown compression %s", opts.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return newBinary(buf.Bytes())
+}
@@ -0,0 +1,200 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"embed"
+	"fmt"
+	"io"
+
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/interp"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+//go:embed cipher.jq
+var cipherFS embed.FS
+
+func init() {
+	interp.RegisterFunc1("_cipher", cipherFn)
+	interp.RegisterFS(cipherFS)
+}
+
+// Key, Iv and Aad are raw bytes, binaries are converted to strings as is
+type cipherOpts struct {
+	Name    string
+	Mode    string
+	Decrypt bool
+	Key     string
+	Iv      string
+	Aad     string
+	Padding string `default:"pkcs7"`
+}
+
+func toBytes(c any) ([]byte, error) {
+	br, err := interp.ToBitReader(c)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(bitio.NewIOReader(br))
+}
+
+func newBinary(b []byte) any {
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(b, -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
+
+// https://www.rfc-editor.org/rfc/rfc5652#section-6.3
+func pad(b []byte, blockSize int, padding string) ([]byte, error) {
+	switch padding {
+	case "pkcs7":
+		n := blockSize - len(b)%blockSize
+		return append(b, bytes.Repeat([]byte{byte(n)}, n)...), nil
+	case "none":
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown padding %s", padding)
+	}
+}
+
+func unpad(b []byte, blockSize int, padding string) ([]byte, error) {
+	switch padding {
+	case "pkcs7":
+		if len(b) == 0 {
+			return nil, fmt.Errorf("pkcs7: no padding")
+		}
+		n := int(b[len(b)-1])
+		if n == 0 || n > blockSize || n > len(b) {
+			return nil, fmt.Errorf("pkcs7: invalid padding")
+		}
+		for _, p := range b[len(b)-n:] {
+			if int(p) != n {
+				return nil, fmt.Errorf("pkcs7: invalid padding")
+			}
+		}
+		return b[:len(b)-n], nil
+	case "none":
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown padding %s", padding)
+	}
+}
+
+func blockMode(block cipher.Block, b []byte, opts cipherOpts) ([]byte, error) {
+	bs := block.BlockSize()
+
+	var err error
+	if !opts.Decrypt {
+		if b, err = pad(b, bs, opts.Padding); err != nil {
+			return nil, err
+		}
+	}
+	if len(b)%bs != 0 {
+		return nil, fmt.Errorf("length %d not multiple of block size %d", len(b), bs)
+	}
+
+	out := make([]byte, len(b))
+	switch opts.Mode {
+	case "ecb":
+		for i := 0; i < len(b); i += bs {
+			if opts.Decrypt {
+				block.Decrypt(out[i:i+bs], b[i:i+bs])
+			} else {
+				block.Encrypt(out[i:i+bs], b[i:i+bs])
+			}
+		}
+	case "cbc":
+		if len(opts.Iv) != bs {
+			return nil, fmt.Errorf("iv length %d, should be %d", len(opts.Iv), bs)
+		}
+		if opts.Decrypt {
+			cipher.NewCBCDecrypter(block, []byte(opts.Iv)).CryptBlocks(out, b)
+		} else {
+			cipher.NewCBCEncrypter(block, []byte(opts.Iv)).CryptBlocks(out, b)
+		}
+	}
+
+	if opts.Decrypt {
+		return unpad(out, bs, opts.Padding)
+	}
+	return out, nil
+}
+
+func aead(a cipher.AEAD, b []byte, opts cipherOpts) ([]byte, error) {
+	if opts.Decrypt {
+		return a.Open(nil, []byte(opts.Iv), b, []byte(opts.Aad))
+	}
+	return a.Seal(nil, []byte(opts.Iv), b, []byte(opts.Aad)), nil
+}
+
+func aesFn(b []byte, opts cipherOpts) ([]byte, error) {
+	block, err := aes.NewCipher([]byte(opts.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Mode {
+	case "ecb", "cbc":
+		return blockMode(block, b, opts)
+	case "ctr":
+		// shorter iv is zero padded, ex: 8 byte CENC iv
+		if len(opts.Iv) > aes.BlockSize {
+			return nil, fmt.Errorf("iv length %d, should be at most %d", len(opts.Iv), aes.BlockSize)
+		}
+		iv := make([]byte, aes.BlockSize)
+		copy(iv, opts.Iv)
+		out := make([]byte, len(b))
+		cipher.NewCTR(block, iv).XORKeyStream(out, b)
+		return out, nil
+	case "gcm":
+		a, err := cipher.NewGCMWithNonceSize(block, len(opts.Iv))
+		if err != nil {
+			return nil, err
+		}
+		return aead(a, b, opts)
+	default:
+		return nil, fmt.Errorf("unknown mode %s", opts.Mode)
+	}
+}
+
+func chacha20Poly1305Fn(b []byte, opts cipherOpts) ([]byte, error) {
+	newFn := chacha20poly1305.New
+	// 24 byte nonce is XChaCha20-Poly1305
+	if len(opts.Iv) == chacha20poly1305.NonceSizeX {
+		newFn = chacha20poly1305.NewX
+	}
+	a, err := newFn([]byte(opts.Key))
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Iv) != a.NonceSize() {
+		return nil, fmt.Errorf("nonce length %d, should be %d or %d", len(opts.Iv), chacha20poly1305.NonceSize, chacha20poly1305.NonceSizeX)
+	}
+	return aead(a, b, opts)
+}
+
+func cipherFn(_ *interp.Interp, c any, opts cipherOpts) any {
+	b, err := toBytes(c)
+	if err != nil {
+		return err
+	}
+
+	var out []byte
+	switch opts.Name {
+	case "aes":
+		out, err = aesFn(b, opts)
+	case "chacha20_poly1305":
+		out, err = chacha20Poly1305Fn(b, opts)
+	default:
+		return fmt.Errorf("unknown cipher %s", opts.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return newBinary(out)
+}
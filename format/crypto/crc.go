@@ -0,0 +1,123 @@
+package crypto
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
+	"github.com/wader/fq/pkg/interp"
+)
+
+func init() {
+	interp.RegisterFunc1("_to_crc", toCRC)
+	interp.RegisterFunc0("crc_presets", crcPresets)
+}
+
+func toUint64(v any) (uint64, bool) {
+	switch v := v.(type) {
+	case int:
+		return uint64(v), v >= 0
+	case float64:
+		return uint64(v), v >= 0 && v <= math.MaxUint64 && v == math.Trunc(v)
+	case *big.Int:
+		return v.Uint64(), v.IsUint64()
+	default:
+		return 0, false
+	}
+}
+
+func fromUint64(v uint64) any {
+	if v > math.MaxInt {
+		return new(big.Int).SetUint64(v)
+	}
+	return int(v)
+}
+
+func crcModelToMap(m checksum.CRCModel) map[string]any {
+	return map[string]any{
+		"width":  m.Width,
+		"poly":   fromUint64(m.Poly),
+		"init":   fromUint64(m.Init),
+		"refin":  m.RefIn,
+		"refout": m.RefOut,
+		"xorout": fromUint64(m.XorOut),
+	}
+}
+
+func crcPresets(_ *interp.Interp, c any) any {
+	ps := map[string]any{}
+	for name, m := range checksum.CRCModels {
+		ps[name] = crcModelToMap(m)
+	}
+	return ps
+}
+
+// opts is a preset name and/or model parameters, parameters override the preset
+func toCRC(_ *interp.Interp, c any, opts map[string]any) any {
+	var m checksum.CRCModel
+	if p, ok := opts["preset"]; ok {
+		name, ok := p.(string)
+		if !ok {
+			return fmt.Errorf("preset should be a string")
+		}
+		if m, ok = checksum.CRCModelByName(name); !ok {
+			return fmt.Errorf("unknown crc preset %s, see crc_presets", name)
+		}
+	}
+	for _, k := range []string{"width", "poly", "init", "xorout"} {
+		v, ok := opts[k]
+		if !ok {
+			continue
+		}
+		n, ok := toUint64(v)
+		if !ok {
+			return fmt.Errorf("%s should be a positive integer", k)
+		}
+		switch k {
+		case "width":
+			if n > 64 {
+				return fmt.Errorf("crc width %d not in range 1-64", n)
+			}
+			m.Width = int(n)
+		case "poly":
+			m.Poly = n
+		case "init":
+			m.Init = n
+		case "xorout":
+			m.XorOut = n
+		}
+	}
+	for _, k := range []string{"refin", "refout"} {
+		v, ok := opts[k]
+		if !ok {
+			continue
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("%s should be a boolean", k)
+		}
+		if k == "refin" {
+			m.RefIn = b
+		} else {
+			m.RefOut = b
+		}
+	}
+
+	h, err := checksum.NewCRCModel(m)
+	if err != nil {
+		return err
+	}
+
+	inBR, err := interp.ToBitReader(c)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(h, bitio.NewIOReader(inBR)); err != nil {
+		return err
+	}
+
+	return newBinary(h.Sum(nil))
+}
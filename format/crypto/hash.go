@@ -9,10 +9,18 @@ import (
 	"embed"
 	"fmt"
 	"hash"
+	"hash/adler32"
+	"hash/fnv"
 	"io"
+	"math"
 
 	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
 	"github.com/wader/fq/pkg/interp"
+	"github.com/zeebo/xxh3"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"lukechampine.com/blake3"
 
 	//nolint: staticcheck
 	"golang.org/x/crypto/md4"
@@ -27,33 +35,84 @@ func init() {
 	interp.RegisterFS(hashFS)
 }
 
-func hashFn(s string) hash.Hash {
-	switch s {
+func hashFn(opts toHashOpts) (hash.Hash, error) {
+	switch opts.Name {
 	case "md4":
-		return md4.New()
+		return md4.New(), nil
 	case "md5":
-		return md5.New()
+		return md5.New(), nil
 	case "sha1":
-		return sha1.New()
+		return sha1.New(), nil
 	case "sha256":
-		return sha256.New()
+		return sha256.New(), nil
 	case "sha512":
-		return sha512.New()
+		return sha512.New(), nil
 	case "sha3_224":
-		return sha3.New224()
+		return sha3.New224(), nil
 	case "sha3_256":
-		return sha3.New256()
+		return sha3.New256(), nil
 	case "sha3_384":
-		return sha3.New384()
+		return sha3.New384(), nil
 	case "sha3_512":
-		return sha3.New512()
+		return sha3.New512(), nil
+	case "blake2b_256":
+		return blake2b.New256([]byte(opts.Key))
+	case "blake2b_384":
+		return blake2b.New384([]byte(opts.Key))
+	case "blake2b_512":
+		return blake2b.New512([]byte(opts.Key))
+	case "blake2s_256":
+		return blake2s.New256([]byte(opts.Key))
+	case "blake3":
+		if opts.Key == "" {
+			return blake3.New(32, nil), nil
+		}
+		if len(opts.Key) != 32 {
+			return nil, fmt.Errorf("blake3 key length %d, should be 32", len(opts.Key))
+		}
+		return blake3.New(32, []byte(opts.Key)), nil
+	case "adler32":
+		return adler32.New(), nil
+	case "fnv32":
+		return fnv.New32(), nil
+	case "fnv32a":
+		return fnv.New32a(), nil
+	case "fnv64":
+		return fnv.New64(), nil
+	case "fnv64a":
+		return fnv.New64a(), nil
+	case "fnv128":
+		return fnv.New128(), nil
+	case "fnv128a":
+		return fnv.New128a(), nil
+	case "xxh32":
+		if opts.Seed > math.MaxUint32 {
+			return nil, fmt.Errorf("xxh32 seed %d not in range 0-4294967295", opts.Seed)
+		}
+		return checksum.NewXXH32(uint32(opts.Seed)), nil
+	case "xxh64":
+		return checksum.NewXXH64(opts.Seed), nil
+	case "xxh3":
+		return xxh3.NewSeed(opts.Seed), nil
+	case "murmur3_32":
+		if opts.Seed > math.MaxUint32 {
+			return nil, fmt.Errorf("murmur3_32 seed %d not in range 0-4294967295", opts.Seed)
+		}
+		return checksum.NewMurmur3Hash32(uint32(opts.Seed)), nil
+	case "murmur3_128":
+		return checksum.NewMurmur3Hash128(opts.Seed), nil
+	case "siphash":
+		return checksum.NewSipHash([]byte(opts.Key))
 	default:
-		return nil
+		return nil, fmt.Errorf("unknown hash function %s", opts.Name)
 	}
 }
 
+// Key is raw bytes, binaries are converted to strings as is
 type toHashOpts struct {
 	Name string
+	Seed uint64
+	Key  string
 }
 
 func toHash(_ *interp.Interp, c any, opts toHashOpts) any {
@@ -62,9 +121,9 @@ func toHash(_ *interp.Interp, c any, opts toHashOpts) any {
 		return err
 	}
 
-	h := hashFn(opts.Name)
-	if h == nil {
-		return fmt.Errorf("unknown hash function %s", opts.Name)
+	h, err := hashFn(opts)
+	if err != nil {
+		return err
 	}
 	if _, err := io.Copy(h, bitio.NewIOReader(inBR)); err != nil {
 		return err
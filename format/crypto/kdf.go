@@ -0,0 +1,111 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"embed"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/wader/fq/pkg/interp"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+//go:embed kdf.jq
+var kdfFS embed.FS
+
+func init() {
+	interp.RegisterFunc1("_to_hmac", toHMAC)
+	interp.RegisterFunc1("_to_hkdf", toHKDF)
+	interp.RegisterFunc1("_to_pbkdf2", toPBKDF2)
+	interp.RegisterFS(kdfFS)
+}
+
+func newHashFn(name string) (func() hash.Hash, error) {
+	opts := toHashOpts{Name: name}
+	if _, err := hashFn(opts); err != nil {
+		return nil, err
+	}
+	return func() hash.Hash {
+		h, _ := hashFn(opts)
+		return h
+	}, nil
+}
+
+type toHMACOpts struct {
+	Hash string `default:"sha256"`
+	Key  string
+}
+
+func toHMAC(_ *interp.Interp, c any, opts toHMACOpts) any {
+	b, err := toBytes(c)
+	if err != nil {
+		return err
+	}
+	h, err := newHashFn(opts.Hash)
+	if err != nil {
+		return err
+	}
+
+	m := hmac.New(h, []byte(opts.Key))
+	m.Write(b)
+
+	return newBinary(m.Sum(nil))
+}
+
+// https://www.rfc-editor.org/rfc/rfc5869
+type toHKDFOpts struct {
+	Hash   string `default:"sha256"`
+	Salt   string
+	Info   string
+	Length int `default:"32"`
+}
+
+func toHKDF(_ *interp.Interp, c any, opts toHKDFOpts) any {
+	b, err := toBytes(c)
+	if err != nil {
+		return err
+	}
+	h, err := newHashFn(opts.Hash)
+	if err != nil {
+		return err
+	}
+	if opts.Length < 0 {
+		return fmt.Errorf("length %d should be positive", opts.Length)
+	}
+
+	out := make([]byte, opts.Length)
+	if _, err := io.ReadFull(hkdf.New(h, b, []byte(opts.Salt), []byte(opts.Info)), out); err != nil {
+		return err
+	}
+
+	return newBinary(out)
+}
+
+// https://www.rfc-editor.org/rfc/rfc8018#section-5.2
+type toPBKDF2Opts struct {
+	Hash       string `default:"sha256"`
+	Salt       string
+	Iterations int `default:"10000"`
+	Length     int `default:"32"`
+}
+
+func toPBKDF2(_ *interp.Interp, c any, opts toPBKDF2Opts) any {
+	b, err := toBytes(c)
+	if err != nil {
+		return err
+	}
+	h, err := newHashFn(opts.Hash)
+	if err != nil {
+		return err
+	}
+	if opts.Iterations < 1 {
+		return fmt.Errorf("iterations %d should be at least 1", opts.Iterations)
+	}
+	if opts.Length < 0 {
+		return fmt.Errorf("length %d should be positive", opts.Length)
+	}
+
+	return newBinary(pbkdf2.Key(b, []byte(opts.Salt), opts.Iterations, opts.Length, h))
+}
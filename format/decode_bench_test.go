@@ -0,0 +1,73 @@
+package format_test
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	_ "github.com/wader/fq/format/all"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+)
+
+// BenchmarkDecode decodes some test files and also reports the heap memory
+// retained by the decoded tree per value
+func BenchmarkDecode(b *testing.B) {
+	for _, bc := range []struct {
+		name   string
+		format string
+		path   string
+	}{
+		{name: "pcap", format: "pcap", path: "pcap/testdata/ipv6_http.pcap"},
+		{name: "pcapng", format: "pcapng", path: "pcap/testdata/many_interfaces.pcapng"},
+		{name: "mp4", format: "mp4", path: "mp4/testdata/avc.mp4"},
+		{name: "flac", format: "flac", path: "flac/testdata/mono16.flac"},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			buf, err := os.ReadFile(bc.path)
+			if err != nil {
+				b.Fatal(err)
+			}
+			g, err := interp.DefaultRegistry.Group(bc.format)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			decodeFn := func() *decode.Value {
+				dv, _, err := decode.Decode(context.Background(), bitio.NewBitReader(buf, -1), g, decode.Options{
+					IsRoot:   true,
+					FillGaps: true,
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				return dv
+			}
+
+			var ms runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&ms)
+			before := ms.HeapAlloc
+			dv := decodeFn()
+			runtime.GC()
+			runtime.ReadMemStats(&ms)
+			retained := ms.HeapAlloc - before
+			values := 0
+			_ = dv.WalkPreOrder(func(_ *decode.Value, _ *decode.Value, _ int, _ int) error {
+				values++
+				return nil
+			})
+			runtime.KeepAlive(dv)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				decodeFn()
+			}
+			b.ReportMetric(float64(values), "values")
+			b.ReportMetric(float64(retained)/float64(values), "retained-B/value")
+		})
+	}
+}
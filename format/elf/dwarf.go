@@ -0,0 +1,1817 @@
+package elf
+
+// https://dwarfstd.org/doc/DWARF5.pdf
+// https://dwarfstd.org/doc/DWARF4.pdf
+// https://sourceware.org/git/?p=binutils-gdb.git;a=blob;f=include/dwarf2.def;hb=HEAD
+
+// TODO: .debug_loc, .debug_loclists, .debug_ranges, .debug_rnglists, .debug_addr
+// TODO: .debug_types, .debug_macro, .debug_names
+// TODO: ET_REL relocations
+// TODO: .zdebug_* (GNU compressed sections)
+// TODO: DW_OP_* expressions
+// TODO: VLIW op_index for line programs
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	zstdlib "github.com/klauspost/compress/zstd"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+const (
+	ELFCOMPRESS_ZLIB = 1
+	ELFCOMPRESS_ZSTD = 2
+)
+
+var compressionTypeNames = scalar.UintMapSymStr{
+	ELFCOMPRESS_ZLIB: "zlib",
+	ELFCOMPRESS_ZSTD: "zstd",
+}
+
+const (
+	DW_TAG_array_type               = 0x01
+	DW_TAG_class_type               = 0x02
+	DW_TAG_entry_point              = 0x03
+	DW_TAG_enumeration_type         = 0x04
+	DW_TAG_formal_parameter         = 0x05
+	DW_TAG_imported_declaration     = 0x08
+	DW_TAG_label                    = 0x0a
+	DW_TAG_lexical_block            = 0x0b
+	DW_TAG_member                   = 0x0d
+	DW_TAG_pointer_type             = 0x0f
+	DW_TAG_reference_type           = 0x10
+	DW_TAG_compile_unit             = 0x11
+	DW_TAG_string_type              = 0x12
+	DW_TAG_structure_type           = 0x13
+	DW_TAG_subroutine_type          = 0x15
+	DW_TAG_typedef                  = 0x16
+	DW_TAG_union_type               = 0x17
+	DW_TAG_unspecified_parameters   = 0x18
+	DW_TAG_variant                  = 0x19
+	DW_TAG_common_block             = 0x1a
+	DW_TAG_common_inclusion         = 0x1b
+	DW_TAG_inheritance              = 0x1c
+	DW_TAG_inlined_subroutine       = 0x1d
+	DW_TAG_module                   = 0x1e
+	DW_TAG_ptr_to_member_type       = 0x1f
+	DW_TAG_set_type                 = 0x20
+	DW_TAG_subrange_type            = 0x21
+	DW_TAG_with_stmt                = 0x22
+	DW_TAG_access_declaration       = 0x23
+	DW_TAG_base_type                = 0x24
+	DW_TAG_catch_block              = 0x25
+	DW_TAG_const_type               = 0x26
+	DW_TAG_constant                 = 0x27
+	DW_TAG_enumerator               = 0x28
+	DW_TAG_file_type                = 0x29
+	DW_TAG_friend                   = 0x2a
+	DW_TAG_namelist                 = 0x2b
+	DW_TAG_namelist_item            = 0x2c
+	DW_TAG_packed_type              = 0x2d
+	DW_TAG_subprogram               = 0x2e
+	DW_TAG_template_type_parameter  = 0x2f
+	DW_TAG_template_value_parameter = 0x30
+	DW_TAG_thrown_type              = 0x31
+	DW_TAG_try_block                = 0x32
+	DW_TAG_variant_part             = 0x33
+	DW_TAG_variable                 = 0x34
+	DW_TAG_volatile_type            = 0x35
+	DW_TAG_dwarf_procedure          = 0x36
+	DW_TAG_restrict_type            = 0x37
+	DW_TAG_interface_type           = 0x38
+	DW_TAG_namespace                = 0x39
+	DW_TAG_imported_module          = 0x3a
+	DW_TAG_unspecified_type         = 0x3b
+	DW_TAG_partial_unit             = 0x3c
+	DW_TAG_imported_unit            = 0x3d
+	DW_TAG_condition                = 0x3f
+	DW_TAG_shared_type              = 0x40
+	DW_TAG_type_unit                = 0x41
+	DW_TAG_rvalue_reference_type    = 0x42
+	DW_TAG_template_alias           = 0x43
+	DW_TAG_coarray_type             = 0x44
+	DW_TAG_generic_subrange         = 0x45
+	DW_TAG_dynamic_type             = 0x46
+	DW_TAG_atomic_type              = 0x47
+	DW_TAG_call_site                = 0x48
+	DW_TAG_call_site_parameter      = 0x49
+	DW_TAG_skeleton_unit            = 0x4a
+	DW_TAG_immutable_type           = 0x4b
+)
+
+var tagNames = scalar.UintMapSymStr{
+	DW_TAG_array_type:               "DW_TAG_array_type",
+	DW_TAG_class_type:               "DW_TAG_class_type",
+	DW_TAG_entry_point:              "DW_TAG_entry_point",
+	DW_TAG_enumeration_type:         "DW_TAG_enumeration_type",
+	DW_TAG_formal_parameter:         "DW_TAG_formal_parameter",
+	DW_TAG_imported_declaration:     "DW_TAG_imported_declaration",
+	DW_TAG_label:                    "DW_TAG_label",
+	DW_TAG_lexical_block:            "DW_TAG_lexical_block",
+	DW_TAG_member:                   "DW_TAG_member",
+	DW_TAG_pointer_type:             "DW_TAG_pointer_type",
+	DW_TAG_reference_type:           "DW_TAG_reference_type",
+	DW_TAG_compile_unit:             "DW_TAG_compile_unit",
+	DW_TAG_string_type:              "DW_TAG_string_type",
+	DW_TAG_structure_type:           "DW_TAG_structure_type",
+	DW_TAG_subroutine_type:          "DW_TAG_subroutine_type",
+	DW_TAG_typedef:                  "DW_TAG_typedef",
+	DW_TAG_union_type:               "DW_TAG_union_type",
+	DW_TAG_unspecified_parameters:   "DW_TAG_unspecified_parameters",
+	DW_TAG_variant:                  "DW_TAG_variant",
+	DW_TAG_common_block:             "DW_TAG_common_block",
+	DW_TAG_common_inclusion:         "DW_TAG_common_inclusion",
+	DW_TAG_inheritance:              "DW_TAG_inheritance",
+	DW_TAG_inlined_subroutine:       "DW_TAG_inlined_subroutine",
+	DW_TAG_module:                   "DW_TAG_module",
+	DW_TAG_ptr_to_member_type:       "DW_TAG_ptr_to_member_type",
+	DW_TAG_set_type:                 "DW_TAG_set_type",
+	DW_TAG_subrange_type:            "DW_TAG_subrange_type",
+	DW_TAG_with_stmt:                "DW_TAG_with_stmt",
+	DW_TAG_access_declaration:       "DW_TAG_access_declaration",
+	DW_TAG_base_type:                "DW_TAG_base_type",
+	DW_TAG_catch_block:              "DW_TAG_catch_block",
+	DW_TAG_const_type:               "DW_TAG_const_type",
+	DW_TAG_constant:                 "DW_TAG_constant",
+	DW_TAG_enumerator:               "DW_TAG_enumerator",
+	DW_TAG_file_type:                "DW_TAG_file_type",
+	DW_TAG_friend:                   "DW_TAG_friend",
+	DW_TAG_namelist:                 "DW_TAG_namelist",
+	DW_TAG_namelist_item:            "DW_TAG_namelist_item",
+	DW_TAG_packed_type:              "DW_TAG_packed_type",
+	DW_TAG_subprogram:               "DW_TAG_subprogram",
+	DW_TAG_template_type_parameter:  "DW_TAG_template_type_parameter",
+	DW_TAG_template_value_parameter: "DW_TAG_template_value_parameter",
+	DW_TAG_thrown_type:              "DW_TAG_thrown_type",
+	DW_TAG_try_block:                "DW_TAG_try_block",
+	DW_TAG_variant_part:             "DW_TAG_variant_part",
+	DW_TAG_variable:                 "DW_TAG_variable",
+	DW_TAG_volatile_type:            "DW_TAG_volatile_type",
+	DW_TAG_dwarf_procedure:          "DW_TAG_dwarf_procedure",
+	DW_TAG_restrict_type:            "DW_TAG_restrict_type",
+	DW_TAG_interface_type:           "DW_TAG_interface_type",
+	DW_TAG_namespace:                "DW_TAG_namespace",
+	DW_TAG_imported_module:          "DW_TAG_imported_module",
+	DW_TAG_unspecified_type:         "DW_TAG_unspecified_type",
+	DW_TAG_partial_unit:             "DW_TAG_partial_unit",
+	DW_TAG_imported_unit:            "DW_TAG_imported_unit",
+	DW_TAG_condition:                "DW_TAG_condition",
+	DW_TAG_shared_type:              "DW_TAG_shared_type",
+	DW_TAG_type_unit:                "DW_TAG_type_unit",
+	DW_TAG_rvalue_reference_type:    "DW_TAG_rvalue_reference_type",
+	DW_TAG_template_alias:           "DW_TAG_template_alias",
+	DW_TAG_coarray_type:             "DW_TAG_coarray_type",
+	DW_TAG_generic_subrange:         "DW_TAG_generic_subrange",
+	DW_TAG_dynamic_type:             "DW_TAG_dynamic_type",
+	DW_TAG_atomic_type:              "DW_TAG_atomic_type",
+	DW_TAG_call_site:                "DW_TAG_call_site",
+	DW_TAG_call_site_parameter:      "DW_TAG_call_site_parameter",
+	DW_TAG_skeleton_unit:            "DW_TAG_skeleton_unit",
+	DW_TAG_immutable_type:           "DW_TAG_immutable_type",
+	0x4081:                          "DW_TAG_MIPS_loop",
+	0x4101:                          "DW_TAG_format_label",
+	0x4102:                          "DW_TAG_function_template",
+	0x4103:                          "DW_TAG_class_template",
+	0x4104:                          "DW_TAG_GNU_BINCL",
+	0x4105:                          "DW_TAG_GNU_EINCL",
+	0x4106:                          "DW_TAG_GNU_template_template_param",
+	0x4107:                          "DW_TAG_GNU_template_parameter_pack",
+	0x4108:                          "DW_TAG_GNU_formal_parameter_pack",
+	0x4109:                          "DW_TAG_GNU_call_site",
+	0x410a:                          "DW_TAG_GNU_call_site_parameter",
+}
+
+const (
+	DW_AT_sibling                 = 0x01
+	DW_AT_location                = 0x02
+	DW_AT_name                    = 0x03
+	DW_AT_ordering                = 0x09
+	DW_AT_byte_size               = 0x0b
+	DW_AT_bit_offset              = 0x0c
+	DW_AT_bit_size                = 0x0d
+	DW_AT_stmt_list               = 0x10
+	DW_AT_low_pc                  = 0x11
+	DW_AT_high_pc                 = 0x12
+	DW_AT_language                = 0x13
+	DW_AT_discr                   = 0x15
+	DW_AT_discr_value             = 0x16
+	DW_AT_visibility              = 0x17
+	DW_AT_import                  = 0x18
+	DW_AT_string_length           = 0x19
+	DW_AT_common_reference        = 0x1a
+	DW_AT_comp_dir                = 0x1b
+	DW_AT_const_value             = 0x1c
+	DW_AT_containing_type         = 0x1d
+	DW_AT_default_value           = 0x1e
+	DW_AT_inline                  = 0x20
+	DW_AT_is_optional             = 0x21
+	DW_AT_lower_bound             = 0x22
+	DW_AT_producer                = 0x25
+	DW_AT_prototyped              = 0x27
+	DW_AT_return_addr             = 0x2a
+	DW_AT_start_scope             = 0x2c
+	DW_AT_bit_stride              = 0x2e
+	DW_AT_upper_bound             = 0x2f
+	DW_AT_abstract_origin         = 0x31
+	DW_AT_accessibility           = 0x32
+	DW_AT_address_class           = 0x33
+	DW_AT_artificial              = 0x34
+	DW_AT_base_types              = 0x35
+	DW_AT_calling_convention      = 0x36
+	DW_AT_count                   = 0x37
+	DW_AT_data_member_location    = 0x38
+	DW_AT_decl_column             = 0x39
+	DW_AT_decl_file               = 0x3a
+	DW_AT_decl_line               = 0x3b
+	DW_AT_declaration             = 0x3c
+	DW_AT_discr_list              = 0x3d
+	DW_AT_encoding                = 0x3e
+	DW_AT_external                = 0x3f
+	DW_AT_frame_base              = 0x40
+	DW_AT_friend                  = 0x41
+	DW_AT_identifier_case         = 0x42
+	DW_AT_macro_info              = 0x43
+	DW_AT_namelist_item           = 0x44
+	DW_AT_priority                = 0x45
+	DW_AT_segment                 = 0x46
+	DW_AT_specification           = 0x47
+	DW_AT_static_link             = 0x48
+	DW_AT_type                    = 0x49
+	DW_AT_use_location            = 0x4a
+	DW_AT_variable_parameter      = 0x4b
+	DW_AT_virtuality              = 0x4c
+	DW_AT_vtable_elem_location    = 0x4d
+	DW_AT_allocated               = 0x4e
+	DW_AT_associated              = 0x4f
+	DW_AT_data_location           = 0x50
+	DW_AT_byte_stride             = 0x51
+	DW_AT_entry_pc                = 0x52
+	DW_AT_use_UTF8                = 0x53
+	DW_AT_extension               = 0x54
+	DW_AT_ranges                  = 0x55
+	DW_AT_trampoline              = 0x56
+	DW_AT_call_column             = 0x57
+	DW_AT_call_file               = 0x58
+	DW_AT_call_line               = 0x59
+	DW_AT_description             = 0x5a
+	DW_AT_binary_scale            = 0x5b
+	DW_AT_decimal_scale           = 0x5c
+	DW_AT_small                   = 0x5d
+	DW_AT_decimal_sign            = 0x5e
+	DW_AT_digit_count             = 0x5f
+	DW_AT_picture_string          = 0x60
+	DW_AT_mutable                 = 0x61
+	DW_AT_threads_scaled          = 0x62
+	DW_AT_explicit                = 0x63
+	DW_AT_object_pointer          = 0x64
+	DW_AT_endianity               = 0x65
+	DW_AT_elemental               = 0x66
+	DW_AT_pure                    = 0x67
+	DW_AT_recursive               = 0x68
+	DW_AT_signature               = 0x69
+	DW_AT_main_subprogram         = 0x6a
+	DW_AT_data_bit_offset         = 0x6b
+	DW_AT_const_expr              = 0x6c
+	DW_AT_enum_class              = 0x6d
+	DW_AT_linkage_name            = 0x6e
+	DW_AT_string_length_bit_size  = 0x6f
+	DW_AT_string_length_byte_size = 0x70
+	DW_AT_rank                    = 0x71
+	DW_AT_str_offsets_base        = 0x72
+	DW_AT_addr_base               = 0x73
+	DW_AT_rnglists_base           = 0x74
+	DW_AT_dwo_name                = 0x76
+	DW_AT_reference               = 0x77
+	DW_AT_rvalue_reference        = 0x78
+	DW_AT_macros                  = 0x79
+	DW_AT_call_all_calls          = 0x7a
+	DW_AT_call_all_source_calls   = 0x7b
+	DW_AT_call_all_tail_calls     = 0x7c
+	DW_AT_call_return_pc          = 0x7d
+	DW_AT_call_value              = 0x7e
+	DW_AT_call_origin             = 0x7f
+	DW_AT_call_parameter          = 0x80
+	DW_AT_call_pc                 = 0x81
+	DW_AT_call_tail_call          = 0x82
+	DW_AT_call_target             = 0x83
+	DW_AT_call_target_clobbered   = 0x84
+	DW_AT_call_data_location      = 0x85
+	DW_AT_call_data_value         = 0x86
+	DW_AT_noreturn                = 0x87
+	DW_AT_alignment               = 0x88
+	DW_AT_export_symbols          = 0x89
+	DW_AT_deleted                 = 0x8a
+	DW_AT_defaulted               = 0x8b
+	DW_AT_loclists_base           = 0x8c
+)
+
+var attributeNames = scalar.UintMapSymStr{
+	DW_AT_sibling:                 "DW_AT_sibling",
+	DW_AT_location:                "DW_AT_location",
+	DW_AT_name:                    "DW_AT_name",
+	DW_AT_ordering:                "DW_AT_ordering",
+	DW_AT_byte_size:               "DW_AT_byte_size",
+	DW_AT_bit_offset:              "DW_AT_bit_offset",
+	DW_AT_bit_size:                "DW_AT_bit_size",
+	DW_AT_stmt_list:               "DW_AT_stmt_list",
+	DW_AT_low_pc:                  "DW_AT_low_pc",
+	DW_AT_high_pc:                 "DW_AT_high_pc",
+	DW_AT_language:                "DW_AT_language",
+	DW_AT_discr:                   "DW_AT_discr",
+	DW_AT_discr_value:             "DW_AT_discr_value",
+	DW_AT_visibility:              "DW_AT_visibility",
+	DW_AT_import:                  "DW_AT_import",
+	DW_AT_string_length:           "DW_AT_string_length",
+	DW_AT_common_reference:        "DW_AT_common_reference",
+	DW_AT_comp_dir:                "DW_AT_comp_dir",
+	DW_AT_const_value:             "DW_AT_const_value",
+	DW_AT_containing_type:         "DW_AT_containing_type",
+	DW_AT_default_value:           "DW_AT_default_value",
+	DW_AT_inline:                  "DW_AT_inline",
+	DW_AT_is_optional:             "DW_AT_is_optional",
+	DW_AT_lower_bound:             "DW_AT_lower_bound",
+	DW_AT_producer:                "DW_AT_producer",
+	DW_AT_prototyped:              "DW_AT_prototyped",
+	DW_AT_return_addr:             "DW_AT_return_addr",
+	DW_AT_start_scope:             "DW_AT_start_scope",
+	DW_AT_bit_stride:              "DW_AT_bit_stride",
+	DW_AT_upper_bound:             "DW_AT_upper_bound",
+	DW_AT_abstract_origin:         "DW_AT_abstract_origin",
+	DW_AT_accessibility:           "DW_AT_accessibility",
+	DW_AT_address_class:           "DW_AT_address_class",
+	DW_AT_artificial:              "DW_AT_artificial",
+	DW_AT_base_types:              "DW_AT_base_types",
+	DW_AT_calling_convention:      "DW_AT_calling_convention",
+	DW_AT_count:                   "DW_AT_count",
+	DW_AT_data_member_location:    "DW_AT_data_member_location",
+	DW_AT_decl_column:             "DW_AT_decl_column",
+	DW_AT_decl_file:               "DW_AT_decl_file",
+	DW_AT_decl_line:               "DW_AT_decl_line",
+	DW_AT_declaration:             "DW_AT_declaration",
+	DW_AT_discr_list:              "DW_AT_discr_list",
+	DW_AT_encoding:                "DW_AT_encoding",
+	DW_AT_external:                "DW_AT_external",
+	DW_AT_frame_base:              "DW_AT_frame_base",
+	DW_AT_friend:                  "DW_AT_friend",
+	DW_AT_identifier_case:         "DW_AT_identifier_case",
+	DW_AT_macro_info:              "DW_AT_macro_info",
+	DW_AT_namelist_item:           "DW_AT_namelist_item",
+	DW_AT_priority:                "DW_AT_priority",
+	DW_AT_segment:                 "DW_AT_segment",
+	DW_AT_specification:           "DW_AT_specification",
+	DW_AT_static_link:             "DW_AT_static_link",
+	DW_AT_type:                    "DW_AT_type",
+	DW_AT_use_location:            "DW_AT_use_location",
+	DW_AT_variable_parameter:      "DW_AT_variable_parameter",
+	DW_AT_virtuality:              "DW_AT_virtuality",
+	DW_AT_vtable_elem_location:    "DW_AT_vtable_elem_location",
+	DW_AT_allocated:               "DW_AT_allocated",
+	DW_AT_associated:              "DW_AT_associated",
+	DW_AT_data_location:           "DW_AT_data_location",
+	DW_AT_byte_stride:             "DW_AT_byte_stride",
+	DW_AT_entry_pc:                "DW_AT_entry_pc",
+	DW_AT_use_UTF8:                "DW_AT_use_UTF8",
+	DW_AT_extension:               "DW_AT_extension",
+	DW_AT_ranges:                  "DW_AT_ranges",
+	DW_AT_trampoline:              "DW_AT_trampoline",
+	DW_AT_call_column:             "DW_AT_call_column",
+	DW_AT_call_file:               "DW_AT_call_file",
+	DW_AT_call_line:               "DW_AT_call_line",
+	DW_AT_description:             "DW_AT_description",
+	DW_AT_binary_scale:            "DW_AT_binary_scale",
+	DW_AT_decimal_scale:           "DW_AT_decimal_scale",
+	DW_AT_small:                   "DW_AT_small",
+	DW_AT_decimal_sign:            "DW_AT_decimal_sign",
+	DW_AT_digit_count:             "DW_AT_digit_count",
+	DW_AT_picture_string:          "DW_AT_picture_string",
+	DW_AT_mutable:                 "DW_AT_mutable",
+	DW_AT_threads_scaled:          "DW_AT_threads_scaled",
+	DW_AT_explicit:                "DW_AT_explicit",
+	DW_AT_object_pointer:          "DW_AT_object_pointer",
+	DW_AT_endianity:               "DW_AT_endianity",
+	DW_AT_elemental:               "DW_AT_elemental",
+	DW_AT_pure:                    "DW_AT_pure",
+	DW_AT_recursive:               "DW_AT_recursive",
+	DW_AT_signature:               "DW_AT_signature",
+	DW_AT_main_subprogram:         "DW_AT_main_subprogram",
+	DW_AT_data_bit_offset:         "DW_AT_data_bit_offset",
+	DW_AT_const_expr:              "DW_AT_const_expr",
+	DW_AT_enum_class:              "DW_AT_enum_class",
+	DW_AT_linkage_name:            "DW_AT_linkage_name",
+	DW_AT_string_length_bit_size:  "DW_AT_string_length_bit_size",
+	DW_AT_string_length_byte_size: "DW_AT_string_length_byte_size",
+	DW_AT_rank:                    "DW_AT_rank",
+	DW_AT_str_offsets_base:        "DW_AT_str_offsets_base",
+	DW_AT_addr_base:               "DW_AT_addr_base",
+	DW_AT_rnglists_base:           "DW_AT_rnglists_base",
+	DW_AT_dwo_name:                "DW_AT_dwo_name",
+	DW_AT_reference:               "DW_AT_reference",
+	DW_AT_rvalue_reference:        "DW_AT_rvalue_reference",
+	DW_AT_macros:                  "DW_AT_macros",
+	DW_AT_call_all_calls:          "DW_AT_call_all_calls",
+	DW_AT_call_all_source_calls:   "DW_AT_call_all_source_calls",
+	DW_AT_call_all_tail_calls:     "DW_AT_call_all_tail_calls",
+	DW_AT_call_return_pc:          "DW_AT_call_return_pc",
+	DW_AT_call_value:              "DW_AT_call_value",
+	DW_AT_call_origin:             "DW_AT_call_origin",
+	DW_AT_call_parameter:          "DW_AT_call_parameter",
+	DW_AT_call_pc:                 "DW_AT_call_pc",
+	DW_AT_call_tail_call:          "DW_AT_call_tail_call",
+	DW_AT_call_target:             "DW_AT_call_target",
+	DW_AT_call_target_clobbered:   "DW_AT_call_target_clobbered",
+	DW_AT_call_data_location:      "DW_AT_call_data_location",
+	DW_AT_call_data_value:         "DW_AT_call_data_value",
+	DW_AT_noreturn:                "DW_AT_noreturn",
+	DW_AT_alignment:               "DW_AT_alignment",
+	DW_AT_export_symbols:          "DW_AT_export_symbols",
+	DW_AT_deleted:                 "DW_AT_deleted",
+	DW_AT_defaulted:               "DW_AT_defaulted",
+	DW_AT_loclists_base:           "DW_AT_loclists_base",
+	0x2007:                        "DW_AT_MIPS_linkage_name",
+	0x2101:                        "DW_AT_sf_names",
+	0x2102:                        "DW_AT_src_info",
+	0x2103:                        "DW_AT_mac_info",
+	0x2104:                        "DW_AT_src_coords",
+	0x2105:                        "DW_AT_body_begin",
+	0x2106:                        "DW_AT_body_end",
+	0x2107:                        "DW_AT_GNU_vector",
+	0x2108:                        "DW_AT_GNU_guarded_by",
+	0x2109:                        "DW_AT_GNU_pt_guarded_by",
+	0x210a:                        "DW_AT_GNU_guarded",
+	0x210b:                        "DW_AT_GNU_pt_guarded",
+	0x210c:                        "DW_AT_GNU_locks_excluded",
+	0x210d:                        "DW_AT_GNU_exclusive_locks_required",
+	0x210e:                        "DW_AT_GNU_shared_locks_required",
+	0x210f:                        "DW_AT_GNU_odr_signature",
+	0x2110:                        "DW_AT_GNU_template_name",
+	0x2111:                        "DW_AT_GNU_call_site_value",
+	0x2112:                        "DW_AT_GNU_call_site_data_value",
+	0x2113:                        "DW_AT_GNU_call_site_target",
+	0x2114:                        "DW_AT_GNU_call_site_target_clobbered",
+	0x2115:                        "DW_AT_GNU_tail_call",
+	0x2116:                        "DW_AT_GNU_all_tail_call_sites",
+	0x2117:                        "DW_AT_GNU_all_call_sites",
+	0x2118:                        "DW_AT_GNU_all_source_call_sites",
+	0x2119:                        "DW_AT_GNU_macros",
+	0x211a:                        "DW_AT_GNU_deleted",
+	0x2130:                        "DW_AT_GNU_dwo_name",
+	0x2131:                        "DW_AT_GNU_dwo_id",
+	0x2132:                        "DW_AT_GNU_ranges_base",
+	0x2133:                        "DW_AT_GNU_addr_base",
+	0x2134:                        "DW_AT_GNU_pubnames",
+	0x2135:                        "DW_AT_GNU_pubtypes",
+	0x2136:                        "DW_AT_GNU_discriminator",
+	0x2137:                        "DW_AT_GNU_locviews",
+	0x2138:                        "DW_AT_GNU_entry_view",
+}
+
+var childrenNames = scalar.UintMapSymStr{
+	0x00: "DW_CHILDREN_no",
+	0x01: "DW_CHILDREN_yes",
+}
+
+const (
+	DW_FORM_addr           = 0x01
+	DW_FORM_block2         = 0x03
+	DW_FORM_block4         = 0x04
+	DW_FORM_data2          = 0x05
+	DW_FORM_data4          = 0x06
+	DW_FORM_data8          = 0x07
+	DW_FORM_string         = 0x08
+	DW_FORM_block          = 0x09
+	DW_FORM_block1         = 0x0a
+	DW_FORM_data1          = 0x0b
+	DW_FORM_flag           = 0x0c
+	DW_FORM_sdata          = 0x0d
+	DW_FORM_strp           = 0x0e
+	DW_FORM_udata          = 0x0f
+	DW_FORM_ref_addr       = 0x10
+	DW_FORM_ref1           = 0x11
+	DW_FORM_ref2           = 0x12
+	DW_FORM_ref4           = 0x13
+	DW_FORM_ref8           = 0x14
+	DW_FORM_ref_udata      = 0x15
+	DW_FORM_indirect       = 0x16
+	DW_FORM_sec_offset     = 0x17
+	DW_FORM_exprloc        = 0x18
+	DW_FORM_flag_present   = 0x19
+	DW_FORM_strx           = 0x1a
+	DW_FORM_addrx          = 0x1b
+	DW_FORM_ref_sup4       = 0x1c
+	DW_FORM_strp_sup       = 0x1d
+	DW_FORM_data16         = 0x1e
+	DW_FORM_line_strp      = 0x1f
+	DW_FORM_ref_sig8       = 0x20
+	DW_FORM_implicit_const = 0x21
+	DW_FORM_loclistx       = 0x22
+	DW_FORM_rnglistx       = 0x23
+	DW_FORM_ref_sup8       = 0x24
+	DW_FORM_strx1          = 0x25
+	DW_FORM_strx2          = 0x26
+	DW_FORM_strx3          = 0x27
+	DW_FORM_strx4          = 0x28
+	DW_FORM_addrx1         = 0x29
+	DW_FORM_addrx2         = 0x2a
+	DW_FORM_addrx3         = 0x2b
+	DW_FORM_addrx4         = 0x2c
+	DW_FORM_GNU_addr_index = 0x1f01
+	DW_FORM_GNU_str_index  = 0x1f02
+	DW_FORM_GNU_ref_alt    = 0x1f20
+	DW_FORM_GNU_strp_alt   = 0x1f21
+)
+
+var formNames = scalar.UintMapSymStr{
+	DW_FORM_addr:           "DW_FORM_addr",
+	DW_FORM_block2:         "DW_FORM_block2",
+	DW_FORM_block4:         "DW_FORM_block4",
+	DW_FORM_data2:          "DW_FORM_data2",
+	DW_FORM_data4:          "DW_FORM_data4",
+	DW_FORM_data8:          "DW_FORM_data8",
+	DW_FORM_string:         "DW_FORM_string",
+	DW_FORM_block:          "DW_FORM_block",
+	DW_FORM_block1:         "DW_FORM_block1",
+	DW_FORM_data1:          "DW_FORM_data1",
+	DW_FORM_flag:           "DW_FORM_flag",
+	DW_FORM_sdata:          "DW_FORM_sdata",
+	DW_FORM_strp:           "DW_FORM_strp",
+	DW_FORM_udata:          "DW_FORM_udata",
+	DW_FORM_ref_addr:       "DW_FORM_ref_addr",
+	DW_FORM_ref1:           "DW_FORM_ref1",
+	DW_FORM_ref2:           "DW_FORM_ref2",
+	DW_FORM_ref4:           "DW_FORM_ref4",
+	DW_FORM_ref8:           "DW_FORM_ref8",
+	DW_FORM_ref_udata:      "DW_FORM_ref_udata",
+	DW_FORM_indirect:       "DW_FORM_indirect",
+	DW_FORM_sec_offset:     "DW_FORM_sec_offset",
+	DW_FORM_exprloc:        "DW_FORM_exprloc",
+	DW_FORM_flag_present:   "DW_FORM_flag_present",
+	DW_FORM_strx:           "DW_FORM_strx",
+	DW_FORM_addrx:          "DW_FORM_addrx",
+	DW_FORM_ref_sup4:       "DW_FORM_ref_sup4",
+	DW_FORM_strp_sup:       "DW_FORM_strp_sup",
+	DW_FORM_data16:         "DW_FORM_data16",
+	DW_FORM_line_strp:      "DW_FORM_line_strp",
+	DW_FORM_ref_sig8:       "DW_FORM_ref_sig8",
+	DW_FORM_implicit_const: "DW_FORM_implicit_const",
+	DW_FORM_loclistx:       "DW_FORM_loclistx",
+	DW_FORM_rnglistx:       "DW_FORM_rnglistx",
+	DW_FORM_ref_sup8:       "DW_FORM_ref_sup8",
+	DW_FORM_strx1:          "DW_FORM_strx1",
+	DW_FORM_strx2:          "DW_FORM_strx2",
+	DW_FORM_strx3:          "DW_FORM_strx3",
+	DW_FORM_strx4:          "DW_FORM_strx4",
+	DW_FORM_addrx1:         "DW_FORM_addrx1",
+	DW_FORM_addrx2:         "DW_FORM_addrx2",
+	DW_FORM_addrx3:         "DW_FORM_addrx3",
+	DW_FORM_addrx4:         "DW_FORM_addrx4",
+	DW_FORM_GNU_addr_index: "DW_FORM_GNU_addr_index",
+	DW_FORM_GNU_str_index:  "DW_FORM_GNU_str_index",
+	DW_FORM_GNU_ref_alt:    "DW_FORM_GNU_ref_alt",
+	DW_FORM_GNU_strp_alt:   "DW_FORM_GNU_strp_alt",
+}
+
+var languageNames = scalar.UintMapSymStr{
+	0x01:   "DW_LANG_C89",
+	0x02:   "DW_LANG_C",
+	0x03:   "DW_LANG_Ada83",
+	0x04:   "DW_LANG_C_plus_plus",
+	0x05:   "DW_LANG_Cobol74",
+	0x06:   "DW_LANG_Cobol85",
+	0x07:   "DW_LANG_Fortran77",
+	0x08:   "DW_LANG_Fortran90",
+	0x09:   "DW_LANG_Pascal83",
+	0x0a:   "DW_LANG_Modula2",
+	0x0b:   "DW_LANG_Java",
+	0x0c:   "DW_LANG_C99",
+	0x0d:   "DW_LANG_Ada95",
+	0x0e:   "DW_LANG_Fortran95",
+	0x0f:   "DW_LANG_PLI",
+	0x10:   "DW_LANG_ObjC",
+	0x11:   "DW_LANG_ObjC_plus_plus",
+	0x12:   "DW_LANG_UPC",
+	0x13:   "DW_LANG_D",
+	0x14:   "DW_LANG_Python",
+	0x15:   "DW_LANG_OpenCL",
+	0x16:   "DW_LANG_Go",
+	0x17:   "DW_LANG_Modula3",
+	0x18:   "DW_LANG_Haskell",
+	0x19:   "DW_LANG_C_plus_plus_03",
+	0x1a:   "DW_LANG_C_plus_plus_11",
+	0x1b:   "DW_LANG_OCaml",
+	0x1c:   "DW_LANG_Rust",
+	0x1d:   "DW_LANG_C11",
+	0x1e:   "DW_LANG_Swift",
+	0x1f:   "DW_LANG_Julia",
+	0x20:   "DW_LANG_Dylan",
+	0x21:   "DW_LANG_C_plus_plus_14",
+	0x22:   "DW_LANG_Fortran03",
+	0x23:   "DW_LANG_Fortran08",
+	0x24:   "DW_LANG_RenderScript",
+	0x25:   "DW_LANG_BLISS",
+	0x8001: "DW_LANG_Mips_Assembler",
+}
+
+var encodingNames = scalar.UintMapSymStr{
+	0x01: "DW_ATE_address",
+	0x02: "DW_ATE_boolean",
+	0x03: "DW_ATE_complex_float",
+	0x04: "DW_ATE_float",
+	0x05: "DW_ATE_signed",
+	0x06: "DW_ATE_signed_char",
+	0x07: "DW_ATE_unsigned",
+	0x08: "DW_ATE_unsigned_char",
+	0x09: "DW_ATE_imaginary_float",
+	0x0a: "DW_ATE_packed_decimal",
+	0x0b: "DW_ATE_numeric_string",
+	0x0c: "DW_ATE_edited",
+	0x0d: "DW_ATE_signed_fixed",
+	0x0e: "DW_ATE_unsigned_fixed",
+	0x0f: "DW_ATE_decimal_float",
+	0x10: "DW_ATE_UTF",
+	0x11: "DW_ATE_UCS",
+	0x12: "DW_ATE_ASCII",
+}
+
+const (
+	DW_UT_compile       = 0x01
+	DW_UT_type          = 0x02
+	DW_UT_partial       = 0x03
+	DW_UT_skeleton      = 0x04
+	DW_UT_split_compile = 0x05
+	DW_UT_split_type    = 0x06
+)
+
+var unitTypeNames = scalar.UintMapSymStr{
+	DW_UT_compile:       "DW_UT_compile",
+	DW_UT_type:          "DW_UT_type",
+	DW_UT_partial:       "DW_UT_partial",
+	DW_UT_skeleton:      "DW_UT_skeleton",
+	DW_UT_split_compile: "DW_UT_split_compile",
+	DW_UT_split_type:    "DW_UT_split_type",
+}
+
+const (
+	DW_LNS_copy               = 0x01
+	DW_LNS_advance_pc         = 0x02
+	DW_LNS_advance_line       = 0x03
+	DW_LNS_set_file           = 0x04
+	DW_LNS_set_column         = 0x05
+	DW_LNS_negate_stmt        = 0x06
+	DW_LNS_set_basic_block    = 0x07
+	DW_LNS_const_add_pc       = 0x08
+	DW_LNS_fixed_advance_pc   = 0x09
+	DW_LNS_set_prologue_end   = 0x0a
+	DW_LNS_set_epilogue_begin = 0x0b
+	DW_LNS_set_isa            = 0x0c
+)
+
+var standardOpcodeNames = map[uint64]string{
+	DW_LNS_copy:               "DW_LNS_copy",
+	DW_LNS_advance_pc:         "DW_LNS_advance_pc",
+	DW_LNS_advance_line:       "DW_LNS_advance_line",
+	DW_LNS_set_file:           "DW_LNS_set_file",
+	DW_LNS_set_column:         "DW_LNS_set_column",
+	DW_LNS_negate_stmt:        "DW_LNS_negate_stmt",
+	DW_LNS_set_basic_block:    "DW_LNS_set_basic_block",
+	DW_LNS_const_add_pc:       "DW_LNS_const_add_pc",
+	DW_LNS_fixed_advance_pc:   "DW_LNS_fixed_advance_pc",
+	DW_LNS_set_prologue_end:   "DW_LNS_set_prologue_end",
+	DW_LNS_set_epilogue_begin: "DW_LNS_set_epilogue_begin",
+	DW_LNS_set_isa:            "DW_LNS_set_isa",
+}
+
+const (
+	DW_LNE_end_sequence      = 0x01
+	DW_LNE_set_address       = 0x02
+	DW_LNE_define_file       = 0x03
+	DW_LNE_set_discriminator = 0x04
+)
+
+var extendedOpcodeNames = scalar.UintMapSymStr{
+	DW_LNE_end_sequence:      "DW_LNE_end_sequence",
+	DW_LNE_set_address:       "DW_LNE_set_address",
+	DW_LNE_define_file:       "DW_LNE_define_file",
+	DW_LNE_set_discriminator: "DW_LNE_set_discriminator",
+}
+
+var lineContentTypeNames = scalar.UintMapSymStr{
+	0x01: "DW_LNCT_path",
+	0x02: "DW_LNCT_directory_index",
+	0x03: "DW_LNCT_timestamp",
+	0x04: "DW_LNCT_size",
+	0x05: "DW_LNCT_MD5",
+}
+
+const (
+	DW_CFA_advance_loc        = 0x40
+	DW_CFA_offset             = 0x80
+	DW_CFA_restore            = 0xc0
+	DW_CFA_nop                = 0x00
+	DW_CFA_set_loc            = 0x01
+	DW_CFA_advance_loc1       = 0x02
+	DW_CFA_advance_loc2       = 0x03
+	DW_CFA_advance_loc4       = 0x04
+	DW_CFA_offset_extended    = 0x05
+	DW_CFA_restore_extended   = 0x06
+	DW_CFA_undefined          = 0x07
+	DW_CFA_same_value         = 0x08
+	DW_CFA_register           = 0x09
+	DW_CFA_remember_state     = 0x0a
+	DW_CFA_restore_state      = 0x0b
+	DW_CFA_def_cfa            = 0x0c
+	DW_CFA_def_cfa_register   = 0x0d
+	DW_CFA_def_cfa_offset     = 0x0e
+	DW_CFA_def_cfa_expression = 0x0f
+	DW_CFA_expression         = 0x10
+	DW_CFA_offset_extended_sf = 0x11
+	DW_CFA_def_cfa_sf         = 0x12
+	DW_CFA_def_cfa_offset_sf  = 0x13
+	DW_CFA_val_offset         = 0x14
+	DW_CFA_val_offset_sf      = 0x15
+	DW_CFA_val_expression     = 0x16
+	DW_CFA_GNU_args_size      = 0x2e
+)
+
+var cfaOpcodeNames = map[uint64]string{
+	DW_CFA_nop:                "DW_CFA_nop",
+	DW_CFA_set_loc:            "DW_CFA_set_loc",
+	DW_CFA_advance_loc1:       "DW_CFA_advance_loc1",
+	DW_CFA_advance_loc2:       "DW_CFA_advance_loc2",
+	DW_CFA_advance_loc4:       "DW_CFA_advance_loc4",
+	DW_CFA_offset_extended:    "DW_CFA_offset_extended",
+	DW_CFA_restore_extended:   "DW_CFA_restore_extended",
+	DW_CFA_undefined:          "DW_CFA_undefined",
+	DW_CFA_same_value:         "DW_CFA_same_value",
+	DW_CFA_register:           "DW_CFA_register",
+	DW_CFA_remember_state:     "DW_CFA_remember_state",
+	DW_CFA_restore_state:      "DW_CFA_restore_state",
+	DW_CFA_def_cfa:            "DW_CFA_def_cfa",
+	DW_CFA_def_cfa_register:   "DW_CFA_def_cfa_register",
+	DW_CFA_def_cfa_offset:     "DW_CFA_def_cfa_offset",
+	DW_CFA_def_cfa_expression: "DW_CFA_def_cfa_expression",
+	DW_CFA_expression:         "DW_CFA_expression",
+	DW_CFA_offset_extended_sf: "DW_CFA_offset_extended_sf",
+	DW_CFA_def_cfa_sf:         "DW_CFA_def_cfa_sf",
+	DW_CFA_def_cfa_offset_sf:  "DW_CFA_def_cfa_offset_sf",
+	DW_CFA_val_offset:         "DW_CFA_val_offset",
+	DW_CFA_val_offset_sf:      "DW_CFA_val_offset_sf",
+	DW_CFA_val_expression:     "DW_CFA_val_expression",
+	DW_CFA_GNU_args_size:      "DW_CFA_GNU_args_size",
+}
+
+// high 2 bits are primary opcode with operand in low 6 bits
+var cfaOpcodeMapper = scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+	switch s.Actual & 0xc0 {
+	case DW_CFA_advance_loc:
+		s.Sym = "DW_CFA_advance_loc"
+	case DW_CFA_offset:
+		s.Sym = "DW_CFA_offset"
+	case DW_CFA_restore:
+		s.Sym = "DW_CFA_restore"
+	default:
+		if n, ok := cfaOpcodeNames[s.Actual]; ok {
+			s.Sym = n
+		}
+	}
+	return s, nil
+})
+
+// value mappers for constant attribute values
+var attributeValueMappers = map[uint64]scalar.UintMapper{
+	DW_AT_language: languageNames,
+	DW_AT_encoding: encodingNames,
+}
+
+func attributeName(at uint64) string {
+	if n, ok := attributeNames[at]; ok {
+		return n
+	}
+	return fmt.Sprintf("DW_AT_0x%x", at)
+}
+
+type abbrevAttribute struct {
+	name          uint64
+	form          uint64
+	implicitConst int64
+}
+
+type abbrev struct {
+	tag         uint64
+	hasChildren bool
+	attributes  []abbrevAttribute
+}
+
+type abbrevTable map[uint64]abbrev
+
+type dwarfContext struct {
+	byteOrder     binary.ByteOrder
+	abbrev        []byte
+	str           strTable
+	lineStr       strTable
+	strOffsets    []byte
+	abbrevTables  map[uint64]abbrevTable
+	sectionReader func(name string) []byte
+}
+
+type dwarfUnit struct {
+	start          int64
+	version        uint64
+	offsetSize     int
+	addressSize    int
+	strOffsetsBase uint64
+	abbrevs        abbrevTable
+}
+
+type byteReader struct {
+	b []byte
+	n int
+}
+
+func (r *byteReader) ReadByte() (byte, error) {
+	if r.n >= len(r.b) {
+		return 0, io.EOF
+	}
+	c := r.b[r.n]
+	r.n++
+	return c, nil
+}
+
+func (r *byteReader) sleb128() (int64, error) {
+	var v int64
+	var shift uint
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v |= int64(c&0x7f) << shift
+		shift += 7
+		if c&0x80 == 0 {
+			if shift < 64 && c&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return v, nil
+		}
+	}
+}
+
+// parse abbreviation table at offset, decoded as fields separately when decoding .debug_abbrev
+func parseAbbrevTable(b []byte, offset uint64) (abbrevTable, error) {
+	if offset > uint64(len(b)) {
+		return nil, fmt.Errorf("abbrev offset %d outside section", offset)
+	}
+	r := &byteReader{b: b, n: int(offset)}
+	t := abbrevTable{}
+	for {
+		code, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if code == 0 {
+			return t, nil
+		}
+		var a abbrev
+		if a.tag, err = binary.ReadUvarint(r); err != nil {
+			return nil, err
+		}
+		children, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		a.hasChildren = children != 0
+		for {
+			var as abbrevAttribute
+			if as.name, err = binary.ReadUvarint(r); err != nil {
+				return nil, err
+			}
+			if as.form, err = binary.ReadUvarint(r); err != nil {
+				return nil, err
+			}
+			if as.name == 0 && as.form == 0 {
+				break
+			}
+			if as.form == DW_FORM_implicit_const {
+				if as.implicitConst, err = r.sleb128(); err != nil {
+					return nil, err
+				}
+			}
+			a.attributes = append(a.attributes, as)
+		}
+		t[code] = a
+	}
+}
+
+func (dc *dwarfContext) abbrevTable(offset uint64) (abbrevTable, bool) {
+	if t, ok := dc.abbrevTables[offset]; ok {
+		return t, t != nil
+	}
+	t, err := parseAbbrevTable(dc.abbrev, offset)
+	if err != nil {
+		t = nil
+	}
+	dc.abbrevTables[offset] = t
+	return t, t != nil
+}
+
+// maps string index to string using .debug_str_offsets and .debug_str
+func (dc *dwarfContext) strxMapper(u *dwarfUnit) scalar.UintMapper {
+	return scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+		off := u.strOffsetsBase + s.Actual*uint64(u.offsetSize)
+		if off+uint64(u.offsetSize) > uint64(len(dc.strOffsets)) {
+			return s, nil
+		}
+		var strOff uint64
+		if u.offsetSize == 8 {
+			strOff = dc.byteOrder.Uint64(dc.strOffsets[off:])
+		} else {
+			strOff = uint64(dc.byteOrder.Uint32(dc.strOffsets[off:]))
+		}
+		s.Sym = strIndexNull(int(strOff), string(dc.str))
+		return s, nil
+	})
+}
+
+// section content decompressed if needed, nil if missing or failed
+func elfReadSectionBytes(d *decode.D, ec *elfContext, sh sectionHeader) []byte {
+	if sh.typ == SHT_NOBITS || sh.size/8 > maxStrTabSize {
+		return nil
+	}
+	br, err := d.TryBitBufRange(sh.offset, sh.size)
+	if err != nil {
+		return nil
+	}
+	b := d.ReadAllBits(br)
+	if sh.flags&SHF_COMPRESSED == 0 {
+		return b
+	}
+
+	var chType uint64
+	var chSize uint64
+	var chLen int
+	byteOrder := ec.byteOrder()
+	switch ec.archBits {
+	case 32:
+		if len(b) < 12 {
+			return nil
+		}
+		chType = uint64(byteOrder.Uint32(b[0:]))
+		chSize = uint64(byteOrder.Uint32(b[4:]))
+		chLen = 12
+	case 64:
+		if len(b) < 24 {
+			return nil
+		}
+		chType = uint64(byteOrder.Uint32(b[0:]))
+		chSize = byteOrder.Uint64(b[8:])
+		chLen = 24
+	}
+	if chSize > maxStrTabSize {
+		return nil
+	}
+	ub, err := decompressSection(chType, b[chLen:], chSize)
+	if err != nil {
+		return nil
+	}
+	return ub
+}
+
+// deflate can at most compress about 1032:1, also used for zstd as a sanity limit
+const maxCompressionRatio = 1032
+
+// decompress section content, size is the uncompressed size from the compression
+// header and decompressed data is limited by it and by the compressed size
+func decompressSection(chType uint64, b []byte, size uint64) ([]byte, error) {
+	if maxSize := uint64(len(b)) * maxCompressionRatio; size > maxSize {
+		return nil, fmt.Errorf("uncompressed size %d larger than max %d", size, maxSize)
+	}
+
+	var zr io.Reader
+	switch chType {
+	case ELFCOMPRESS_ZLIB:
+		zlibR, err := zlib.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer zlibR.Close()
+		zr = zlibR
+	case ELFCOMPRESS_ZSTD:
+		zstdR, err := zstdlib.NewReader(bytes.NewReader(b), zstdlib.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		defer zstdR.Close()
+		zr = zstdR
+	default:
+		return nil, fmt.Errorf("unknown compression type %d", chType)
+	}
+
+	// read one more byte to know if there is more data than size
+	ub, err := io.ReadAll(io.LimitReader(zr, int64(size)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(ub)) > size {
+		return nil, fmt.Errorf("uncompressed data larger than size %d", size)
+	}
+	return ub, nil
+}
+
+func elfReadDWARFContext(d *decode.D, ec *elfContext, shStrTab string) {
+	ec.dwarf = &dwarfContext{
+		byteOrder:    ec.byteOrder(),
+		abbrevTables: map[uint64]abbrevTable{},
+	}
+	for _, sh := range ec.sections {
+		switch strIndexNull(sh.name, shStrTab) {
+		case ".debug_abbrev":
+			ec.dwarf.abbrev = elfReadSectionBytes(d, ec, sh)
+		case ".debug_str":
+			ec.dwarf.str = strTable(elfReadSectionBytes(d, ec, sh))
+		case ".debug_line_str":
+			ec.dwarf.lineStr = strTable(elfReadSectionBytes(d, ec, sh))
+		case ".debug_str_offsets":
+			ec.dwarf.strOffsets = elfReadSectionBytes(d, ec, sh)
+		}
+	}
+}
+
+var dwarfSectionDecoders = map[string]func(d *decode.D, ec elfContext){
+	".debug_abbrev":      dwarfDecodeAbbrev,
+	".debug_aranges":     dwarfDecodeAranges,
+	".debug_frame":       dwarfDecodeFrame,
+	".debug_info":        dwarfDecodeInfo,
+	".debug_line":        dwarfDecodeLine,
+	".debug_line_str":    dwarfDecodeStr,
+	".debug_str":         dwarfDecodeStr,
+	".debug_str_offsets": dwarfDecodeStrOffsets,
+}
+
+func elfDecodeCompressedSection(d *decode.D, ec elfContext, name string) {
+	var chType uint64
+	var chSize uint64
+	d.FieldStruct("compression_header", func(d *decode.D) {
+		chType = d.FieldU32("type", compressionTypeNames)
+		if ec.archBits == 64 {
+			d.FieldU32("reserved")
+		}
+		chSize = d.FieldU("size", ec.archBits)
+		d.FieldU("addralign", ec.archBits)
+	})
+	compressedBR := d.FieldRawLen("compressed", d.BitsLeft())
+
+	b, err := decompressSection(chType, d.ReadAllBits(compressedBR), chSize)
+	if err != nil {
+		return
+	}
+	uncompressedBR := bitio.NewBitReader(b, -1)
+	if fn, ok := dwarfSectionDecoders[name]; ok {
+		if _, err := d.TryFieldStructRootBitBufFn("uncompressed", uncompressedBR, func(d *decode.D) {
+			fn(d, ec)
+		}); err != nil {
+			d.FieldRootBitBuf("uncompressed", uncompressedBR)
+			d.FieldGet("uncompressed").Err = err
+		}
+	} else {
+		d.FieldRootBitBuf("uncompressed", uncompressedBR)
+	}
+}
+
+// keep section data that failed to decode as raw bits with the error
+func dwarfFieldFailedData(d *decode.D, name string, nBits int64, err error) {
+	d.FieldRawLen(name, nBits)
+	d.FieldGet(name).Err = err
+}
+
+// returns unit length in bits and offset size in bytes
+func dwarfFieldUnitLength(d *decode.D) (int64, int) {
+	length := d.FieldU32("unit_length", scalar.UintMapDescription{0xffff_ffff: "64-bit DWARF"})
+	if length == 0xffff_ffff {
+		return int64(d.FieldU64("unit_length64")) * 8, 8
+	}
+	return int64(length) * 8, 4
+}
+
+// decode length prefixed units, truncated units are decoded as raw data
+func dwarfDecodeUnits(d *decode.D, name string, fn func(d *decode.D, offsetSize int)) {
+	d.FieldArray(name+"s", func(d *decode.D) {
+		for d.BitsLeft() >= 32 {
+			d.FieldStruct(name, func(d *decode.D) {
+				length, offsetSize := dwarfFieldUnitLength(d)
+				if length > d.BitsLeft() {
+					d.FieldRawLen("data", d.BitsLeft())
+					return
+				}
+				d.FramedFn(length, func(d *decode.D) {
+					fn(d, offsetSize)
+				})
+			})
+		}
+	})
+	if d.BitsLeft() > 0 {
+		d.FieldRawLen("padding", d.BitsLeft())
+	}
+}
+
+func dwarfDecodeStr(d *decode.D, ec elfContext) {
+	d.FieldArray("strings", func(d *decode.D) {
+		for !d.End() {
+			d.FieldUTF8Null("string")
+		}
+	})
+}
+
+func dwarfDecodeStrOffsets(d *decode.D, ec elfContext) {
+	dwarfDecodeUnits(d, "unit", func(d *decode.D, offsetSize int) {
+		d.FieldU16("version")
+		d.FieldU16("padding")
+		d.FieldArray("offsets", func(d *decode.D) {
+			for !d.End() {
+				d.FieldU("offset", offsetSize*8, ec.dwarf.str)
+			}
+		})
+	})
+}
+
+func dwarfDecodeAbbrev(d *decode.D, ec elfContext) {
+	sectionStart := d.Pos()
+	d.FieldArray("tables", func(d *decode.D) {
+		for !d.End() {
+			d.FieldStruct("table", func(d *decode.D) {
+				d.FieldValueUint("offset", uint64((d.Pos()-sectionStart)/8), scalar.UintHex)
+				d.FieldArray("abbreviations", func(d *decode.D) {
+					for !d.End() {
+						code := uint64(0)
+						d.FieldStruct("abbreviation", func(d *decode.D) {
+							code = d.FieldULEB128("code")
+							if code == 0 {
+								return
+							}
+							d.FieldULEB128("tag", tagNames)
+							d.FieldU8("children", childrenNames)
+							d.FieldArray("attributes", func(d *decode.D) {
+								for {
+									var name, form uint64
+									d.FieldStruct("attribute", func(d *decode.D) {
+										name = d.FieldULEB128("name", attributeNames)
+										form = d.FieldULEB128("form", formNames)
+										if form == DW_FORM_implicit_const {
+											d.FieldSLEB128("implicit_const")
+										}
+									})
+									if name == 0 && form == 0 {
+										break
+									}
+								}
+							})
+						})
+						if code == 0 {
+							break
+						}
+					}
+				})
+			})
+		}
+	})
+}
+
+// read form value without adding fields, used to find attributes before decoding
+func dwarfReadForm(d *decode.D, u *dwarfUnit, form uint64, implicitConst int64) (uint64, bool) {
+	switch form {
+	case DW_FORM_addr:
+		return d.U(u.addressSize * 8), true
+	case DW_FORM_block1:
+		d.SeekRel(int64(d.U8()) * 8)
+	case DW_FORM_block2:
+		d.SeekRel(int64(d.U16()) * 8)
+	case DW_FORM_block4:
+		d.SeekRel(int64(d.U32()) * 8)
+	case DW_FORM_block, DW_FORM_exprloc:
+		d.SeekRel(int64(d.ULEB128()) * 8)
+	case DW_FORM_data1, DW_FORM_ref1, DW_FORM_flag, DW_FORM_strx1, DW_FORM_addrx1:
+		return d.U8(), true
+	case DW_FORM_data2, DW_FORM_ref2, DW_FORM_strx2, DW_FORM_addrx2:
+		return d.U16(), true
+	case DW_FORM_strx3, DW_FORM_addrx3:
+		return d.U24(), true
+	case DW_FORM_data4, DW_FORM_ref4, DW_FORM_ref_sup4, DW_FORM_strx4, DW_FORM_addrx4:
+		return d.U32(), true
+	case DW_FORM_data8, DW_FORM_ref8, DW_FORM_ref_sig8, DW_FORM_ref_sup8:
+		return d.U64(), true
+	case DW_FORM_data16:
+		d.SeekRel(128)
+	case DW_FORM_string:
+		d.UTF8Null()
+	case DW_FORM_sdata:
+		return uint64(d.SLEB128()), true
+	case DW_FORM_udata, DW_FORM_ref_udata, DW_FORM_strx, DW_FORM_addrx, DW_FORM_loclistx, DW_FORM_rnglistx,
+		DW_FORM_GNU_addr_index, DW_FORM_GNU_str_index:
+		return d.ULEB128(), true
+	case DW_FORM_strp, DW_FORM_line_strp, DW_FORM_sec_offset, DW_FORM_strp_sup,
+		DW_FORM_GNU_ref_alt, DW_FORM_GNU_strp_alt:
+		return d.U(u.offsetSize * 8), true
+	case DW_FORM_ref_addr:
+		// DWARF 2 used address size
+		if u.version <= 2 {
+			return d.U(u.addressSize * 8), true
+		}
+		return d.U(u.offsetSize * 8), true
+	case DW_FORM_flag_present:
+		return 1, true
+	case DW_FORM_implicit_const:
+		return uint64(implicitConst), true
+	case DW_FORM_indirect:
+		return dwarfReadForm(d, u, d.ULEB128(), implicitConst)
+	default:
+		return 0, false
+	}
+	return 0, true
+}
+
+func dwarfFieldBlock(d *decode.D, name string, lengthFn func(d *decode.D) uint64) {
+	d.FieldStruct(name, func(d *decode.D) {
+		length := lengthFn(d)
+		d.FieldRawLen("data", int64(length)*8)
+	})
+}
+
+// add attribute value field, false if form is unknown
+func dwarfFieldForm(d *decode.D, dc *dwarfContext, u *dwarfUnit, name string, form uint64, implicitConst int64, vm scalar.UintMapper) bool {
+	sms := []scalar.UintMapper{}
+	if vm != nil {
+		sms = append(sms, vm)
+	}
+
+	switch form {
+	case DW_FORM_addr:
+		d.FieldU(name, u.addressSize*8, scalar.UintHex)
+	case DW_FORM_block1:
+		dwarfFieldBlock(d, name, func(d *decode.D) uint64 { return d.FieldU8("length") })
+	case DW_FORM_block2:
+		dwarfFieldBlock(d, name, func(d *decode.D) uint64 { return d.FieldU16("length") })
+	case DW_FORM_block4:
+		dwarfFieldBlock(d, name, func(d *decode.D) uint64 { return d.FieldU32("length") })
+	case DW_FORM_block, DW_FORM_exprloc:
+		dwarfFieldBlock(d, name, func(d *decode.D) uint64 { return d.FieldULEB128("length") })
+	case DW_FORM_data1:
+		d.FieldU8(name, sms...)
+	case DW_FORM_data2:
+		d.FieldU16(name, sms...)
+	case DW_FORM_data4:
+		d.FieldU32(name, sms...)
+	case DW_FORM_data8:
+		d.FieldU64(name, sms...)
+	case DW_FORM_data16:
+		d.FieldRawLen(name, 128, scalar.RawHex)
+	case DW_FORM_sdata:
+		d.FieldSLEB128(name)
+	case DW_FORM_udata:
+		d.FieldULEB128(name, sms...)
+	case DW_FORM_implicit_const:
+		d.FieldValueSint(name, implicitConst)
+	case DW_FORM_flag:
+		d.FieldU8(name)
+	case DW_FORM_flag_present:
+		d.FieldValueBool(name, true)
+	case DW_FORM_string:
+		d.FieldUTF8Null(name)
+	case DW_FORM_strp:
+		d.FieldU(name, u.offsetSize*8, dc.str)
+	case DW_FORM_line_strp:
+		d.FieldU(name, u.offsetSize*8, dc.lineStr)
+	case DW_FORM_strp_sup, DW_FORM_GNU_strp_alt:
+		d.FieldU(name, u.offsetSize*8, scalar.UintHex)
+	case DW_FORM_strx, DW_FORM_GNU_str_index:
+		d.FieldULEB128(name, dc.strxMapper(u))
+	case DW_FORM_strx1:
+		d.FieldU8(name, dc.strxMapper(u))
+	case DW_FORM_strx2:
+		d.FieldU16(name, dc.strxMapper(u))
+	case DW_FORM_strx3:
+		d.FieldU24(name, dc.strxMapper(u))
+	case DW_FORM_strx4:
+		d.FieldU32(name, dc.strxMapper(u))
+	case DW_FORM_ref1:
+		d.FieldU8(name, scalar.UintHex)
+	case DW_FORM_ref2:
+		d.FieldU16(name, scalar.UintHex)
+	case DW_FORM_ref4, DW_FORM_ref_sup4:
+		d.FieldU32(name, scalar.UintHex)
+	case DW_FORM_ref8, DW_FORM_ref_sig8, DW_FORM_ref_sup8:
+		d.FieldU64(name, scalar.UintHex)
+	case DW_FORM_ref_udata:
+		d.FieldULEB128(name, scalar.UintHex)
+	case DW_FORM_ref_addr:
+		if u.version <= 2 {
+			d.FieldU(name, u.addressSize*8, scalar.UintHex)
+		} else {
+			d.FieldU(name, u.offsetSize*8, scalar.UintHex)
+		}
+	case DW_FORM_sec_offset, DW_FORM_GNU_ref_alt:
+		d.FieldU(name, u.offsetSize*8, scalar.UintHex)
+	case DW_FORM_addrx, DW_FORM_loclistx, DW_FORM_rnglistx, DW_FORM_GNU_addr_index:
+		d.FieldULEB128(name)
+	case DW_FORM_addrx1:
+		d.FieldU8(name)
+	case DW_FORM_addrx2:
+		d.FieldU16(name)
+	case DW_FORM_addrx3:
+		d.FieldU24(name)
+	case DW_FORM_addrx4:
+		d.FieldU32(name)
+	case DW_FORM_indirect:
+		ok := true
+		d.FieldStruct(name, func(d *decode.D) {
+			form := d.FieldULEB128("form", formNames)
+			ok = dwarfFieldForm(d, dc, u, "value", form, implicitConst, vm)
+		})
+		return ok
+	default:
+		return false
+	}
+	return true
+}
+
+// find DW_AT_str_offsets_base in first DIE, needed to resolve strx forms
+func dwarfReadStrOffsetsBase(d *decode.D, u *dwarfUnit) uint64 {
+	// default is just after the header of the first .debug_str_offsets unit
+	base := uint64(8)
+	d.SeekRel(0, func(d *decode.D) {
+		code := d.ULEB128()
+		a, ok := u.abbrevs[code]
+		if !ok {
+			return
+		}
+		for _, as := range a.attributes {
+			v, ok := dwarfReadForm(d, u, as.form, as.implicitConst)
+			if !ok {
+				return
+			}
+			if as.name == DW_AT_str_offsets_base {
+				base = v
+				return
+			}
+		}
+	})
+	return base
+}
+
+// dwarfFieldNames makes field names unique, the same attribute or content type
+// can appear more than once in invalid or vendor specific data
+type dwarfFieldNames map[string]int
+
+func (n dwarfFieldNames) name(name string) string {
+	c := n[name]
+	n[name] = c + 1
+	if c == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s_%d", name, c)
+}
+
+// decode DIE, returns false if unknown abbreviation or form was found
+func dwarfDecodeDIE(d *decode.D, dc *dwarfContext, u *dwarfUnit) (isNull bool, ok bool) {
+	ok = true
+	d.FieldStruct("die", func(d *decode.D) {
+		// unit relative offset used by DW_FORM_ref* forms
+		d.FieldValueUint("offset", uint64((d.Pos()-u.start)/8), scalar.UintHex)
+		code := d.FieldULEB128("abbrev_code")
+		if code == 0 {
+			isNull = true
+			return
+		}
+		a, found := u.abbrevs[code]
+		if !found {
+			d.FieldRawLen("unknown", d.BitsLeft())
+			ok = false
+			return
+		}
+		d.FieldValueUint("tag", a.tag, tagNames)
+
+		if len(a.attributes) > 0 {
+			d.FieldStruct("attributes", func(d *decode.D) {
+				names := dwarfFieldNames{}
+				for _, as := range a.attributes {
+					if !dwarfFieldForm(d, dc, u, names.name(attributeName(as.name)), as.form, as.implicitConst, attributeValueMappers[as.name]) {
+						d.FieldRawLen("unknown", d.BitsLeft())
+						ok = false
+						return
+					}
+				}
+			})
+		}
+		if !ok || !a.hasChildren {
+			return
+		}
+
+		d.FieldArray("children", func(d *decode.D) {
+			for !d.End() {
+				var isNull bool
+				isNull, ok = dwarfDecodeDIE(d, dc, u)
+				if isNull || !ok {
+					return
+				}
+			}
+		})
+	})
+	return isNull, ok
+}
+
+func dwarfDecodeInfo(d *decode.D, ec elfContext) {
+	dc := ec.dwarf
+	dwarfDecodeUnits(d, "unit", func(d *decode.D, offsetSize int) {
+		u := &dwarfUnit{offsetSize: offsetSize, start: d.Pos() - 32}
+		if offsetSize == 8 {
+			u.start -= 64
+		}
+		var abbrevOffset uint64
+		u.version = d.FieldU16("version")
+		if u.version >= 5 {
+			unitType := d.FieldU8("unit_type", unitTypeNames)
+			u.addressSize = int(d.FieldU8("address_size"))
+			abbrevOffset = d.FieldU("debug_abbrev_offset", offsetSize*8, scalar.UintHex)
+			switch unitType {
+			case DW_UT_skeleton, DW_UT_split_compile:
+				d.FieldU64("dwo_id", scalar.UintHex)
+			case DW_UT_type, DW_UT_split_type:
+				d.FieldU64("type_signature", scalar.UintHex)
+				d.FieldU("type_offset", offsetSize*8, scalar.UintHex)
+			}
+		} else {
+			abbrevOffset = d.FieldU("debug_abbrev_offset", offsetSize*8, scalar.UintHex)
+			u.addressSize = int(d.FieldU8("address_size"))
+		}
+
+		var ok bool
+		u.abbrevs, ok = dc.abbrevTable(abbrevOffset)
+		if !ok {
+			d.FieldRawLen("data", d.BitsLeft())
+			return
+		}
+		u.strOffsetsBase = dwarfReadStrOffsetsBase(d, u)
+
+		d.FieldArray("dies", func(d *decode.D) {
+			for !d.End() {
+				if _, ok := dwarfDecodeDIE(d, dc, u); !ok {
+					return
+				}
+			}
+		})
+	})
+}
+
+func dwarfDecodeLineUnit(d *decode.D, dc *dwarfContext, offsetSize int, archBits int) {
+	u := &dwarfUnit{offsetSize: offsetSize, addressSize: archBits / 8}
+	u.version = d.FieldU16("version")
+	if u.version >= 5 {
+		u.addressSize = int(d.FieldU8("address_size"))
+		d.FieldU8("segment_selector_size")
+	}
+	headerLength := d.FieldU("header_length", offsetSize*8)
+	programStart := d.Pos() + int64(headerLength)*8
+	minInstLength := d.FieldU8("minimum_instruction_length")
+	if u.version >= 4 {
+		d.FieldU8("maximum_operations_per_instruction")
+	}
+	d.FieldU8("default_is_stmt")
+	lineBase := d.FieldS8("line_base")
+	lineRange := d.FieldU8("line_range")
+	opcodeBase := d.FieldU8("opcode_base")
+	var opcodeLengths []uint64
+	d.FieldArray("standard_opcode_lengths", func(d *decode.D) {
+		for i := uint64(1); i < opcodeBase; i++ {
+			opcodeLengths = append(opcodeLengths, d.FieldU8("length"))
+		}
+	})
+
+	if u.version >= 5 {
+		// rest of header is decoded as unknown if an unknown form is found
+		if dwarfDecodeLineEntries(d, dc, u, "directory_entry_format", "directories", "directory") {
+			dwarfDecodeLineEntries(d, dc, u, "file_name_entry_format", "file_names", "file_name")
+		}
+	} else {
+		d.FieldArray("include_directories", func(d *decode.D) {
+			for d.PeekUintBits(8) != 0 {
+				d.FieldUTF8Null("include_directory")
+			}
+		})
+		d.FieldU8("include_directories_end")
+		d.FieldArray("file_names", func(d *decode.D) {
+			for d.PeekUintBits(8) != 0 {
+				d.FieldStruct("file_name", dwarfDecodeLineFileEntry)
+			}
+		})
+		d.FieldU8("file_names_end")
+	}
+
+	if d.Pos() < programStart {
+		d.FieldRawLen("header_unknown", programStart-d.Pos())
+	}
+	d.SeekAbs(programStart)
+
+	if lineRange == 0 {
+		d.FieldRawLen("program", d.BitsLeft())
+		return
+	}
+
+	var address uint64
+	var line int64
+	reset := func() {
+		address = 0
+		line = 1
+	}
+	reset()
+	row := func(d *decode.D) {
+		d.FieldValueUint("address", address, scalar.UintHex)
+		d.FieldValueSint("line", line)
+	}
+
+	opcodeMapper := scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+		switch {
+		case s.Actual == 0:
+			s.Description = "extended"
+		case s.Actual >= opcodeBase:
+			s.Description = "special"
+		default:
+			if n, ok := standardOpcodeNames[s.Actual]; ok {
+				s.Sym = n
+			}
+		}
+		return s, nil
+	})
+
+	d.FieldArray("program", func(d *decode.D) {
+		for !d.End() {
+			d.FieldStruct("instruction", func(d *decode.D) {
+				opcode := d.FieldU8("opcode", opcodeMapper)
+				switch {
+				case opcode >= opcodeBase:
+					adjusted := opcode - opcodeBase
+					address += minInstLength * (adjusted / lineRange)
+					line += lineBase + int64(adjusted%lineRange)
+					row(d)
+				case opcode == 0:
+					length := d.FieldULEB128("length")
+					d.FramedFn(int64(length)*8, func(d *decode.D) {
+						if length == 0 {
+							return
+						}
+						switch d.FieldU8("extended_opcode", extendedOpcodeNames) {
+						case DW_LNE_end_sequence:
+							row(d)
+							reset()
+						case DW_LNE_set_address:
+							address = d.FieldU("address", int(length-1)*8, scalar.UintHex)
+						case DW_LNE_define_file:
+							dwarfDecodeLineFileEntry(d)
+						case DW_LNE_set_discriminator:
+							d.FieldULEB128("discriminator")
+						default:
+							d.FieldRawLen("data", d.BitsLeft())
+						}
+					})
+				case opcode == DW_LNS_copy:
+					row(d)
+				case opcode == DW_LNS_advance_pc:
+					address += minInstLength * d.FieldULEB128("operation_advance")
+				case opcode == DW_LNS_advance_line:
+					line += d.FieldSLEB128("line_advance")
+				case opcode == DW_LNS_set_file:
+					d.FieldULEB128("file")
+				case opcode == DW_LNS_set_column:
+					d.FieldULEB128("column")
+				case opcode == DW_LNS_const_add_pc:
+					address += minInstLength * ((255 - opcodeBase) / lineRange)
+				case opcode == DW_LNS_fixed_advance_pc:
+					address += d.FieldU16("operation_advance")
+				case opcode == DW_LNS_set_isa:
+					d.FieldULEB128("isa")
+				case opcode > DW_LNS_set_isa:
+					// unknown standard opcode, skip operands
+					d.FieldArray("operands", func(d *decode.D) {
+						for i := uint64(0); i < opcodeLengths[opcode-1]; i++ {
+							d.FieldULEB128("operand")
+						}
+					})
+				}
+			})
+		}
+	})
+}
+
+func dwarfDecodeLineFileEntry(d *decode.D) {
+	d.FieldUTF8Null("name")
+	d.FieldULEB128("directory_index")
+	d.FieldULEB128("modification_time")
+	d.FieldULEB128("length")
+}
+
+// DWARF 5 directory and file name tables described by entry formats, returns
+// false if an unknown form was found
+func dwarfDecodeLineEntries(d *decode.D, dc *dwarfContext, u *dwarfUnit, formatName string, entriesName string, entryName string) (ok bool) {
+	ok = true
+	type entryFormat struct{ contentType, form uint64 }
+	var formats []entryFormat
+	formatCount := d.FieldU8(formatName + "_count")
+	d.FieldArray(formatName, func(d *decode.D) {
+		for i := uint64(0); i < formatCount; i++ {
+			d.FieldStruct("format", func(d *decode.D) {
+				contentType := d.FieldULEB128("content_type", lineContentTypeNames)
+				form := d.FieldULEB128("form", formNames)
+				formats = append(formats, entryFormat{contentType: contentType, form: form})
+			})
+		}
+	})
+	count := d.FieldULEB128(entriesName + "_count")
+	d.FieldArray(entriesName, func(d *decode.D) {
+		for i := uint64(0); i < count; i++ {
+			d.FieldStruct(entryName, func(d *decode.D) {
+				names := dwarfFieldNames{}
+				for _, f := range formats {
+					name := fmt.Sprintf("DW_LNCT_0x%x", f.contentType)
+					if n, ok := lineContentTypeNames[f.contentType]; ok {
+						name = n
+					}
+					if !dwarfFieldForm(d, dc, u, names.name(name), f.form, 0, nil) {
+						ok = false
+						return
+					}
+				}
+			})
+			if !ok {
+				return
+			}
+		}
+	})
+	return ok
+}
+
+func dwarfDecodeLine(d *decode.D, ec elfContext) {
+	dwarfDecodeUnits(d, "unit", func(d *decode.D, offsetSize int) {
+		dwarfDecodeLineUnit(d, ec.dwarf, offsetSize, ec.archBits)
+	})
+}
+
+func dwarfDecodeAranges(d *decode.D, ec elfContext) {
+	dwarfDecodeUnits(d, "set", func(d *decode.D, offsetSize int) {
+		// tuples are aligned to tuple size relative to set start
+		headerSize := int64(4 + 2 + offsetSize + 2)
+		if offsetSize == 8 {
+			headerSize += 8
+		}
+		d.FieldU16("version")
+		d.FieldU("debug_info_offset", offsetSize*8, scalar.UintHex)
+		addressSize := int(d.FieldU8("address_size"))
+		segmentSize := int(d.FieldU8("segment_selector_size"))
+		tupleSize := int64(segmentSize + 2*addressSize)
+		if tupleSize == 0 {
+			d.FieldRawLen("data", d.BitsLeft())
+			return
+		}
+		if padding := (tupleSize - headerSize%tupleSize) % tupleSize; padding > 0 {
+			d.FieldRawLen("padding", padding*8)
+		}
+		d.FieldArray("tuples", func(d *decode.D) {
+			for d.BitsLeft() >= tupleSize*8 {
+				d.FieldStruct("tuple", func(d *decode.D) {
+					if segmentSize > 0 {
+						d.FieldU("segment", segmentSize*8)
+					}
+					d.FieldU("address", addressSize*8, scalar.UintHex)
+					d.FieldU("length", addressSize*8)
+				})
+			}
+		})
+	})
+}
+
+func dwarfDecodeCFAInstructions(d *decode.D, addressSize int) {
+	d.FieldArray("instructions", func(d *decode.D) {
+		for !d.End() {
+			d.FieldStruct("instruction", func(d *decode.D) {
+				opcode := d.FieldU8("opcode", cfaOpcodeMapper, scalar.UintHex)
+				switch opcode & 0xc0 {
+				case DW_CFA_advance_loc:
+					d.FieldValueUint("delta", opcode&0x3f)
+					return
+				case DW_CFA_offset:
+					d.FieldValueUint("register", opcode&0x3f)
+					d.FieldULEB128("offset")
+					return
+				case DW_CFA_restore:
+					d.FieldValueUint("register", opcode&0x3f)
+					return
+				}
+
+				switch opcode {
+				case DW_CFA_nop, DW_CFA_remember_state, DW_CFA_restore_state:
+				case DW_CFA_set_loc:
+					d.FieldU("address", addressSize*8, scalar.UintHex)
+				case DW_CFA_advance_loc1:
+					d.FieldU8("delta")
+				case DW_CFA_advance_loc2:
+					d.FieldU16("delta")
+				case DW_CFA_advance_loc4:
+					d.FieldU32("delta")
+				case DW_CFA_offset_extended, DW_CFA_val_offset:
+					d.FieldULEB128("register")
+					d.FieldULEB128("offset")
+				case DW_CFA_restore_extended, DW_CFA_undefined, DW_CFA_same_value, DW_CFA_def_cfa_register:
+					d.FieldULEB128("register")
+				case DW_CFA_register:
+					d.FieldULEB128("register")
+					d.FieldULEB128("register2")
+				case DW_CFA_def_cfa:
+					d.FieldULEB128("register")
+					d.FieldULEB128("offset")
+				case DW_CFA_def_cfa_offset, DW_CFA_GNU_args_size:
+					d.FieldULEB128("offset")
+				case DW_CFA_def_cfa_expression:
+					length := d.FieldULEB128("length")
+					d.FieldRawLen("expression", int64(length)*8)
+				case DW_CFA_expression, DW_CFA_val_expression:
+					d.FieldULEB128("register")
+					length := d.FieldULEB128("length")
+					d.FieldRawLen("expression", int64(length)*8)
+				case DW_CFA_offset_extended_sf, DW_CFA_val_offset_sf, DW_CFA_def_cfa_sf:
+					d.FieldULEB128("register")
+					d.FieldSLEB128("offset")
+				case DW_CFA_def_cfa_offset_sf:
+					d.FieldSLEB128("offset")
+				default:
+					d.FieldRawLen("unknown", d.BitsLeft())
+				}
+			})
+		}
+	})
+}
+
+func dwarfDecodeFrame(d *decode.D, ec elfContext) {
+	addressSize := ec.archBits / 8
+	d.FieldArray("entries", func(d *decode.D) {
+		for d.BitsLeft() >= 32 {
+			var id uint64
+			var offsetSize int
+			d.SeekRel(0, func(d *decode.D) {
+				offsetSize = 4
+				if d.U32() == 0xffff_ffff {
+					d.U64()
+					offsetSize = 8
+				}
+				if d.BitsLeft() >= int64(offsetSize*8) {
+					id = d.U(offsetSize * 8)
+				}
+			})
+			isCIE := (offsetSize == 4 && id == 0xffff_ffff) || (offsetSize == 8 && id == 0xffff_ffff_ffff_ffff)
+
+			name := "fde"
+			if isCIE {
+				name = "cie"
+			}
+			d.FieldStruct(name, func(d *decode.D) {
+				length, offsetSize := dwarfFieldUnitLength(d)
+				if length > d.BitsLeft() {
+					d.FieldRawLen("data", d.BitsLeft())
+					return
+				}
+				d.FramedFn(length, func(d *decode.D) {
+					if d.End() {
+						return
+					}
+					if isCIE {
+						d.FieldU("cie_id", offsetSize*8, scalar.UintHex)
+						version := d.FieldU8("version")
+						augmentation := d.FieldUTF8Null("augmentation")
+						if version >= 4 {
+							addressSize = int(d.FieldU8("address_size"))
+							d.FieldU8("segment_selector_size")
+						}
+						d.FieldULEB128("code_alignment_factor")
+						d.FieldSLEB128("data_alignment_factor")
+						if version == 1 {
+							d.FieldU8("return_address_register")
+						} else {
+							d.FieldULEB128("return_address_register")
+						}
+						if augmentation != "" {
+							// augmentation data is only known for .eh_frame
+							d.FieldRawLen("unknown", d.BitsLeft())
+							return
+						}
+						dwarfDecodeCFAInstructions(d, addressSize)
+					} else {
+						d.FieldU("cie_pointer", offsetSize*8, scalar.UintHex)
+						d.FieldU("initial_location", addressSize*8, scalar.UintHex)
+						d.FieldU("address_range", addressSize*8)
+						dwarfDecodeCFAInstructions(d, addressSize)
+					}
+				})
+			})
+		}
+	})
+}
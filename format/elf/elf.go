@@ -5,9 +5,9 @@ package elf
 // https://github.com/torvalds/linux/blob/master/include/uapi/linux/elf.h
 // https://sourceware.org/git/?p=binutils-gdb.git;a=blob;f=include/elf/external.h;hb=HEAD
 
-// TODO: dwarf
-
 import (
+	"embed"
+	"encoding/binary"
 	"strings"
 
 	"github.com/wader/fq/format"
@@ -16,14 +16,19 @@ import (
 	"github.com/wader/fq/pkg/scalar"
 )
 
+//go:embed elf.md
+var elfFS embed.FS
+
 func init() {
 	interp.RegisterFormat(
 		format.ELF,
 		&decode.Format{
 			Description: "Executable and Linkable Format",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("\x7fELF")}},
 			DecodeFn:    elfDecode,
 		})
+	interp.RegisterFS(elfFS)
 }
 
 const (
@@ -346,6 +351,8 @@ var sectionHeaderTypeMap = scalar.UintMap{
 	SHT_GNU_HASH:      {Sym: "gnu_hash", Description: "GNU symbol hash table"},
 }
 
+const SHF_COMPRESSED = 0x800
+
 const (
 	STRTAB_DYNSTR   = ".dynstr"
 	STRTAB_SHSTRTAB = ".shstrtab"
@@ -667,6 +674,7 @@ type sectionHeader struct {
 	entSize int64
 	name    int
 	typ     int
+	flags   uint64
 	dc      dynamicContext // if SHT_DYNAMIC
 	symbols []symbol
 }
@@ -689,7 +697,7 @@ func elfReadSectionHeaders(d *decode.D, ec *elfContext) {
 		case 32:
 			sh.name = int(d.U32())
 			sh.typ = int(d.U32())
-			d.U32()                      // flags
+			sh.flags = d.U32()
 			sh.addr = int64(d.U32() * 8) // addr
 			sh.offset = int64(d.U32()) * 8
 			sh.size = int64(d.U32()) * 8
@@ -700,8 +708,8 @@ func elfReadSectionHeaders(d *decode.D, ec *elfContext) {
 		case 64:
 			sh.name = int(d.U32())
 			sh.typ = int(d.U32())
-			d.U64()                      // addr
-			sh.addr = int64(d.U64() * 8) // flags
+			sh.flags = d.U64()
+			sh.addr = int64(d.U64() * 8)
 			sh.offset = int64(d.U64()) * 8
 			sh.size = int64(d.U64()) * 8
 			d.U32() // link
@@ -755,6 +763,9 @@ func elfReadSectionHeaders(d *decode.D, ec *elfContext) {
 			ec.strTabMap[strIndexNull(sh.name, shStrTab)] = readStrTab(d, sh.offset, sh.size/8)
 		}
 	}
+	ec.shStrTab = shStrTab
+
+	elfReadDWARFContext(d, ec, shStrTab)
 }
 
 type elfContext struct {
@@ -775,6 +786,15 @@ type elfContext struct {
 
 	sections  []sectionHeader
 	strTabMap map[string]string
+	shStrTab  string
+	dwarf     *dwarfContext
+}
+
+func (ec *elfContext) byteOrder() binary.ByteOrder {
+	if ec.endian == decode.LittleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
 }
 
 func (ec *elfContext) sectionIndexByAddr(addr int64) (int, bool) {
@@ -991,16 +1011,18 @@ func elfDecodeSectionHeader(d *decode.D, ec elfContext, sh sectionHeader) {
 				d.FieldBool("execinstr")
 				d.FieldBool("alloc")
 				d.FieldBool("write")
+				d.FieldU4("unused1")
+				d.FieldBool("compressed")
 				d.FieldBool("tls")
 				d.FieldBool("group")
 				d.FieldBool("os_nonconforming")
 
-				d.FieldU9("unused1")
+				d.FieldU4("unused2")
 
 				d.FieldU8("os_specific")
 				d.FieldU4("processor_specific")
 				if archBits == 64 {
-					d.FieldU32("unused2")
+					d.FieldU32("unused3")
 				}
 			} else {
 				// TODO: add.FieldUnused that is per decoder?
@@ -1009,7 +1031,8 @@ func elfDecodeSectionHeader(d *decode.D, ec elfContext, sh sectionHeader) {
 				}
 				d.FieldU4("processor_specific")
 				d.FieldU8("os_specific")
-				d.FieldU9("unused1")
+				d.FieldU8("unused1")
+				d.FieldBool("compressed")
 				d.FieldBool("tls")
 				d.FieldBool("group")
 				d.FieldBool("os_nonconforming")
@@ -1069,6 +1092,15 @@ func elfDecodeSectionHeader(d *decode.D, ec elfContext, sh sectionHeader) {
 	}
 
 	d.SeekAbs(offset)
+
+	name := strIndexNull(sh.name, ec.shStrTab)
+	if sh.flags&SHF_COMPRESSED != 0 {
+		d.FramedFn(size, func(d *decode.D) {
+			elfDecodeCompressedSection(d, ec, name)
+		})
+		return
+	}
+
 	switch typ {
 	case SHT_STRTAB:
 		d.FieldUTF8("string", int(size/8))
@@ -1089,6 +1121,12 @@ func elfDecodeSectionHeader(d *decode.D, ec elfContext, sh sectionHeader) {
 	case SHT_PROGBITS:
 		// TODO: name progbits?
 		// TODO: decode opcodes
+		if fn, ok := dwarfSectionDecoders[name]; ok {
+			if err := d.TryFramedFn(size, func(d *decode.D) { fn(d, ec) }); err != nil {
+				dwarfFieldFailedData(d, "data", size, err)
+			}
+			return
+		}
 		d.FieldRawLen("data", size)
 	case SHT_GNU_HASH:
 		d.FieldStruct("gnu_hash", func(d *decode.D) {
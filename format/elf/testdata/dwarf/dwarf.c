@@ -0,0 +1,31 @@
+#include <stdint.h>
+
+struct point {
+	int32_t x;
+	int32_t y;
+};
+
+struct shape {
+	const char *name;
+	struct point origin;
+	uint8_t flags : 3;
+	uint8_t kind : 5;
+	double scale;
+};
+
+static struct shape shapes[2] = {
+	{"square", {1, 2}, 1, 2, 1.5},
+	{"circle", {3, 4}, 0, 1, 2.0},
+};
+
+int area(struct shape *s) {
+	return s->origin.x * s->origin.y;
+}
+
+int main(void) {
+	int sum = 0;
+	for (int i = 0; i < 2; i++) {
+		sum += area(&shapes[i]);
+	}
+	return sum;
+}
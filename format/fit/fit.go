@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "Garmin Flexible and Interoperable Data Transfer",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Offset: 8, Bytes: []byte(".FIT")}},
 			DecodeFn:    decodeFIT,
 		})
 
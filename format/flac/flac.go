@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "Free Lossless Audio Codec file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("fLaC")}},
 			DecodeFn:    flacDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.FLAC_Metadatablocks}, Out: &flacMetadatablocksGroup},
@@ -61,6 +62,9 @@ func flacDecode(d *decode.D) any {
 			if !ok {
 				panic(fmt.Sprintf("expected FlacFrameOut got %#+v", v))
 			}
+			if flacMetadatablockOut.HasStreamInfo && uint64(ffo.BitsPerSample) != streamInfo.BitsPerSample {
+				d.Warnf("frame bits per sample %d differs from stream info %d", ffo.BitsPerSample, streamInfo.BitsPerSample)
+			}
 
 			samplesInFrame := ffo.Samples
 			if streamTotalSamples > 0 {
@@ -80,6 +84,9 @@ func flacDecode(d *decode.D) any {
 	md5CalcValue := d.FieldRootBitBuf("md5_calculated", bitio.NewBitReader(md5Samples.Sum(nil), -1))
 	_ = md5CalcValue.TryBitBufScalarFn(d.ValidateBitBuf(streamInfo.MD5), scalar.RawHex)
 	d.FieldValueUint("decoded_samples", framesNDecodedSamples)
+	if streamTotalSamples > 0 && framesNDecodedSamples != streamTotalSamples {
+		d.Warnf("decoded %d samples but stream info has %d", framesNDecodedSamples, streamTotalSamples)
+	}
 
 	return nil
 }
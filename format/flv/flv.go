@@ -17,6 +17,7 @@ func init() {
 		Name:        format.FLV,
 		Description: "Flash video",
 		Groups:      []*decode.Group{format.Probe},
+		Signatures:  []decode.Signature{{Bytes: []byte("FLV")}},
 		DecodeFn:    flvDecode,
 	})
 }
@@ -114,6 +114,7 @@ var (
 	HEVC_SPS            = &decode.Group{Name: "hevc_sps"}
 	HEVC_VPS            = &decode.Group{Name: "hevc_vps"}
 	HTML                = &decode.Group{Name: "html"}
+	HTTP                = &decode.Group{Name: "http"}
 	ICC_Profile         = &decode.Group{Name: "icc_profile"}
 	ICMP                = &decode.Group{Name: "icmp"}
 	ICMPv6              = &decode.Group{Name: "icmpv6"}
@@ -126,10 +127,12 @@ var (
 	JPEG                = &decode.Group{Name: "jpeg"}
 	JSON                = &decode.Group{Name: "json"}
 	JSONL               = &decode.Group{Name: "jsonl"}
+	Kaitai              = &decode.Group{Name: "kaitai"}
 	LevelDB_Descriptor  = &decode.Group{Name: "leveldb_descriptor"}
 	LevelDB_LDB         = &decode.Group{Name: "leveldb_table"}
 	LevelDB_LOG         = &decode.Group{Name: "leveldb_log"}
 	LuaJIT              = &decode.Group{Name: "luajit"}
+	LZ4                 = &decode.Group{Name: "lz4"}
 	MachO               = &decode.Group{Name: "macho"}
 	MachO_Fat           = &decode.Group{Name: "macho_fat"}
 	Markdown            = &decode.Group{Name: "markdown"}
@@ -156,6 +159,7 @@ var (
 	Opus_Packet         = &decode.Group{Name: "opus_packet"}
 	PCAP                = &decode.Group{Name: "pcap"}
 	PCAPNG              = &decode.Group{Name: "pcapng"}
+	PE                  = &decode.Group{Name: "pe"}
 	Pg_BTree            = &decode.Group{Name: "pg_btree"}
 	Pg_Control          = &decode.Group{Name: "pg_control"}
 	Pg_Heap             = &decode.Group{Name: "pg_heap"}
@@ -186,8 +190,10 @@ var (
 	WAV                 = &decode.Group{Name: "wav"}
 	WebP                = &decode.Group{Name: "webp"}
 	XML                 = &decode.Group{Name: "xml"}
+	XZ                  = &decode.Group{Name: "xz"}
 	YAML                = &decode.Group{Name: "yaml"}
 	Zip                 = &decode.Group{Name: "zip"}
+	Zstd                = &decode.Group{Name: "zstd"}
 )
 
 // below are data types used to communicate between formats <FormatName>In/Out
@@ -258,7 +264,10 @@ type Ogg_Page_Out struct {
 }
 
 type Protobuf_In struct {
-	Message ProtoBufMessage
+	Message       ProtoBufMessage
+	Proto         string `doc:".proto schema source"`
+	DescriptorSet string `doc:"FileDescriptorSet schema, ex: from protoc --descriptor_set_out"`
+	MessageType   string `doc:"Full message type name, default first message in schema"`
 }
 
 type Matroska_In struct {
@@ -293,6 +302,10 @@ type MPEG_ASC_Out struct {
 	ObjectType int
 }
 
+type MPEG_PES_Packet_In struct {
+	StreamType int // MPEG-TS PMT stream type, zero if unknown
+}
+
 type Link_Frame_In struct {
 	Type           int
 	IsLittleEndian bool // pcap endian etc
@@ -304,6 +317,9 @@ type INET_Packet_In struct {
 
 type IP_Packet_In struct {
 	Protocol int
+	// addresses for the tcp and udp checksum pseudo header, nil if not known
+	SourceAddress      []byte
+	DestinationAddress []byte
 }
 
 type UDP_Payload_In struct {
@@ -387,6 +403,10 @@ type CSV_In struct {
 	Comment string `doc:"Comment line character"`
 }
 
+type Kaitai_In struct {
+	KSY string `doc:"Kaitai Struct YAML spec"`
+}
+
 type Bitcoin_Block_In struct {
 	HasHeader bool `doc:"Has blkdat header"`
 }
@@ -22,7 +22,11 @@ func init() {
 		&decode.Format{
 			Description: "Graphics Interchange Format",
 			Groups:      []*decode.Group{format.Probe, format.Image},
-			DecodeFn:    gifDecode,
+			Signatures: []decode.Signature{
+				{Bytes: []byte("GIF87a")},
+				{Bytes: []byte("GIF89a")},
+			},
+			DecodeFn: gifDecode,
 		})
 }
 
@@ -5,6 +5,7 @@ package gz
 // TODO: verify isize?
 
 import (
+	"bytes"
 	"compress/flate"
 	"hash/crc32"
 	"io"
@@ -25,15 +26,19 @@ func init() {
 		&decode.Format{
 			Description: "gzip compression",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: memberMagic}},
 			DecodeFn:    gzipDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
 			},
+			SkipFromFunction: true, // from_gzip is defined in compress and returns uncompressed binary
 		})
 }
 
 const deflateMethod = 8
 
+var memberMagic = []byte("\x1f\x8b")
+
 var compressionMethodNames = scalar.UintMapSymStr{
 	deflateMethod: "deflate",
 }
@@ -61,7 +66,7 @@ var deflateExtraFlagsNames = scalar.UintMapSymStr{
 }
 
 func gzipDecodeMember(d *decode.D) bitio.ReaderAtSeeker {
-	d.FieldRawLen("identification", 2*8, d.AssertBitBuf([]byte("\x1f\x8b")))
+	d.FieldRawLen("identification", int64(len(memberMagic))*8, d.AssertBitBuf(memberMagic))
 	compressionMethod := d.FieldU8("compression_method", compressionMethodNames)
 	hasHeaderCRC := false
 	hasExtra := false
@@ -120,7 +125,7 @@ func gzipDecodeMember(d *decode.D) bitio.ReaderAtSeeker {
 		crc32W := crc32.NewIEEE()
 		// TODO: cleanup clone
 		d.CopyBits(crc32W, d.CloneReadSeeker(uncompressedBR))
-		d.FieldU32("crc32", d.UintValidateBytes(crc32W.Sum(nil)), scalar.UintHex)
+		d.FieldChecksumU("crc32", 32, "crc32", crc32W.Sum(nil), scalar.UintHex)
 		d.FieldU32("isize")
 	} else {
 		d.Fatalf("unknown compression method %d", compressionMethod)
@@ -135,6 +140,11 @@ func gzipDecode(d *decode.D) any {
 	var brs []bitio.ReadAtSeeker
 	d.FieldArray("members", func(d *decode.D) {
 		for !d.End() {
+			// stop at trailing data that is not another member
+			if len(brs) > 0 && (d.BitsLeft() < int64(len(memberMagic))*8 || !bytes.Equal(d.PeekBytes(len(memberMagic)), memberMagic)) {
+				break
+			}
+
 			var br bitio.ReadAtSeeker
 			d.FieldStruct("member", func(d *decode.D) {
 				br = gzipDecodeMember(d)
@@ -0,0 +1,366 @@
+package http
+
+// https://www.rfc-editor.org/rfc/rfc9112 HTTP/1.1
+// https://www.rfc-editor.org/rfc/rfc9110 HTTP Semantics
+
+// TODO: body length for response to HEAD request (needs peer request)
+// TODO: obsolete line folding
+// TODO: brotli, zstd content encoding
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"embed"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/internal/lazyre"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+var httpProbeGroup decode.Group
+
+//go:embed http.md
+var httpFS embed.FS
+
+func init() {
+	interp.RegisterFormat(
+		format.HTTP,
+		&decode.Format{
+			Description: "HyperText Transfer Protocol 1.x",
+			Groups:      []*decode.Group{format.TCP_Stream},
+			DecodeFn:    httpDecode,
+			RootArray:   true,
+			RootName:    "messages",
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.Probe}, Out: &httpProbeGroup},
+			},
+		})
+	interp.RegisterFS(httpFS)
+}
+
+// max length of start and header lines
+const httpMaxLineLength = 64 * 1024
+
+var httpRequestLineRE = &lazyre.RE{S: `^([!#$%&'*+.^_` + "`" + `|~0-9A-Za-z-]+ )([^ \r\n]+ )(HTTP/\d\.\d\r?\n)$`}
+var httpStatusLineRE = &lazyre.RE{S: `^(HTTP/\d\.\d )(\d\d\d(?: |\r?\n$))([^\r\n]*\r?\n)?$`}
+var httpHeaderLineRE = &lazyre.RE{S: `^([!#$%&'*+.^_` + "`" + `|~0-9A-Za-z-]+:)([^\r\n]*\r?\n)$`}
+var httpChunkSizeLineRE = &lazyre.RE{S: `^([0-9A-Fa-f]+[ \t]*)(;[^\r\n]*)?(\r?\n)$`}
+
+var statusCodeNames = map[uint64]string{
+	100: "Continue",
+	101: "Switching Protocols",
+	200: "OK",
+	201: "Created",
+	202: "Accepted",
+	204: "No Content",
+	206: "Partial Content",
+	301: "Moved Permanently",
+	302: "Found",
+	303: "See Other",
+	304: "Not Modified",
+	307: "Temporary Redirect",
+	308: "Permanent Redirect",
+	400: "Bad Request",
+	401: "Unauthorized",
+	403: "Forbidden",
+	404: "Not Found",
+	405: "Method Not Allowed",
+	408: "Request Timeout",
+	409: "Conflict",
+	410: "Gone",
+	411: "Length Required",
+	413: "Content Too Large",
+	415: "Unsupported Media Type",
+	429: "Too Many Requests",
+	500: "Internal Server Error",
+	501: "Not Implemented",
+	502: "Bad Gateway",
+	503: "Service Unavailable",
+	504: "Gateway Timeout",
+}
+
+// status code as number with reason phrase as description
+var statusCodeMapper = scalar.StrFn(func(s scalar.Str) (scalar.Str, error) {
+	n, err := strconv.ParseUint(strings.TrimSpace(s.Actual), 10, 64)
+	if err != nil {
+		return s, nil
+	}
+	s.Sym = n
+	s.Description = statusCodeNames[n]
+	return s, nil
+})
+
+type httpHeaders []struct{ name, value string }
+
+func (hs httpHeaders) get(name string) (string, bool) {
+	for _, h := range hs {
+		if strings.EqualFold(h.name, name) {
+			return h.value, true
+		}
+	}
+	return "", false
+}
+
+// peek next line including line ending, nil if not found
+func httpPeekLine(d *decode.D) []byte {
+	n, _, err := d.TryPeekFind(8, 8, httpMaxLineLength*8, func(v uint64) bool { return v == '\n' })
+	if err != nil || n == -1 {
+		return nil
+	}
+	return d.PeekBytes(int(n/8) + 1)
+}
+
+// read line fields for each submatch of re
+func httpFieldsRE(d *decode.D, line []byte, re *lazyre.RE, fns ...func(d *decode.D, n int)) bool {
+	m := re.Must().FindSubmatchIndex(line)
+	if m == nil {
+		return false
+	}
+	for i, fn := range fns {
+		start, stop := m[(i+1)*2], m[(i+1)*2+1]
+		if start == -1 {
+			continue
+		}
+		fn(d, stop-start)
+	}
+	return true
+}
+
+func httpStrFn(name string, sms ...scalar.StrMapper) func(d *decode.D, n int) {
+	return func(d *decode.D, n int) {
+		d.FieldUTF8(name, n, sms...)
+	}
+}
+
+func httpDecodeHeaders(d *decode.D) httpHeaders {
+	var hs httpHeaders
+	d.FieldArray("headers", func(d *decode.D) {
+		for {
+			line := httpPeekLine(d)
+			if line == nil {
+				d.Fatalf("incomplete header line")
+			}
+			if bytes.Equal(line, []byte("\r\n")) || bytes.Equal(line, []byte("\n")) {
+				break
+			}
+			var name, value string
+			d.FieldStruct("header", func(d *decode.D) {
+				if !httpFieldsRE(d, line, httpHeaderLineRE,
+					func(d *decode.D, n int) { name = d.FieldUTF8("name", n, scalar.StrActualTrim(":")) },
+					func(d *decode.D, n int) { value = d.FieldUTF8("value", n, scalar.ActualTrimSpace) },
+				) {
+					d.Fatalf("invalid header line")
+				}
+			})
+			hs = append(hs, struct{ name, value string }{name, value})
+		}
+	})
+	line := httpPeekLine(d)
+	d.FieldUTF8("header_end", len(line))
+
+	return hs
+}
+
+func httpContentDecoder(contentEncoding string) func(r io.Reader) (io.Reader, error) {
+	switch strings.ToLower(strings.TrimSpace(contentEncoding)) {
+	case "gzip", "x-gzip":
+		return func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) }
+	case "deflate":
+		// should be zlib but some servers send raw deflate
+		return func(r io.Reader) (io.Reader, error) {
+			br, ok := r.(io.ReadSeeker)
+			if ok {
+				zr, err := zlib.NewReader(br)
+				if err == nil {
+					return zr, nil
+				}
+				if _, err := br.Seek(0, io.SeekStart); err != nil {
+					return nil, err
+				}
+			}
+			return flate.NewReader(r), nil
+		}
+	default:
+		return nil
+	}
+}
+
+func httpFieldProbe(d *decode.D, name string, br bitio.ReaderAtSeeker) {
+	if dv, _, _ := d.TryFieldFormatBitBuf(name, br, &httpProbeGroup, format.Probe_In{}); dv == nil {
+		d.FieldRootBitBuf(name, br)
+	}
+}
+
+func httpFieldUncompressed(d *decode.D, br bitio.ReaderAtSeeker, fn func(r io.Reader) (io.Reader, error)) {
+	r, err := fn(bitio.NewIOReadSeeker(br))
+	if err != nil {
+		return
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	httpFieldProbe(d, "uncompressed", bitio.NewBitReader(b, -1))
+}
+
+func httpDecodeChunkedBody(d *decode.D) []byte {
+	body := &bytes.Buffer{}
+	d.FieldArray("chunks", func(d *decode.D) {
+		for {
+			line := httpPeekLine(d)
+			if line == nil {
+				d.Fatalf("incomplete chunk size line")
+			}
+			var size uint64
+			d.FieldStruct("chunk", func(d *decode.D) {
+				var sizeErr error
+				if !httpFieldsRE(d, line, httpChunkSizeLineRE,
+					func(d *decode.D, n int) {
+						s := d.FieldUTF8("size", n, scalar.ActualTrimSpace, scalar.TryStrSymParseUint(16))
+						size, sizeErr = strconv.ParseUint(s, 16, 64)
+					},
+					httpStrFn("extension", scalar.StrActualTrim("; \t")),
+					httpStrFn("size_end"),
+				) || sizeErr != nil {
+					d.Fatalf("invalid chunk size line")
+				}
+				if size == 0 {
+					return
+				}
+				dataBR := d.FieldRawLen("data", int64(size)*8)
+				d.Copy(body, bitio.NewIOReader(dataBR))
+				line := httpPeekLine(d)
+				if line == nil || len(bytes.TrimRight(line, "\r\n")) != 0 {
+					d.Fatalf("invalid chunk data end")
+				}
+				d.FieldUTF8("data_end", len(line))
+			})
+			if size == 0 {
+				break
+			}
+		}
+	})
+	d.FieldStruct("trailer", func(d *decode.D) {
+		httpDecodeHeaders(d)
+	})
+
+	return body.Bytes()
+}
+
+func httpDecodeMessage(d *decode.D, isRequest bool) {
+	line := httpPeekLine(d)
+	if line == nil {
+		d.Fatalf("no start line found")
+	}
+
+	var statusCode uint64
+	if isRequest {
+		d.FieldStruct("request_line", func(d *decode.D) {
+			if !httpFieldsRE(d, line, httpRequestLineRE,
+				httpStrFn("method", scalar.ActualTrimSpace),
+				httpStrFn("target", scalar.ActualTrimSpace),
+				httpStrFn("version", scalar.ActualTrimSpace),
+			) {
+				d.Fatalf("invalid request line")
+			}
+		})
+	} else {
+		d.FieldStruct("status_line", func(d *decode.D) {
+			if !httpFieldsRE(d, line, httpStatusLineRE,
+				httpStrFn("version", scalar.ActualTrimSpace),
+				func(d *decode.D, n int) {
+					s := d.FieldUTF8("status_code", n, scalar.ActualTrimSpace, statusCodeMapper)
+					statusCode, _ = strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+				},
+				httpStrFn("reason", scalar.ActualTrimSpace),
+			) {
+				d.Fatalf("invalid status line")
+			}
+		})
+	}
+
+	hs := httpDecodeHeaders(d)
+
+	// https://www.rfc-editor.org/rfc/rfc9112#section-6.3
+	if !isRequest && (statusCode/100 == 1 || statusCode == 204 || statusCode == 304) {
+		return
+	}
+
+	contentDecoder := httpContentDecoder("")
+	if contentEncoding, ok := hs.get("Content-Encoding"); ok {
+		contentDecoder = httpContentDecoder(contentEncoding)
+	}
+
+	transferEncoding, _ := hs.get("Transfer-Encoding")
+	if strings.Contains(strings.ToLower(transferEncoding), "chunked") {
+		body := httpDecodeChunkedBody(d)
+		bodyBR := bitio.NewBitReader(body, -1)
+		if contentDecoder != nil {
+			d.FieldRootBitBuf("body", bodyBR)
+			httpFieldUncompressed(d, bodyBR, contentDecoder)
+		} else {
+			httpFieldProbe(d, "body", bodyBR)
+		}
+		return
+	}
+
+	var bodyLen int64
+	if contentLength, ok := hs.get("Content-Length"); ok {
+		n, err := strconv.ParseInt(strings.TrimSpace(contentLength), 10, 64)
+		if err != nil || n < 0 {
+			d.Fatalf("invalid content length %q", contentLength)
+		}
+		bodyLen = n * 8
+	} else if !isRequest {
+		// read until connection is closed
+		bodyLen = d.BitsLeft()
+	}
+	if bodyLen == 0 {
+		return
+	}
+	if bodyLen > d.BitsLeft() {
+		// truncated stream
+		bodyLen = d.BitsLeft()
+	}
+
+	if contentDecoder != nil {
+		bodyBR := d.FieldRawLen("body", bodyLen)
+		httpFieldUncompressed(d, bodyBR, contentDecoder)
+	} else {
+		d.FieldFormatOrRawLen("body", bodyLen, &httpProbeGroup, format.Probe_In{})
+	}
+}
+
+func httpDecode(d *decode.D) any {
+	line := httpPeekLine(d)
+	if line == nil {
+		d.Fatalf("no start line found")
+	}
+	isRequest := httpRequestLineRE.Must().Match(line)
+	if !isRequest && !httpStatusLineRE.Must().Match(line) {
+		d.Fatalf("no request or status line found")
+	}
+
+	var tsi format.TCP_Stream_In
+	if d.ArgAs(&tsi) {
+		if tsi.IsClient != isRequest {
+			d.Fatalf("client should send requests and server responses")
+		}
+	}
+
+	for d.NotEnd() {
+		d.FieldStruct("message", func(d *decode.D) {
+			httpDecodeMessage(d, isRequest)
+		})
+	}
+
+	return nil
+}
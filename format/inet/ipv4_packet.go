@@ -77,8 +77,10 @@ func decodeIPv4(d *decode.D) any {
 	checksumStart := d.Pos()
 	d.FieldU16("header_checksum", scalar.UintHex)
 	checksumEnd := d.Pos()
+	addressesStart := d.Pos()
 	d.FieldU32("source_ip", mapUToIPv4Sym, scalar.UintHex)
 	d.FieldU32("destination_ip", mapUToIPv4Sym, scalar.UintHex)
+	addresses := d.ReadAllBits(d.BitBufRange(addressesStart, 64))
 	optionsLen := (int64(ihl) - 5) * 8 * 4
 	if optionsLen > 0 {
 		d.FramedFn(optionsLen, func(d *decode.D) {
@@ -104,7 +106,7 @@ func decodeIPv4(d *decode.D) any {
 	ipv4Checksum := &checksum.IPv4{}
 	d.Copy(ipv4Checksum, bitio.NewIOReader(d.BitBufRange(0, checksumStart)))
 	d.Copy(ipv4Checksum, bitio.NewIOReader(d.BitBufRange(checksumEnd, headerEnd-checksumEnd)))
-	_ = d.FieldMustGet("header_checksum").TryUintScalarFn(d.UintValidateBytes(ipv4Checksum.Sum(nil)), scalar.UintHex)
+	d.ValidateChecksum(d.FieldMustGet("header_checksum"), "inet", ipv4Checksum.Sum(nil))
 
 	dataLen := int64(totalLength-(ihl*4)) * 8
 
@@ -115,7 +117,11 @@ func decodeIPv4(d *decode.D) any {
 			"payload",
 			dataLen,
 			&ipv4IpPacketGroup,
-			format.IP_Packet_In{Protocol: int(protocol)},
+			format.IP_Packet_In{
+				Protocol:           int(protocol),
+				SourceAddress:      addresses[0:4],
+				DestinationAddress: addresses[4:8],
+			},
 		)
 	}
 
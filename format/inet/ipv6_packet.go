@@ -128,8 +128,10 @@ func decodeIPv6(d *decode.D) any {
 	dataLength := d.FieldU16("payload_length")
 	nextHeader := d.FieldU8("next_header", nextHeaderMap)
 	d.FieldU8("hop_limit")
+	addressesStart := d.Pos()
 	d.FieldRawLen("source_address", 128, mapUToIPv6Sym)
 	d.FieldRawLen("destination_address", 128, mapUToIPv6Sym)
+	addresses := d.ReadAllBits(d.BitBufRange(addressesStart, 256))
 
 	extStart := d.Pos()
 	if isIpv6Option(nextHeader) {
@@ -174,7 +176,11 @@ func decodeIPv6(d *decode.D) any {
 		"payload",
 		payloadLen,
 		&ipv4IpPacketGroup,
-		format.IP_Packet_In{Protocol: int(nextHeader)},
+		format.IP_Packet_In{
+			Protocol:           int(nextHeader),
+			SourceAddress:      addresses[0:16],
+			DestinationAddress: addresses[16:32],
+		},
 	)
 
 	return nil
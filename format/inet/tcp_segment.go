@@ -3,7 +3,11 @@ package inet
 // https://en.wikipedia.org/wiki/Transmission_Control_Protocol
 
 import (
+	"encoding/binary"
+
 	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
 	"github.com/wader/fq/pkg/decode"
 	"github.com/wader/fq/pkg/interp"
 	"github.com/wader/fq/pkg/scalar"
@@ -61,9 +65,8 @@ func decodeTCP(d *decode.D) any {
 	d.FieldBool("syn")
 	d.FieldBool("fin")
 	d.FieldU16("window_size")
-	// checksumStart := d.Pos()
+	checksumStart := d.Pos()
 	d.FieldU16("checksum", scalar.UintHex)
-	// checksumEnd := d.Pos()
 	d.FieldU16("urgent_pointer")
 	optionsLen := (int64(dataOffset) - 5) * 8 * 4
 	if optionsLen > 0 {
@@ -108,13 +111,27 @@ func decodeTCP(d *decode.D) any {
 		})
 	}
 
-	// TODO: need to pass ipv4 pseudo header somehow
-	// tcpChecksum := &checksum.IPv4{}
-	// d.MustCopy(tcpChecksum, d.BitBufRange(0, checksumStart))
-	// d.MustCopy(tcpChecksum, d.BitBufRange(checksumEnd, d.Len()-checksumEnd))
-	// _ = d.FieldMustGet("checksum").TryScalarFn(d.UintValidateBytes(tcpChecksum.Sum(nil)), scalar.Hex)
-
 	d.FieldRawLen("payload", d.BitsLeft())
 
+	if ipi.SourceAddress != nil {
+		d.ValidateChecksum(d.FieldMustGet("checksum"), "inet", pseudoHeaderChecksum(d, ipi, checksumStart))
+	}
+
 	return nil
 }
+
+// pseudoHeaderChecksum calculates the tcp or udp checksum of the whole segment
+// including the ip pseudo header with the checksum field as zero. Uses the ipv6
+// pseudo header layout which sums to the same as the ipv4 one.
+func pseudoHeaderChecksum(d *decode.D, ipi format.IP_Packet_In, checksumStart int64) []byte {
+	c := &checksum.IPv4{}
+	_, _ = c.Write(ipi.SourceAddress)
+	_, _ = c.Write(ipi.DestinationAddress)
+	_, _ = c.Write(binary.BigEndian.AppendUint32(nil, uint32(d.Len()/8)))
+	_, _ = c.Write([]byte{0, 0, 0, byte(ipi.Protocol)})
+	d.Copy(c, bitio.NewIOReader(d.BitBufRange(0, checksumStart)))
+	_, _ = c.Write([]byte{0, 0})
+	checksumEnd := checksumStart + 16
+	d.Copy(c, bitio.NewIOReader(d.BitBufRange(checksumEnd, d.Len()-checksumEnd)))
+	return c.Sum(nil)
+}
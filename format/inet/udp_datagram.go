@@ -31,7 +31,8 @@ func decodeUDP(d *decode.D) any {
 	sourcePort := d.FieldU16("source_port", format.UDPPortMap)
 	destPort := d.FieldU16("destination_port", format.UDPPortMap)
 	length := d.FieldU16("length")
-	d.FieldU16("checksum", scalar.UintHex)
+	checksumStart := d.Pos()
+	udpChecksum := d.FieldU16("checksum", scalar.UintHex)
 
 	payloadLen := int64(length-8) * 8
 	d.FieldFormatOrRawLen(
@@ -44,7 +45,15 @@ func decodeUDP(d *decode.D) any {
 		},
 	)
 
-	// TODO: for checksum need to pass ipv4 pseudo header somehow
+	// zero checksum means no checksum, only allowed for ipv4
+	if ipi.SourceAddress != nil && udpChecksum != 0 {
+		calculated := pseudoHeaderChecksum(d, ipi, checksumStart)
+		// calculated zero is sent as all ones
+		if calculated[0] == 0 && calculated[1] == 0 {
+			calculated = []byte{0xff, 0xff}
+		}
+		d.ValidateChecksum(d.FieldMustGet("checksum"), "inet", calculated)
+	}
 
 	return nil
 }
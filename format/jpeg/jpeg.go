@@ -23,6 +23,7 @@ func init() {
 		&decode.Format{
 			Description: "Joint Photographic Experts Group file",
 			Groups:      []*decode.Group{format.Probe, format.Image},
+			Signatures:  []decode.Signature{{Bytes: []byte{0xff, 0xd8, 0xff}}},
 			DecodeFn:    jpegDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Exif}, Out: &exifFormat},
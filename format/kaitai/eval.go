@@ -0,0 +1,620 @@
+package kaitai
+
+// Kaitai Struct expression language evaluation
+// https://doc.kaitai.io/user_guide.html#_expression_language
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding"
+)
+
+// enumVal is an integer with an enum, compares and converts as an integer
+type enumVal struct {
+	e *enumSpec
+	v int64
+}
+
+// ioVal is the _io of an object, positions and size are in bytes relative to
+// the start of the stream
+type ioVal struct {
+	o *obj
+}
+
+func (v ioVal) pos() int64  { return (v.o.d.Pos() - v.o.ioStart) / 8 }
+func (v ioVal) size() int64 { return (v.o.ioEnd - v.o.ioStart) / 8 }
+
+// eval evaluates e in the scope of object o. Values are int64, float64, bool,
+// string, []byte, []any, enumVal, ioVal, *obj or nil.
+func (o *obj) eval(e *expr) any {
+	switch e.kind {
+	case exprInt:
+		return e.i
+	case exprFloat:
+		return e.f
+	case exprStr:
+		return e.s
+	case exprBool:
+		return e.i == 1
+	case exprIdent:
+		return o.lookup(e.name)
+	case exprEnum:
+		name := strings.Join(e.path[:len(e.path)-1], "::")
+		en := o.t.lookupEnum(name)
+		if en == nil {
+			o.fatalf("%s: enum %q not found", e, name)
+		}
+		id := e.path[len(e.path)-1]
+		v, ok := en.byID[id]
+		if !ok {
+			o.fatalf("%s: enum %q has no value %q", e, name, id)
+		}
+		return enumVal{e: en, v: v}
+	case exprUnary:
+		return o.evalUnary(e)
+	case exprBinary:
+		return o.evalBinary(e)
+	case exprTernary:
+		if o.evalBool(e.x) {
+			return o.eval(e.y)
+		}
+		return o.eval(e.z)
+	case exprAttr:
+		return o.evalMethod(e, o.eval(e.x), nil)
+	case exprCall:
+		args := make([]any, len(e.args))
+		for i, a := range e.args {
+			args[i] = o.eval(a)
+		}
+		return o.evalMethod(e, o.eval(e.x), args)
+	case exprIndex:
+		x := o.eval(e.x)
+		i := o.evalInt(e.y)
+		switch x := x.(type) {
+		case []any:
+			if i < 0 || i >= int64(len(x)) {
+				o.fatalf("%s: index %d out of range", e, i)
+			}
+			return x[i]
+		case []byte:
+			if i < 0 || i >= int64(len(x)) {
+				o.fatalf("%s: index %d out of range", e, i)
+			}
+			return int64(x[i])
+		default:
+			o.fatalf("%s: can't index %s", e, typeName(x))
+		}
+	case exprArray:
+		vs := make([]any, len(e.args))
+		for i, a := range e.args {
+			vs[i] = o.eval(a)
+		}
+		return vs
+	case exprCast:
+		return o.eval(e.x)
+	case exprSizeof, exprBitSizeof:
+		n, ok := o.typeBitSize(e.name)
+		if !ok {
+			o.fatalf("%s: type %q has no fixed size", e, e.name)
+		}
+		if e.kind == exprSizeof {
+			return (n + 7) / 8
+		}
+		return n
+	}
+	o.fatalf("%s: unsupported expression", e)
+	return nil
+}
+
+func (o *obj) evalInt(e *expr) int64 {
+	v := o.eval(e)
+	n, ok := toInt(v)
+	if !ok {
+		o.fatalf("%s: expected integer got %s", e, typeName(v))
+	}
+	return n
+}
+
+func (o *obj) evalBool(e *expr) bool {
+	v := o.eval(e)
+	switch v := v.(type) {
+	case bool:
+		return v
+	case int64:
+		return v != 0
+	}
+	o.fatalf("%s: expected boolean got %s", e, typeName(v))
+	return false
+}
+
+// lookup resolves an identifier, local variables, special names, params,
+// seq fields and instances in that order
+func (o *obj) lookup(name string) any {
+	if v, ok := o.locals[name]; ok {
+		return v
+	}
+	switch name {
+	case "_root":
+		return o.root
+	case "_parent":
+		if o.parent == nil {
+			return nil
+		}
+		return o.parent
+	case "_io":
+		return ioVal{o: o}
+	}
+	return o.get(name)
+}
+
+// get returns a param, field or instance of o, instances are evaluated on
+// first use
+func (o *obj) get(name string) any {
+	if v, ok := o.params[name]; ok {
+		return v
+	}
+	if v, ok := o.fields[name]; ok {
+		return v
+	}
+	for _, a := range o.t.instances {
+		if a.id == name {
+			return o.instance(a)
+		}
+	}
+	for _, a := range o.t.seq {
+		if a.id == name {
+			// not decoded yet or skipped by if
+			return nil
+		}
+	}
+	o.fatalf("%q not found in %s", name, o.t.name)
+	return nil
+}
+
+func toInt(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case enumVal:
+		return v.v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case int64:
+		return "integer"
+	case float64:
+		return "float"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []byte:
+		return "bytes"
+	case []any:
+		return "array"
+	case enumVal:
+		return "enum"
+	case ioVal:
+		return "io"
+	case *obj:
+		return "struct"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func (o *obj) evalUnary(e *expr) any {
+	x := o.eval(e.x)
+	switch e.op {
+	case "not":
+		b, ok := x.(bool)
+		if !ok {
+			o.fatalf("%s: not on %s", e, typeName(x))
+		}
+		return !b
+	case "-":
+		switch x := x.(type) {
+		case int64:
+			return -x
+		case float64:
+			return -x
+		}
+	case "~":
+		if x, ok := x.(int64); ok {
+			return ^x
+		}
+	}
+	o.fatalf("%s: %s on %s", e, e.op, typeName(x))
+	return nil
+}
+
+// floorDiv and floorMod round towards negative infinity like kaitai
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int64) int64 {
+	m := a % b
+	if m != 0 && ((m < 0) != (b < 0)) {
+		m += b
+	}
+	return m
+}
+
+func (o *obj) evalBinary(e *expr) any {
+	switch e.op {
+	case "and":
+		return o.evalBool(e.x) && o.evalBool(e.y)
+	case "or":
+		return o.evalBool(e.x) || o.evalBool(e.y)
+	}
+
+	x := o.eval(e.x)
+	y := o.eval(e.y)
+
+	switch e.op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		c, ok := compare(x, y)
+		if !ok {
+			if e.op == "==" {
+				return false
+			} else if e.op == "!=" {
+				return true
+			}
+			o.fatalf("%s: can't compare %s and %s", e, typeName(x), typeName(y))
+		}
+		switch e.op {
+		case "==":
+			return c == 0
+		case "!=":
+			return c != 0
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		case ">":
+			return c > 0
+		default:
+			return c >= 0
+		}
+	}
+
+	if e.op == "+" {
+		switch x := x.(type) {
+		case string:
+			if y, ok := y.(string); ok {
+				return x + y
+			}
+		case []byte:
+			if y, ok := y.([]byte); ok {
+				return append(append([]byte{}, x...), y...)
+			}
+		}
+	}
+
+	if xi, ok := x.(int64); ok {
+		if yi, ok := y.(int64); ok {
+			switch e.op {
+			case "+":
+				return xi + yi
+			case "-":
+				return xi - yi
+			case "*":
+				return xi * yi
+			case "/":
+				if yi == 0 {
+					o.fatalf("%s: division by zero", e)
+				}
+				return floorDiv(xi, yi)
+			case "%":
+				if yi == 0 {
+					o.fatalf("%s: division by zero", e)
+				}
+				return floorMod(xi, yi)
+			case "&":
+				return xi & yi
+			case "|":
+				return xi | yi
+			case "^":
+				return xi ^ yi
+			case "<<":
+				return xi << uint64(yi)
+			case ">>":
+				return int64(uint64(xi) >> uint64(yi))
+			}
+		}
+	}
+	if xb, ok := x.(bool); ok {
+		if yb, ok := y.(bool); ok {
+			switch e.op {
+			case "&":
+				return xb && yb
+			case "|":
+				return xb || yb
+			case "^":
+				return xb != yb
+			}
+		}
+	}
+	if xf, ok := toFloat(x); ok {
+		if yf, ok := toFloat(y); ok {
+			switch e.op {
+			case "+":
+				return xf + yf
+			case "-":
+				return xf - yf
+			case "*":
+				return xf * yf
+			case "/":
+				return xf / yf
+			case "%":
+				return xf - yf*math.Floor(xf/yf)
+			}
+		}
+	}
+
+	o.fatalf("%s: %s %s %s not supported", e, typeName(x), e.op, typeName(y))
+	return nil
+}
+
+// compare returns -1, 0 or 1, false if the values are not comparable
+func compare(x, y any) (int, bool) {
+	cmpInt := func(a, b int64) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+
+	switch x := x.(type) {
+	case int64, enumVal:
+		xi, _ := toInt(x)
+		if yi, ok := toInt(y); ok {
+			if _, ok := y.(bool); !ok {
+				return cmpInt(xi, yi), true
+			}
+		}
+		if yf, ok := y.(float64); ok {
+			return compare(float64(xi), yf)
+		}
+	case float64:
+		if yf, ok := toFloat(y); ok {
+			switch {
+			case x < yf:
+				return -1, true
+			case x > yf:
+				return 1, true
+			}
+			return 0, true
+		}
+	case bool:
+		if yb, ok := y.(bool); ok {
+			return cmpInt(boolInt(x), boolInt(yb)), true
+		}
+	case string:
+		if ys, ok := y.(string); ok {
+			return strings.Compare(x, ys), true
+		}
+	case []byte:
+		if yb, ok := toBytes(y); ok {
+			return bytes.Compare(x, yb), true
+		}
+	case []any:
+		// array literal of byte values compares as bytes, ex: [0x50, 0x4b]
+		if yb, ok := y.([]byte); ok {
+			if xb, ok := toBytes(x); ok {
+				return bytes.Compare(xb, yb), true
+			}
+		}
+	case *obj:
+		if yo, ok := y.(*obj); ok && x == yo {
+			return 0, true
+		}
+	case nil:
+		if y == nil {
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+func toBytes(v any) ([]byte, bool) {
+	switch v := v.(type) {
+	case []byte:
+		return v, true
+	case []any:
+		bs := make([]byte, len(v))
+		for i, e := range v {
+			n, ok := e.(int64)
+			if !ok || n < 0 || n > 255 {
+				return nil, false
+			}
+			bs[i] = byte(n)
+		}
+		return bs, true
+	}
+	return nil, false
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// evalMethod evaluates attribute x.name or method call x.name(args), args is
+// nil for attributes
+func (o *obj) evalMethod(e *expr, x any, args []any) any {
+	argInt := func(i int) int64 {
+		if i >= len(args) {
+			o.fatalf("%s: missing argument", e)
+		}
+		n, ok := toInt(args[i])
+		if !ok {
+			o.fatalf("%s: expected integer argument got %s", e, typeName(args[i]))
+		}
+		return n
+	}
+
+	switch x := x.(type) {
+	case *obj:
+		if e.kind == exprAttr {
+			return x.get(e.name)
+		}
+	case ioVal:
+		switch e.name {
+		case "pos":
+			return x.pos()
+		case "size":
+			return x.size()
+		case "eof":
+			return x.o.d.Pos() >= x.o.ioEnd
+		}
+	case enumVal:
+		if e.name == "to_i" {
+			return x.v
+		}
+	case bool:
+		if e.name == "to_i" {
+			return boolInt(x)
+		}
+	case float64:
+		switch e.name {
+		case "to_i":
+			return int64(x)
+		case "to_s":
+			return strconv.FormatFloat(x, 'g', -1, 64)
+		}
+	case int64:
+		switch e.name {
+		case "to_s":
+			if len(args) > 0 {
+				return strconv.FormatInt(x, int(argInt(0)))
+			}
+			return strconv.FormatInt(x, 10)
+		}
+	case string:
+		switch e.name {
+		case "length":
+			return int64(len([]rune(x)))
+		case "reverse":
+			rs := []rune(x)
+			for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+				rs[i], rs[j] = rs[j], rs[i]
+			}
+			return string(rs)
+		case "to_i":
+			base := 10
+			if len(args) > 0 {
+				base = int(argInt(0))
+			}
+			n, err := strconv.ParseInt(x, base, 64)
+			if err != nil {
+				o.fatalf("%s: %s", e, err)
+			}
+			return n
+		case "substring":
+			rs := []rune(x)
+			from, to := argInt(0), argInt(1)
+			if from < 0 || to > int64(len(rs)) || from > to {
+				o.fatalf("%s: substring %d-%d out of range", e, from, to)
+			}
+			return string(rs[from:to])
+		}
+	case []byte:
+		switch e.name {
+		case "length", "size":
+			return int64(len(x))
+		case "first", "last", "min", "max":
+			if len(x) == 0 {
+				o.fatalf("%s: empty byte array", e)
+			}
+			vs := make([]any, len(x))
+			for i, b := range x {
+				vs[i] = int64(b)
+			}
+			return o.evalMethod(e, vs, args)
+		case "to_s":
+			if len(args) != 1 {
+				o.fatalf("%s: to_s requires an encoding", e)
+			}
+			enc, ok := args[0].(string)
+			if !ok {
+				o.fatalf("%s: encoding should be a string", e)
+			}
+			return o.decodeText(x, o.encoding(enc))
+		}
+	case []any:
+		switch e.name {
+		case "length", "size":
+			return int64(len(x))
+		case "first":
+			if len(x) > 0 {
+				return x[0]
+			}
+		case "last":
+			if len(x) > 0 {
+				return x[len(x)-1]
+			}
+		case "min", "max":
+			if len(x) > 0 {
+				m := x[0]
+				for _, v := range x[1:] {
+					c, ok := compare(v, m)
+					if !ok {
+						o.fatalf("%s: can't compare %s and %s", e, typeName(v), typeName(m))
+					}
+					if (e.name == "min" && c < 0) || (e.name == "max" && c > 0) {
+						m = v
+					}
+				}
+				return m
+			}
+		}
+		if e.name == "first" || e.name == "last" || e.name == "min" || e.name == "max" {
+			o.fatalf("%s: empty array", e)
+		}
+	}
+
+	o.fatalf("%s: %s has no %s", e, typeName(x), e.name)
+	return nil
+}
+
+func (o *obj) decodeText(bs []byte, enc encoding.Encoding) string {
+	s, err := enc.NewDecoder().Bytes(bs)
+	if err != nil {
+		o.fatalf("%s", err)
+	}
+	return string(s)
+}
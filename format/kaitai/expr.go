@@ -0,0 +1,578 @@
+package kaitai
+
+// Kaitai Struct expression language
+// https://doc.kaitai.io/user_guide.html#_expression_language
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenInt
+	tokenFloat
+	tokenStr
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	s    string // identifier, operator or decoded string literal
+	i    int64
+	f    float64
+	pos  int
+}
+
+var exprOps = []string{
+	// longest first
+	"::", "<<", ">>", "<=", ">=", "==", "!=",
+	"+", "-", "*", "/", "%", "<", ">", "&", "|", "^", "~", "!",
+	"?", ":", "(", ")", "[", "]", ",", ".",
+}
+
+func lexExpr(s string) ([]token, error) {
+	var ts []token
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9':
+			start := i
+			t, n, err := lexNumber(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%s at %d", err, start)
+			}
+			t.pos = start
+			ts = append(ts, t)
+			i += n
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			ts = append(ts, token{kind: tokenIdent, s: s[start:i], pos: start})
+		case c == '\'' || c == '"':
+			start := i
+			str, n, err := lexString(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%s at %d", err, start)
+			}
+			ts = append(ts, token{kind: tokenStr, s: str, pos: start})
+			i += n
+		default:
+			found := false
+			for _, op := range exprOps {
+				if strings.HasPrefix(s[i:], op) {
+					ts = append(ts, token{kind: tokenOp, s: op, pos: i})
+					i += len(op)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+		}
+	}
+	ts = append(ts, token{kind: tokenEOF, pos: len(s)})
+	return ts, nil
+}
+
+func lexNumber(s string) (token, int, error) {
+	n := 0
+	isFloat := false
+	base := 10
+	digits := "0123456789_"
+	if len(s) > 1 && s[0] == '0' {
+		switch s[1] {
+		case 'x', 'X':
+			base, digits, n = 16, "0123456789abcdefABCDEF_", 2
+		case 'b', 'B':
+			base, digits, n = 2, "01_", 2
+		case 'o', 'O':
+			base, digits, n = 8, "01234567_", 2
+		}
+	}
+	start := n
+	for n < len(s) {
+		c := s[n]
+		if strings.IndexByte(digits, c) != -1 {
+			n++
+			continue
+		}
+		// 1.5, 1e3 but not 1.to_s
+		if base == 10 && c == '.' && !isFloat && n+1 < len(s) && s[n+1] >= '0' && s[n+1] <= '9' {
+			isFloat = true
+			n++
+			continue
+		}
+		if base == 10 && (c == 'e' || c == 'E') {
+			isFloat = true
+			n++
+			if n < len(s) && (s[n] == '+' || s[n] == '-') {
+				n++
+			}
+			continue
+		}
+		break
+	}
+	lit := strings.ReplaceAll(s[start:n], "_", "")
+	if isFloat {
+		f, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			return token{}, 0, fmt.Errorf("invalid float %q", s[:n])
+		}
+		return token{kind: tokenFloat, f: f}, n, nil
+	}
+	u, err := strconv.ParseUint(lit, base, 64)
+	if err != nil {
+		return token{}, 0, fmt.Errorf("invalid integer %q", s[:n])
+	}
+	return token{kind: tokenInt, i: int64(u)}, n, nil
+}
+
+// single quoted strings are literal, double quoted strings support escapes
+func lexString(s string) (string, int, error) {
+	q := s[0]
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == q:
+			return sb.String(), i + 1, nil
+		case c == '\\' && q == '"' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case '0':
+				sb.WriteByte(0)
+			default:
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+type exprKind int
+
+const (
+	exprInt exprKind = iota
+	exprFloat
+	exprStr
+	exprBool
+	exprIdent     // name
+	exprEnum      // path::enum::value
+	exprUnary     // op x
+	exprBinary    // x op y
+	exprTernary   // x ? y : z
+	exprAttr      // x.name
+	exprCall      // x.name(args)
+	exprIndex     // x[y]
+	exprArray     // [args]
+	exprCast      // x.as<type>
+	exprSizeof    // sizeof<type>
+	exprBitSizeof // bitsizeof<type>
+)
+
+type expr struct {
+	kind exprKind
+	op   string
+	name string
+	path []string
+	i    int64
+	f    float64
+	s    string
+	x    *expr
+	y    *expr
+	z    *expr
+	args []*expr
+	src  string
+}
+
+func (e *expr) String() string { return e.src }
+
+type exprParser struct {
+	src string
+	ts  []token
+	i   int
+}
+
+func parseExpr(s string) (*expr, error) {
+	ts, err := lexExpr(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", s, err)
+	}
+	p := &exprParser{src: s, ts: ts}
+	e, err := p.ternary()
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", s, err)
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("%q: unexpected %q at %d", s, t.s, t.pos)
+	}
+	e.src = s
+	return e, nil
+}
+
+// parseExprList parses comma separated expressions, ex: type arguments
+func parseExprList(s string) ([]*expr, error) {
+	ts, err := lexExpr(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", s, err)
+	}
+	p := &exprParser{src: s, ts: ts}
+	var es []*expr
+	for p.peek().kind != tokenEOF {
+		e, err := p.ternary()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", s, err)
+		}
+		es = append(es, e)
+		if !p.acceptOp(",") {
+			break
+		}
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("%q: unexpected %q at %d", s, t.s, t.pos)
+	}
+	return es, nil
+}
+
+func (p *exprParser) peek() token { return p.ts[p.i] }
+func (p *exprParser) next() token {
+	t := p.ts[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *exprParser) isOp(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOp {
+		return false
+	}
+	for _, op := range ops {
+		if t.s == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) isIdent(names ...string) bool {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return false
+	}
+	for _, n := range names {
+		if t.s == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) acceptOp(op string) bool {
+	if p.isOp(op) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expectOp(op string) error {
+	if !p.acceptOp(op) {
+		t := p.peek()
+		return fmt.Errorf("expected %q at %d", op, t.pos)
+	}
+	return nil
+}
+
+func (p *exprParser) expectIdent() (string, error) {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return "", fmt.Errorf("expected identifier at %d", t.pos)
+	}
+	p.next()
+	return t.s, nil
+}
+
+func (p *exprParser) ternary() (*expr, error) {
+	c, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.acceptOp("?") {
+		return c, nil
+	}
+	t, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectOp(":"); err != nil {
+		return nil, err
+	}
+	f, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	return &expr{kind: exprTernary, x: c, y: t, z: f}, nil
+}
+
+func (p *exprParser) or() (*expr, error) {
+	x, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.isIdent("or") {
+		p.next()
+		y, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		x = &expr{kind: exprBinary, op: "or", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *exprParser) and() (*expr, error) {
+	x, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.isIdent("and") {
+		p.next()
+		y, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		x = &expr{kind: exprBinary, op: "and", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *exprParser) not() (*expr, error) {
+	if p.isIdent("not") {
+		p.next()
+		x, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &expr{kind: exprUnary, op: "not", x: x}, nil
+	}
+	return p.binary(0)
+}
+
+// binary operator precedence, lowest first
+var exprBinaryOps = [][]string{
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"|"},
+	{"^"},
+	{"&"},
+	{"<<", ">>"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) binary(level int) (*expr, error) {
+	if level == len(exprBinaryOps) {
+		return p.unary()
+	}
+	x, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(exprBinaryOps[level]...) {
+		op := p.next().s
+		y, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &expr{kind: exprBinary, op: op, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *exprParser) unary() (*expr, error) {
+	if p.isOp("-", "~", "!") {
+		op := p.next().s
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "!" {
+			op = "not"
+		}
+		return &expr{kind: exprUnary, op: op, x: x}, nil
+	}
+	return p.postfix()
+}
+
+func (p *exprParser) postfix() (*expr, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.acceptOp("."):
+			name, err := p.expectIdent()
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case name == "as" && p.isOp("<"):
+				p.next()
+				typ, err := p.typeName()
+				if err != nil {
+					return nil, err
+				}
+				x = &expr{kind: exprCast, name: typ, x: x}
+			case p.acceptOp("("):
+				args, err := p.args(")")
+				if err != nil {
+					return nil, err
+				}
+				x = &expr{kind: exprCall, name: name, x: x, args: args}
+			default:
+				x = &expr{kind: exprAttr, name: name, x: x}
+			}
+		case p.acceptOp("["):
+			y, err := p.ternary()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp("]"); err != nil {
+				return nil, err
+			}
+			x = &expr{kind: exprIndex, x: x, y: y}
+		default:
+			return x, nil
+		}
+	}
+}
+
+// typeName parses name[::name...]> after a "<"
+func (p *exprParser) typeName() (string, error) {
+	var parts []string
+	for {
+		n, err := p.expectIdent()
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, n)
+		if !p.acceptOp("::") {
+			break
+		}
+	}
+	if err := p.expectOp(">"); err != nil {
+		return "", err
+	}
+	return strings.Join(parts, "::"), nil
+}
+
+func (p *exprParser) args(end string) ([]*expr, error) {
+	var args []*expr
+	for !p.acceptOp(end) {
+		a, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+		if !p.acceptOp(",") {
+			if err := p.expectOp(end); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return args, nil
+}
+
+func (p *exprParser) primary() (*expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenInt:
+		return &expr{kind: exprInt, i: t.i}, nil
+	case tokenFloat:
+		return &expr{kind: exprFloat, f: t.f}, nil
+	case tokenStr:
+		s := t.s
+		// adjacent string literals are concatenated
+		for p.peek().kind == tokenStr {
+			s += p.next().s
+		}
+		return &expr{kind: exprStr, s: s}, nil
+	case tokenIdent:
+		switch t.s {
+		case "true":
+			return &expr{kind: exprBool, i: 1}, nil
+		case "false":
+			return &expr{kind: exprBool, i: 0}, nil
+		case "sizeof", "bitsizeof":
+			if p.acceptOp("<") {
+				typ, err := p.typeName()
+				if err != nil {
+					return nil, err
+				}
+				if t.s == "sizeof" {
+					return &expr{kind: exprSizeof, name: typ}, nil
+				}
+				return &expr{kind: exprBitSizeof, name: typ}, nil
+			}
+		}
+		if p.isOp("::") {
+			path := []string{t.s}
+			for p.acceptOp("::") {
+				n, err := p.expectIdent()
+				if err != nil {
+					return nil, err
+				}
+				path = append(path, n)
+			}
+			if len(path) < 2 {
+				return nil, fmt.Errorf("invalid enum reference at %d", t.pos)
+			}
+			return &expr{kind: exprEnum, path: path}, nil
+		}
+		return &expr{kind: exprIdent, name: t.s}, nil
+	case tokenOp:
+		switch t.s {
+		case "(":
+			x, err := p.ternary()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		case "[":
+			args, err := p.args("]")
+			if err != nil {
+				return nil, err
+			}
+			return &expr{kind: exprArray, args: args}, nil
+		}
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end")
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", t.s, t.pos)
+}
@@ -0,0 +1,583 @@
+package kaitai
+
+// Runtime decoder for Kaitai Struct specs
+// https://kaitai.io
+// https://doc.kaitai.io/ksy_reference.html
+
+import (
+	"bytes"
+	"embed"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/scalar"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+)
+
+//go:embed kaitai.jq
+//go:embed kaitai.md
+var kaitaiFS embed.FS
+
+func init() {
+	interp.RegisterFormat(
+		format.Kaitai,
+		&decode.Format{
+			Description:  "Kaitai Struct spec",
+			DecodeFn:     decodeKaitai,
+			DefaultInArg: format.Kaitai_In{},
+		})
+	interp.RegisterFS(kaitaiFS)
+}
+
+// obj is a decoded instance of a user type
+type obj struct {
+	t       *typeSpec
+	d       *decode.D
+	parent  *obj
+	root    *obj
+	ioStart int64 // stream range in bits
+	ioEnd   int64
+
+	params     map[string]any
+	fields     map[string]any
+	instances  map[string]any
+	evaluating map[string]bool
+	locals     map[string]any // _ and _index while decoding repeated fields
+}
+
+func newObj(t *typeSpec, parent *obj, ioStart int64, ioEnd int64) *obj {
+	o := &obj{
+		t:          t,
+		parent:     parent,
+		ioStart:    ioStart,
+		ioEnd:      ioEnd,
+		params:     map[string]any{},
+		fields:     map[string]any{},
+		instances:  map[string]any{},
+		evaluating: map[string]bool{},
+		locals:     map[string]any{},
+	}
+	o.root = o
+	if parent != nil {
+		o.root = parent.root
+	}
+	return o
+}
+
+func (o *obj) fatalf(format string, a ...any) {
+	o.d.Fatalf(format, a...)
+}
+
+func decodeKaitai(d *decode.D) any {
+	var ki format.Kaitai_In
+	d.ArgAs(&ki)
+
+	if ki.KSY == "" {
+		d.Fatalf("no spec, use -o ksy=@file.ksy or decode_ksy")
+	}
+	t, err := parseSpec(ki.KSY)
+	if err != nil {
+		d.Fatalf("ksy: %s", err)
+	}
+
+	o := newObj(t, nil, 0, d.Len())
+	o.decode(d)
+
+	return nil
+}
+
+func (o *obj) decode(d *decode.D) {
+	o.d = d
+	for _, a := range o.t.seq {
+		o.fields[a.id] = o.decodeAttr(d, a)
+	}
+	// instances are lazy but evaluate all so that they show up as fields
+	for _, a := range o.t.instances {
+		o.instance(a)
+	}
+}
+
+func (o *obj) instance(a *attrSpec) any {
+	if v, ok := o.instances[a.id]; ok {
+		return v
+	}
+	if o.evaluating[a.id] {
+		o.fatalf("%s: instance depends on itself", a.id)
+	}
+	o.evaluating[a.id] = true
+	defer delete(o.evaluating, a.id)
+
+	var v any
+	switch {
+	case a.ifExpr != nil && !o.evalBool(a.ifExpr):
+	case a.value != nil:
+		v = o.eval(a.value)
+		if a.enum != "" {
+			n, ok := toInt(v)
+			if !ok {
+				o.fatalf("%s: enum value is not an integer", a.id)
+			}
+			v = enumVal{e: o.lookupEnum(a.enum), v: n}
+		}
+		o.addValue(o.d, a, a.id, v)
+	case a.pos != nil:
+		pos := o.ioStart + o.evalInt(a.pos)*8
+		if pos < o.ioStart || pos > o.ioEnd {
+			o.fatalf("%s: pos %d outside of stream", a.id, (pos-o.ioStart)/8)
+		}
+		o.d.RangeFn(pos, o.ioEnd-pos, func(d *decode.D) {
+			v = o.decodeAttrNoIf(d, a)
+		})
+	default:
+		o.fatalf("%s: instance without pos or value", a.id)
+	}
+	o.instances[a.id] = v
+
+	return v
+}
+
+// addValue adds a field for a value instance
+func (o *obj) addValue(d *decode.D, a *attrSpec, name string, v any) {
+	doc := docDescription(a.doc)
+	switch v := v.(type) {
+	case int64:
+		d.FieldValueSint(name, v, scalar.SintDescription(doc))
+	case enumVal:
+		d.FieldValueSint(name, v.v, sintEnumMap(v.e))
+	case float64:
+		d.FieldValueFlt(name, v, scalar.FltDescription(doc))
+	case bool:
+		d.FieldValueBool(name, v, scalar.BoolDescription(doc))
+	case string:
+		d.FieldValueStr(name, v, scalar.StrDescription(doc))
+	case []byte:
+		d.FieldValueBitBuf(name, bitio.NewBitReader(v, -1), scalar.BitBufDescription(doc))
+	case []any:
+		d.FieldArray(name, func(d *decode.D) {
+			for _, e := range v {
+				o.addValue(d, a, name, e)
+			}
+		})
+	default:
+		// structs are already decoded somewhere else
+	}
+}
+
+func (o *obj) decodeAttr(d *decode.D, a *attrSpec) any {
+	if a.ifExpr != nil && !o.evalBool(a.ifExpr) {
+		return nil
+	}
+	return o.decodeAttrNoIf(d, a)
+}
+
+func (o *obj) decodeAttrNoIf(d *decode.D, a *attrSpec) any {
+	if a.repeat == "" {
+		return o.decodeOne(d, a, a.id)
+	}
+
+	var vs []any
+	d.FieldArray(a.id, func(d *decode.D) {
+		defer delete(o.locals, "_index")
+		defer delete(o.locals, "_")
+
+		switch a.repeat {
+		case "eos":
+			for i := int64(0); d.Pos() < o.ioEnd; i++ {
+				o.locals["_index"] = i
+				vs = append(vs, o.decodeOne(d, a, a.id))
+			}
+		case "expr":
+			n := o.evalInt(a.repeatExpr)
+			for i := int64(0); i < n; i++ {
+				o.locals["_index"] = i
+				vs = append(vs, o.decodeOne(d, a, a.id))
+			}
+		case "until":
+			for i := int64(0); ; i++ {
+				o.locals["_index"] = i
+				v := o.decodeOne(d, a, a.id)
+				vs = append(vs, v)
+				o.locals["_"] = v
+				if o.evalBool(a.repeatUntil) {
+					break
+				}
+				if d.Pos() >= o.ioEnd {
+					o.fatalf("%s: end of stream before repeat-until", a.id)
+				}
+			}
+		}
+	})
+
+	return vs
+}
+
+// switchType returns the type and type arguments of the matching case
+func (o *obj) switchType(a *attrSpec) (string, []*expr) {
+	on := o.eval(a.switchOn)
+	for _, c := range a.cases {
+		if c.match == nil {
+			continue
+		}
+		if r, ok := compare(on, o.eval(c.match)); ok && r == 0 {
+			return c.typ, c.typArgs
+		}
+	}
+	for _, c := range a.cases {
+		if c.match == nil {
+			return c.typ, c.typArgs
+		}
+	}
+	return "", nil
+}
+
+func (o *obj) decodeOne(d *decode.D, a *attrSpec, name string) any {
+	typ, typArgs := a.typ, a.typArgs
+	if a.switchOn != nil {
+		typ, typArgs = o.switchType(a)
+	}
+
+	p, isPrimitive := parsePrimitive(typ)
+	if !isPrimitive || p.kind != 'b' {
+		// non bit sized types start at next byte
+		if r := d.Pos() % 8; r != 0 {
+			d.SeekRel(8 - r)
+		}
+	}
+
+	size := int64(-1)
+	if a.size != nil {
+		size = o.evalInt(a.size)
+		if size < 0 {
+			o.fatalf("%s: negative size %d", name, size)
+		}
+	} else if a.sizeEOS {
+		size = (o.ioEnd - d.Pos()) / 8
+	}
+
+	var v any
+	switch {
+	case a.hasContents:
+		d.FieldRawLen(name, int64(len(a.contents))*8, d.AssertBitBuf(a.contents), scalar.BitBufDescription(docDescription(a.doc)))
+		v = a.contents
+	case isPrimitive:
+		v = o.decodePrimitive(d, a, name, p)
+	case typ == "str", typ == "strz":
+		v = o.decodeStr(d, a, name, size)
+	case typ == "":
+		v = o.decodeBytes(d, a, name, size)
+	default:
+		t := o.t.lookupType(typ)
+		if t == nil {
+			o.fatalf("%s: type %q not found", name, typ)
+		}
+		if size >= 0 {
+			start := d.Pos()
+			d.FramedFn(size*8, func(d *decode.D) {
+				v = o.decodeUserType(d, t, typArgs, name, start, start+size*8)
+			})
+		} else {
+			v = o.decodeUserType(d, t, typArgs, name, o.ioStart, o.ioEnd)
+		}
+	}
+
+	if a.valid != nil {
+		o.validate(a, name, v)
+	}
+
+	return v
+}
+
+func (o *obj) decodeUserType(d *decode.D, t *typeSpec, typArgs []*expr, name string, ioStart int64, ioEnd int64) *obj {
+	if len(typArgs) != len(t.params) {
+		o.fatalf("%s: type %s expects %d arguments got %d", name, t.name, len(t.params), len(typArgs))
+	}
+	c := newObj(t, o, ioStart, ioEnd)
+	for i, p := range t.params {
+		c.params[p.id] = o.eval(typArgs[i])
+	}
+	d.FieldStruct(name, c.decode)
+	return c
+}
+
+func (o *obj) validate(a *attrSpec, name string, v any) {
+	vs := a.valid
+	check := func(e *expr, op string, ok func(c int) bool) {
+		if e == nil {
+			return
+		}
+		ev := o.eval(e)
+		if c, cok := compare(v, ev); !cok || !ok(c) {
+			o.fatalf("%s: validation failed, expected %s %s", name, op, e)
+		}
+	}
+	check(vs.eq, "==", func(c int) bool { return c == 0 })
+	check(vs.min, ">=", func(c int) bool { return c >= 0 })
+	check(vs.max, "<=", func(c int) bool { return c <= 0 })
+	if len(vs.anyOf) > 0 {
+		found := false
+		for _, e := range vs.anyOf {
+			if c, ok := compare(v, o.eval(e)); ok && c == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			o.fatalf("%s: validation failed, expected any-of", name)
+		}
+	}
+	if vs.expr != nil {
+		o.locals["_"] = v
+		ok := o.evalBool(vs.expr)
+		delete(o.locals, "_")
+		if !ok {
+			o.fatalf("%s: validation failed, expected %s", name, vs.expr)
+		}
+	}
+}
+
+type primitive struct {
+	kind      byte // u, s, f or b
+	nBits     int
+	endian    decode.Endian
+	hasEndian bool
+}
+
+var primitiveRe = regexp.MustCompile(`^(?:([us])([1248])|f([48])|b([1-9][0-9]*))(le|be)?$`)
+
+func parsePrimitive(typ string) (primitive, bool) {
+	sm := primitiveRe.FindStringSubmatch(typ)
+	if sm == nil {
+		return primitive{}, false
+	}
+	var p primitive
+	switch {
+	case sm[1] != "":
+		p.kind = sm[1][0]
+		n, _ := strconv.Atoi(sm[2])
+		p.nBits = n * 8
+	case sm[3] != "":
+		p.kind = 'f'
+		n, _ := strconv.Atoi(sm[3])
+		p.nBits = n * 8
+	default:
+		p.kind = 'b'
+		p.nBits, _ = strconv.Atoi(sm[4])
+		if p.nBits > 64 {
+			return primitive{}, false
+		}
+	}
+	switch sm[5] {
+	case "le":
+		p.endian, p.hasEndian = decode.LittleEndian, true
+	case "be":
+		p.endian, p.hasEndian = decode.BigEndian, true
+	}
+	return p, true
+}
+
+func (o *obj) decodePrimitive(d *decode.D, a *attrSpec, name string, p primitive) any {
+	var endian decode.Endian = decode.BigEndian
+	switch {
+	case p.kind == 'b':
+		// only big endian bit order is supported
+	case p.hasEndian:
+		endian = p.endian
+	case p.nBits > 8:
+		var ok bool
+		if endian, ok = o.t.lookupEndian(); !ok {
+			o.fatalf("%s: %d bit type without endian, set meta endian", name, p.nBits)
+		}
+	}
+
+	var en *enumSpec
+	if a.enum != "" {
+		en = o.lookupEnum(a.enum)
+	}
+	doc := docDescription(a.doc)
+
+	switch p.kind {
+	case 'u', 'b':
+		if p.kind == 'b' && p.nBits == 1 && en == nil {
+			return d.FieldBool(name, scalar.BoolDescription(doc))
+		}
+		var sm scalar.UintMapper = scalar.UintDescription(doc)
+		if en != nil {
+			sm = uintEnumMap(en)
+		}
+		n := int64(d.FieldUE(name, p.nBits, endian, sm))
+		if en != nil {
+			return enumVal{e: en, v: n}
+		}
+		return n
+	case 's':
+		var sm scalar.SintMapper = scalar.SintDescription(doc)
+		if en != nil {
+			sm = sintEnumMap(en)
+		}
+		n := d.FieldSE(name, p.nBits, endian, sm)
+		if en != nil {
+			return enumVal{e: en, v: n}
+		}
+		return n
+	default:
+		return d.FieldFE(name, p.nBits, endian, scalar.FltDescription(doc))
+	}
+}
+
+// bytesLen returns number of bytes a str or bytes field covers based on
+// size, terminator, include and consume
+func (o *obj) bytesLen(d *decode.D, a *attrSpec, name string, size int64) int64 {
+	if a.terminator < 0 {
+		if size < 0 {
+			o.fatalf("%s: no size or terminator", name)
+		}
+		return size
+	}
+	if size >= 0 {
+		// terminator is searched for in the sized bytes
+		return size
+	}
+
+	limit := o.ioEnd - d.Pos()
+	found := int64(-1)
+	if limit >= 8 {
+		var err error
+		found, _, err = d.TryPeekFind(8, 8, limit, func(v uint64) bool { return v == uint64(a.terminator) })
+		if err != nil {
+			found = -1
+		}
+	}
+	if found < 0 {
+		if a.eosError {
+			o.fatalf("%s: terminator %d not found", name, a.terminator)
+		}
+		return limit / 8
+	}
+	n := found / 8
+	if a.consume || a.include {
+		n++
+	}
+	return n
+}
+
+// bytesValue strips padding and terminator
+func (o *obj) bytesValue(a *attrSpec, bs []byte) []byte {
+	if a.padRight >= 0 {
+		bs = bytes.TrimRight(bs, string([]byte{byte(a.padRight)}))
+	}
+	if a.terminator >= 0 {
+		if i := bytes.IndexByte(bs, byte(a.terminator)); i >= 0 {
+			if a.include {
+				i++
+			}
+			bs = bs[:i]
+		}
+	}
+	return bs
+}
+
+func (o *obj) decodeBytes(d *decode.D, a *attrSpec, name string, size int64) any {
+	n := o.bytesLen(d, a, name, size)
+	bs := o.bytesValue(a, d.BytesRange(d.Pos(), int(n)))
+	d.FieldRawLen(name, n*8, scalar.BitBufDescription(docDescription(a.doc)))
+	return bs
+}
+
+func (o *obj) decodeStr(d *decode.D, a *attrSpec, name string, size int64) any {
+	encName := a.encoding
+	if encName == "" {
+		encName = o.t.lookupEncoding()
+	}
+	enc := o.encoding(encName)
+	n := o.bytesLen(d, a, name, size)
+	return d.FieldStrFn(name, func(d *decode.D) string {
+		return o.decodeText(o.bytesValue(a, d.BytesLen(int(n))), enc)
+	}, scalar.StrDescription(docDescription(a.doc)))
+}
+
+func (o *obj) encoding(name string) encoding.Encoding {
+	switch strings.ToUpper(name) {
+	case "", "UTF-8", "UTF8", "ASCII", "US-ASCII":
+		return unicode.UTF8
+	case "UTF-16LE":
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case "UTF-16BE":
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	}
+	enc, err := ianaindex.IANA.Encoding(name)
+	if err != nil || enc == nil {
+		o.fatalf("unsupported encoding %q", name)
+	}
+	return enc
+}
+
+func (o *obj) lookupEnum(name string) *enumSpec {
+	e := o.t.lookupEnum(name)
+	if e == nil {
+		o.fatalf("enum %q not found", name)
+	}
+	return e
+}
+
+func uintEnumMap(e *enumSpec) scalar.UintMap {
+	m := scalar.UintMap{}
+	for k, v := range e.values {
+		m[uint64(k)] = scalar.Uint{Sym: v.id, Description: docDescription(v.doc)}
+	}
+	return m
+}
+
+func sintEnumMap(e *enumSpec) scalar.SintMap {
+	m := scalar.SintMap{}
+	for k, v := range e.values {
+		m[k] = scalar.Sint{Sym: v.id, Description: docDescription(v.doc)}
+	}
+	return m
+}
+
+// docDescription uses first line of doc as description
+func docDescription(doc string) string {
+	doc, _, _ = strings.Cut(strings.TrimSpace(doc), "\n")
+	return strings.TrimSpace(doc)
+}
+
+// typeBitSize returns size of a type if it's known without decoding
+func (o *obj) typeBitSize(typ string) (int64, bool) {
+	if p, ok := parsePrimitive(typ); ok {
+		return int64(p.nBits), true
+	}
+	t := o.t.lookupType(typ)
+	if t == nil {
+		return 0, false
+	}
+	var n int64
+	for _, a := range t.seq {
+		if a.ifExpr != nil || a.repeat != "" || a.switchOn != nil {
+			return 0, false
+		}
+		switch {
+		case a.hasContents:
+			n += int64(len(a.contents)) * 8
+		case a.size != nil && a.size.kind == exprInt:
+			n += a.size.i * 8
+		default:
+			if a.typ == "" {
+				return 0, false
+			}
+			tc := &obj{t: t, d: o.d}
+			an, ok := tc.typeBitSize(a.typ)
+			if !ok {
+				return 0, false
+			}
+			n += an
+		}
+	}
+	return n, true
+}
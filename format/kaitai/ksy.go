@@ -0,0 +1,616 @@
+package kaitai
+
+// Kaitai Struct YAML spec
+// https://doc.kaitai.io/ksy_reference.html
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wader/fq/pkg/decode"
+	"gopkg.in/yaml.v3"
+)
+
+type typeSpec struct {
+	name      string
+	parent    *typeSpec // lexically enclosing type, nil for root
+	endian    decode.Endian
+	hasEndian bool
+	encoding  string
+	doc       string
+	params    []*paramSpec
+	seq       []*attrSpec
+	instances []*attrSpec
+	types     map[string]*typeSpec
+	enums     map[string]*enumSpec
+}
+
+type paramSpec struct {
+	id  string
+	typ string
+}
+
+type enumValue struct {
+	id  string
+	doc string
+}
+
+type enumSpec struct {
+	name   string
+	values map[int64]enumValue
+	byID   map[string]int64
+}
+
+type switchCase struct {
+	match   *expr // nil for default case "_"
+	typ     string
+	typArgs []*expr
+}
+
+type attrSpec struct {
+	id          string
+	doc         string
+	typ         string
+	typArgs     []*expr // user type arguments, ex: type: foo(1, bar)
+	switchOn    *expr
+	cases       []switchCase
+	contents    []byte
+	hasContents bool
+	size        *expr
+	sizeEOS     bool
+	repeat      string // "eos", "expr" or "until"
+	repeatExpr  *expr
+	repeatUntil *expr
+	ifExpr      *expr
+	enum        string
+	encoding    string
+	terminator  int // -1 if none
+	consume     bool
+	include     bool
+	eosError    bool
+	padRight    int // -1 if none
+	valid       *validSpec
+
+	// instance only
+	pos   *expr
+	value *expr
+}
+
+// validSpec is a value validation, all set constraints must hold
+type validSpec struct {
+	eq    *expr
+	min   *expr
+	max   *expr
+	anyOf []*expr
+	expr  *expr // with _ as the value
+}
+
+// yamlMap returns mapping node keys and values in document order
+func yamlMap(n *yaml.Node) ([]string, []*yaml.Node, error) {
+	if n.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("line %d: expected a mapping", n.Line)
+	}
+	var keys []string
+	var values []*yaml.Node
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		keys = append(keys, n.Content[i].Value)
+		values = append(values, n.Content[i+1])
+	}
+	return keys, values, nil
+}
+
+func yamlScalar(n *yaml.Node) (string, error) {
+	if n.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("line %d: expected a scalar", n.Line)
+	}
+	return n.Value, nil
+}
+
+func yamlBool(n *yaml.Node) (bool, error) {
+	var b bool
+	if err := n.Decode(&b); err != nil {
+		return false, fmt.Errorf("line %d: expected a boolean", n.Line)
+	}
+	return b, nil
+}
+
+func yamlInt(n *yaml.Node) (int64, error) {
+	s, err := yamlScalar(n)
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(strings.ReplaceAll(s, "_", ""), 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: expected an integer", n.Line)
+	}
+	return i, nil
+}
+
+// yamlExpr parses a scalar as an expression, integers and booleans are also expressions
+func yamlExpr(n *yaml.Node) (*expr, error) {
+	s, err := yamlScalar(n)
+	if err != nil {
+		return nil, err
+	}
+	e, err := parseExpr(s)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", n.Line, err)
+	}
+	return e, nil
+}
+
+func parseEndian(n *yaml.Node) (decode.Endian, error) {
+	s, err := yamlScalar(n)
+	if err != nil {
+		return 0, fmt.Errorf("calculated endian not supported: %w", err)
+	}
+	switch s {
+	case "le":
+		return decode.LittleEndian, nil
+	case "be":
+		return decode.BigEndian, nil
+	default:
+		return 0, fmt.Errorf("line %d: unknown endian %q", n.Line, s)
+	}
+}
+
+// parseContents parses contents that can be a string, a byte or an array of strings and bytes
+func parseContents(n *yaml.Node) ([]byte, error) {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if n.Tag == "!!int" {
+			i, err := yamlInt(n)
+			if err != nil {
+				return nil, err
+			}
+			return []byte{byte(i)}, nil
+		}
+		return []byte(n.Value), nil
+	case yaml.SequenceNode:
+		var bs []byte
+		for _, c := range n.Content {
+			b, err := parseContents(c)
+			if err != nil {
+				return nil, err
+			}
+			bs = append(bs, b...)
+		}
+		return bs, nil
+	default:
+		return nil, fmt.Errorf("line %d: invalid contents", n.Line)
+	}
+}
+
+// parseTypeRef parses a type reference with optional arguments, ex: foo::bar(1, 2)
+func parseTypeRef(n *yaml.Node) (string, []*expr, error) {
+	s, err := yamlScalar(n)
+	if err != nil {
+		return "", nil, err
+	}
+	name, argsStr, ok := strings.Cut(s, "(")
+	if !ok {
+		return s, nil, nil
+	}
+	if !strings.HasSuffix(argsStr, ")") {
+		return "", nil, fmt.Errorf("line %d: invalid type %q", n.Line, s)
+	}
+	args, err := parseExprList(strings.TrimSuffix(argsStr, ")"))
+	if err != nil {
+		return "", nil, fmt.Errorf("line %d: %w", n.Line, err)
+	}
+	return strings.TrimSpace(name), args, nil
+}
+
+func parseAttr(n *yaml.Node, isInstance bool) (*attrSpec, error) {
+	a := &attrSpec{terminator: -1, padRight: -1, consume: true, eosError: true}
+	keys, values, err := yamlMap(n)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range keys {
+		v := values[i]
+		switch k {
+		case "id":
+			a.id, err = yamlScalar(v)
+		case "doc":
+			a.doc, err = yamlScalar(v)
+		case "doc-ref", "-orig-id":
+			// ignore
+		case "type":
+			if v.Kind == yaml.MappingNode {
+				err = parseSwitch(a, v)
+			} else {
+				a.typ, a.typArgs, err = parseTypeRef(v)
+			}
+		case "contents":
+			a.contents, err = parseContents(v)
+			a.hasContents = true
+		case "size":
+			a.size, err = yamlExpr(v)
+		case "size-eos":
+			a.sizeEOS, err = yamlBool(v)
+		case "repeat":
+			a.repeat, err = yamlScalar(v)
+			switch a.repeat {
+			case "eos", "expr", "until":
+			default:
+				err = fmt.Errorf("line %d: unknown repeat %q", v.Line, a.repeat)
+			}
+		case "repeat-expr":
+			a.repeatExpr, err = yamlExpr(v)
+		case "repeat-until":
+			a.repeatUntil, err = yamlExpr(v)
+		case "if":
+			a.ifExpr, err = yamlExpr(v)
+		case "enum":
+			a.enum, err = yamlScalar(v)
+		case "encoding":
+			a.encoding, err = yamlScalar(v)
+		case "terminator":
+			var t int64
+			t, err = yamlInt(v)
+			a.terminator = int(t)
+		case "consume":
+			a.consume, err = yamlBool(v)
+		case "include":
+			a.include, err = yamlBool(v)
+		case "eos-error":
+			a.eosError, err = yamlBool(v)
+		case "pad-right":
+			var p int64
+			p, err = yamlInt(v)
+			a.padRight = int(p)
+		case "pos":
+			a.pos, err = yamlExpr(v)
+		case "value":
+			a.value, err = yamlExpr(v)
+		case "valid":
+			a.valid, err = parseValid(v)
+		case "process", "io":
+			err = fmt.Errorf("line %d: %s not supported", v.Line, k)
+		default:
+			err = fmt.Errorf("line %d: unknown key %q", v.Line, k)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !isInstance && a.id == "" {
+		// anonymous fields are allowed in seq
+		a.id = "_unnamed"
+	}
+	if (a.pos != nil || a.value != nil) && !isInstance {
+		return nil, fmt.Errorf("line %d: pos and value are only allowed in instances", n.Line)
+	}
+	if a.repeat == "expr" && a.repeatExpr == nil {
+		return nil, fmt.Errorf("line %d: repeat expr without repeat-expr", n.Line)
+	}
+	if a.repeat == "until" && a.repeatUntil == nil {
+		return nil, fmt.Errorf("line %d: repeat until without repeat-until", n.Line)
+	}
+	if a.typ == "strz" && a.terminator == -1 {
+		a.terminator = 0
+	}
+	return a, nil
+}
+
+func parseValid(n *yaml.Node) (*validSpec, error) {
+	vs := &validSpec{}
+	if n.Kind == yaml.ScalarNode {
+		e, err := yamlExpr(n)
+		if err != nil {
+			return nil, err
+		}
+		vs.eq = e
+		return vs, nil
+	}
+	keys, values, err := yamlMap(n)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range keys {
+		v := values[i]
+		switch k {
+		case "eq":
+			vs.eq, err = yamlExpr(v)
+		case "min":
+			vs.min, err = yamlExpr(v)
+		case "max":
+			vs.max, err = yamlExpr(v)
+		case "expr":
+			vs.expr, err = yamlExpr(v)
+		case "any-of":
+			if v.Kind != yaml.SequenceNode {
+				return nil, fmt.Errorf("line %d: any-of should be an array", v.Line)
+			}
+			for _, an := range v.Content {
+				var e *expr
+				if e, err = yamlExpr(an); err != nil {
+					return nil, err
+				}
+				vs.anyOf = append(vs.anyOf, e)
+			}
+		default:
+			err = fmt.Errorf("line %d: unknown valid key %q", v.Line, k)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+func parseSwitch(a *attrSpec, n *yaml.Node) error {
+	keys, values, err := yamlMap(n)
+	if err != nil {
+		return err
+	}
+	for i, k := range keys {
+		v := values[i]
+		switch k {
+		case "switch-on":
+			if a.switchOn, err = yamlExpr(v); err != nil {
+				return err
+			}
+		case "cases":
+			caseKeys, caseValues, err := yamlMap(v)
+			if err != nil {
+				return err
+			}
+			for j, ck := range caseKeys {
+				typ, typArgs, err := parseTypeRef(caseValues[j])
+				if err != nil {
+					return err
+				}
+				sc := switchCase{typ: typ, typArgs: typArgs}
+				if ck != "_" {
+					if sc.match, err = parseExpr(ck); err != nil {
+						return fmt.Errorf("line %d: %w", caseValues[j].Line, err)
+					}
+				}
+				a.cases = append(a.cases, sc)
+			}
+		default:
+			return fmt.Errorf("line %d: unknown switch key %q", v.Line, k)
+		}
+	}
+	if a.switchOn == nil {
+		return fmt.Errorf("line %d: switch without switch-on", n.Line)
+	}
+	return nil
+}
+
+func parseEnum(name string, n *yaml.Node) (*enumSpec, error) {
+	e := &enumSpec{name: name, values: map[int64]enumValue{}, byID: map[string]int64{}}
+	keys, values, err := yamlMap(n)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range keys {
+		v := values[i]
+		n, err := strconv.ParseInt(strings.ReplaceAll(k, "_", ""), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid enum key %q", v.Line, k)
+		}
+		var ev enumValue
+		switch v.Kind {
+		case yaml.ScalarNode:
+			ev.id = v.Value
+		case yaml.MappingNode:
+			vKeys, vValues, err := yamlMap(v)
+			if err != nil {
+				return nil, err
+			}
+			for j, vk := range vKeys {
+				switch vk {
+				case "id":
+					ev.id = vValues[j].Value
+				case "doc":
+					ev.doc = vValues[j].Value
+				}
+			}
+		default:
+			return nil, fmt.Errorf("line %d: invalid enum value", v.Line)
+		}
+		e.values[n] = ev
+		e.byID[ev.id] = n
+	}
+	return e, nil
+}
+
+func parseType(name string, parent *typeSpec, n *yaml.Node) (*typeSpec, error) {
+	t := &typeSpec{
+		name:   name,
+		parent: parent,
+		types:  map[string]*typeSpec{},
+		enums:  map[string]*enumSpec{},
+	}
+	keys, values, err := yamlMap(n)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range keys {
+		v := values[i]
+		switch k {
+		case "meta":
+			metaKeys, metaValues, err := yamlMap(v)
+			if err != nil {
+				return nil, err
+			}
+			for j, mk := range metaKeys {
+				mv := metaValues[j]
+				switch mk {
+				case "id":
+					if parent == nil {
+						t.name = mv.Value
+					}
+				case "endian":
+					if t.endian, err = parseEndian(mv); err != nil {
+						return nil, err
+					}
+					t.hasEndian = true
+				case "encoding":
+					t.encoding = mv.Value
+				case "bit-endian":
+					if mv.Value != "be" {
+						return nil, fmt.Errorf("line %d: bit-endian %q not supported", mv.Line, mv.Value)
+					}
+				case "imports":
+					if len(mv.Content) > 0 {
+						return nil, fmt.Errorf("line %d: imports not supported", mv.Line)
+					}
+				}
+			}
+		case "doc":
+			t.doc = v.Value
+		case "params":
+			if v.Kind != yaml.SequenceNode {
+				return nil, fmt.Errorf("line %d: params should be an array", v.Line)
+			}
+			for _, pn := range v.Content {
+				pKeys, pValues, err := yamlMap(pn)
+				if err != nil {
+					return nil, err
+				}
+				p := &paramSpec{}
+				for j, pk := range pKeys {
+					switch pk {
+					case "id":
+						p.id = pValues[j].Value
+					case "type":
+						p.typ = pValues[j].Value
+					}
+				}
+				t.params = append(t.params, p)
+			}
+		case "seq":
+			if v.Kind != yaml.SequenceNode {
+				return nil, fmt.Errorf("line %d: seq should be an array", v.Line)
+			}
+			for _, an := range v.Content {
+				a, err := parseAttr(an, false)
+				if err != nil {
+					return nil, err
+				}
+				t.seq = append(t.seq, a)
+			}
+		case "instances":
+			instKeys, instValues, err := yamlMap(v)
+			if err != nil {
+				return nil, err
+			}
+			for j, ik := range instKeys {
+				a, err := parseAttr(instValues[j], true)
+				if err != nil {
+					return nil, err
+				}
+				a.id = ik
+				t.instances = append(t.instances, a)
+			}
+		case "types":
+			typeKeys, typeValues, err := yamlMap(v)
+			if err != nil {
+				return nil, err
+			}
+			for j, tk := range typeKeys {
+				st, err := parseType(tk, t, typeValues[j])
+				if err != nil {
+					return nil, err
+				}
+				t.types[tk] = st
+			}
+		case "enums":
+			enumKeys, enumValues, err := yamlMap(v)
+			if err != nil {
+				return nil, err
+			}
+			for j, ek := range enumKeys {
+				e, err := parseEnum(ek, enumValues[j])
+				if err != nil {
+					return nil, err
+				}
+				t.enums[ek] = e
+			}
+		case "doc-ref", "-orig-id", "to-string":
+			// ignore
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", v.Line, k)
+		}
+	}
+	return t, nil
+}
+
+// parseSpec parses a .ksy YAML spec and returns the root type
+func parseSpec(s string) (*typeSpec, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 {
+		return nil, errors.New("expected one YAML document")
+	}
+	return parseType("", nil, doc.Content[0])
+}
+
+// lookupType finds type by name or name::name path starting at t and then
+// in enclosing types
+func (t *typeSpec) lookupType(name string) *typeSpec {
+	parts := strings.Split(name, "::")
+	for s := t; s != nil; s = s.parent {
+		if ft := s.lookupTypePath(parts); ft != nil {
+			return ft
+		}
+	}
+	return nil
+}
+
+func (t *typeSpec) lookupTypePath(parts []string) *typeSpec {
+	c := t
+	for _, p := range parts {
+		st, ok := c.types[p]
+		if !ok {
+			return nil
+		}
+		c = st
+	}
+	return c
+}
+
+// lookupEnum finds enum by name or type::name path starting at t and then
+// in enclosing types
+func (t *typeSpec) lookupEnum(name string) *enumSpec {
+	parts := strings.Split(name, "::")
+	enumName := parts[len(parts)-1]
+	typeParts := parts[:len(parts)-1]
+	for s := t; s != nil; s = s.parent {
+		et := s.lookupTypePath(typeParts)
+		if et == nil {
+			continue
+		}
+		if e, ok := et.enums[enumName]; ok {
+			return e
+		}
+	}
+	return nil
+}
+
+// endianness is inherited from enclosing types
+func (t *typeSpec) lookupEndian() (decode.Endian, bool) {
+	for s := t; s != nil; s = s.parent {
+		if s.hasEndian {
+			return s.endian, true
+		}
+	}
+	return 0, false
+}
+
+func (t *typeSpec) lookupEncoding() string {
+	for s := t; s != nil; s = s.parent {
+		if s.encoding != "" {
+			return s.encoding
+		}
+	}
+	return ""
+}
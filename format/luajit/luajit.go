@@ -51,6 +51,7 @@ func init() {
 		&decode.Format{
 			Description: "LuaJIT 2.0 bytecode",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{0x1b, 0x4c, 0x4a}}},
 			DecodeFn:    LuaJITDecode,
 		})
 	interp.RegisterFS(LuaJITFS)
@@ -0,0 +1,331 @@
+package lz4
+
+// https://github.com/lz4/lz4/blob/dev/doc/lz4_Frame_format.md
+// TODO: decode sequences of compressed blocks
+// TODO: dictionaries
+
+import (
+	"io"
+
+	lz4lib "github.com/pierrec/lz4/v4"
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+var probeGroup decode.Group
+
+func init() {
+	interp.RegisterFormat(
+		format.LZ4,
+		&decode.Format{
+			Description: "LZ4 frame compression",
+			Groups:      []*decode.Group{format.Probe},
+			Signatures: []decode.Signature{
+				{Bytes: []byte{0x04, 0x22, 0x4d, 0x18}},
+				{Bytes: []byte{0x02, 0x21, 0x4c, 0x18}}, // legacy
+			},
+			DecodeFn: lz4Decode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
+			},
+			SkipFromFunction: true, // from_lz4 is defined in compress and returns uncompressed binary
+		})
+}
+
+const frameMagic = 0x184d_2204
+const legacyFrameMagic = 0x184c_2102
+const skippableFrameMagicFirst = 0x184d_2a50
+const skippableFrameMagicLast = 0x184d_2a5f
+
+var blockMaxSizeNames = scalar.UintMap{
+	4: {Sym: "64kb", Description: "64 KB"},
+	5: {Sym: "256kb", Description: "256 KB"},
+	6: {Sym: "1mb", Description: "1 MB"},
+	7: {Sym: "4mb", Description: "4 MB"},
+}
+
+const legacyBlockMaxSize = 8 * 1024 * 1024
+
+// max uncompressed size per compressed byte, a match length byte of 255 adds
+// 255 bytes and some margin for the minimum match length
+const maxExpandRatio = 256
+
+// dependent blocks can refer to previous 64KB of uncompressed data
+const blockDictionarySize = 64 * 1024
+
+func blockMaxSize(n uint64) int {
+	return 1 << (8 + 2*n)
+}
+
+func isSkippableFrameMagic(magic uint64) bool {
+	return magic >= skippableFrameMagicFirst && magic <= skippableFrameMagicLast
+}
+
+type lz4Block struct {
+	r            ranges.Range // in bytes relative to start of frames
+	uncompressed bool
+}
+
+type lz4Frame struct {
+	blocks      []lz4Block
+	independent bool
+	maxSize     int
+}
+
+// framesReader reads uncompressed data of frames from r one block at a time.
+// Blocks are in order and r is positioned at the start of the first frame.
+type framesReader struct {
+	r      io.Reader
+	frames []lz4Frame
+	pos    int64
+	frame  *lz4Frame
+	data   []byte // compressed block
+	buf    []byte // uncompressed block, reused for all blocks
+	dict   []byte // previous uncompressed data for dependent blocks
+	out    []byte
+}
+
+func (fr *framesReader) nextBlock() error {
+	for fr.frame == nil || len(fr.frame.blocks) == 0 {
+		if len(fr.frames) == 0 {
+			return io.EOF
+		}
+		fr.frame = &fr.frames[0]
+		fr.frames = fr.frames[1:]
+		fr.dict = fr.dict[:0]
+	}
+	b := fr.frame.blocks[0]
+	fr.frame.blocks = fr.frame.blocks[1:]
+
+	if _, err := io.CopyN(io.Discard, fr.r, b.r.Start-fr.pos); err != nil {
+		return err
+	}
+	if cap(fr.data) < int(b.r.Len) {
+		fr.data = make([]byte, b.r.Len)
+	}
+	fr.data = fr.data[:b.r.Len]
+	if _, err := io.ReadFull(fr.r, fr.data); err != nil {
+		return err
+	}
+	fr.pos = b.r.Stop()
+
+	if b.uncompressed {
+		fr.out = fr.data
+	} else {
+		var dict []byte
+		if !fr.frame.independent {
+			dict = fr.dict
+		}
+		// a sequence can at most expand to about 255 times its size so no need
+		// to allocate max block size for small blocks, ex: 8MB for legacy frames
+		size := min(fr.frame.maxSize, len(fr.data)*maxExpandRatio)
+		if cap(fr.buf) < size {
+			fr.buf = make([]byte, size)
+		}
+		n, err := lz4lib.UncompressBlockWithDict(fr.data, fr.buf[:size], dict)
+		if err != nil {
+			return err
+		}
+		fr.out = fr.buf[0:n]
+	}
+	if !fr.frame.independent {
+		fr.dict = append(fr.dict, fr.out...)
+		if len(fr.dict) > blockDictionarySize {
+			fr.dict = append(fr.dict[:0], fr.dict[len(fr.dict)-blockDictionarySize:]...)
+		}
+	}
+
+	return nil
+}
+
+func (fr *framesReader) Read(p []byte) (int, error) {
+	for len(fr.out) == 0 {
+		if err := fr.nextBlock(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, fr.out)
+	fr.out = fr.out[n:]
+	return n, nil
+}
+
+func lz4DecodeSkippableFrame(d *decode.D) {
+	d.FieldU32("magic", d.UintAssertRange(skippableFrameMagicFirst, skippableFrameMagicLast), scalar.UintHex)
+	size := d.FieldU32("size")
+	d.FieldRawLen("data", int64(size)*8)
+}
+
+// returns false if frame can't be decompressed
+func lz4DecodeFrame(d *decode.D, framesStart int64) (lz4Frame, bool) {
+	d.FieldU32("magic", d.UintAssert(frameMagic), scalar.UintHex)
+
+	var frame lz4Frame
+	var hasBlockChecksum bool
+	var hasContentSize bool
+	var hasContentChecksum bool
+	var hasDictionaryID bool
+	descriptorStart := d.Pos()
+	d.FieldStruct("descriptor", func(d *decode.D) {
+		d.FieldStruct("flags", func(d *decode.D) {
+			d.FieldU2("version", d.UintAssert(1))
+			frame.independent = d.FieldBool("block_independence")
+			hasBlockChecksum = d.FieldBool("block_checksum")
+			hasContentSize = d.FieldBool("content_size")
+			hasContentChecksum = d.FieldBool("content_checksum")
+			d.FieldU1("reserved")
+			hasDictionaryID = d.FieldBool("dictionary_id")
+		})
+		d.FieldStruct("block_descriptor", func(d *decode.D) {
+			d.FieldU1("reserved0")
+			frame.maxSize = blockMaxSize(d.FieldU3("block_max_size", blockMaxSizeNames))
+			d.FieldU4("reserved1")
+		})
+		if hasContentSize {
+			d.FieldU64("content_size")
+		}
+		if hasDictionaryID {
+			d.FieldU32("dictionary_id")
+		}
+		xxh32W := checksum.NewXXH32(0)
+		d.CopyBits(xxh32W, d.BitBufRange(descriptorStart, d.Pos()-descriptorStart))
+		d.FieldChecksumU("header_checksum", 8, "xxh32", []byte{byte(xxh32W.Sum32() >> 8)}, scalar.UintHex)
+	})
+
+	d.FieldArray("blocks", func(d *decode.D) {
+		for {
+			endMark := false
+			d.FieldStruct("block", func(d *decode.D) {
+				header := d.FieldU32("header", scalar.UintHex)
+				if header == 0 {
+					endMark = true
+					d.FieldValueBool("end_mark", true)
+					return
+				}
+				size := header & 0x7fff_ffff
+				isUncompressed := header&0x8000_0000 != 0
+				d.FieldValueBool("uncompressed", isUncompressed)
+				d.FieldValueUint("size", size)
+				frame.blocks = append(frame.blocks, lz4Block{
+					r:            ranges.Range{Start: (d.Pos() - framesStart) / 8, Len: int64(size)},
+					uncompressed: isUncompressed,
+				})
+				dataBR := d.FieldRawLen("data", int64(size)*8)
+				if hasBlockChecksum {
+					xxh32W := checksum.NewXXH32(0)
+					d.CopyBits(xxh32W, dataBR)
+					d.FieldChecksumU("checksum", 32, "xxh32", xxh32W.Sum(nil), scalar.UintHex)
+				}
+			})
+			if endMark {
+				break
+			}
+		}
+	})
+
+	// dictionaries are not supported
+	canDecompress := !hasDictionaryID
+
+	if hasContentChecksum {
+		var sum []byte
+		if canDecompress {
+			// hash content by streaming the uncompressed blocks of this frame
+			xxh32W := checksum.NewXXH32(0)
+			fr := &framesReader{
+				r:      bitio.NewIOReadSeeker(d.BitBufRange(framesStart, d.Pos()-framesStart)),
+				frames: []lz4Frame{frame},
+			}
+			if _, err := io.Copy(xxh32W, fr); err == nil {
+				sum = xxh32W.Sum(nil)
+			} else {
+				canDecompress = false
+			}
+		}
+		if sum != nil {
+			d.FieldChecksumU("content_checksum", 32, "xxh32", sum, scalar.UintHex)
+		} else {
+			d.FieldU32("content_checksum", scalar.UintHex)
+		}
+	}
+
+	return frame, canDecompress
+}
+
+// legacy frame has no end mark, ends at end of input or next magic
+func lz4DecodeLegacyFrame(d *decode.D, framesStart int64) lz4Frame {
+	d.FieldU32("magic", d.UintAssert(legacyFrameMagic), scalar.UintHex)
+
+	frame := lz4Frame{independent: true, maxSize: legacyBlockMaxSize}
+	d.FieldArray("blocks", func(d *decode.D) {
+		for d.BitsLeft() >= 32 {
+			size := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+			if size == legacyFrameMagic || size == frameMagic || isSkippableFrameMagic(size) {
+				break
+			}
+			d.FieldStruct("block", func(d *decode.D) {
+				d.FieldU32("size")
+				frame.blocks = append(frame.blocks, lz4Block{
+					r: ranges.Range{Start: (d.Pos() - framesStart) / 8, Len: int64(size)},
+				})
+				d.FieldRawLen("data", int64(size)*8)
+			})
+		}
+	})
+
+	return frame
+}
+
+func lz4Decode(d *decode.D) any {
+	d.Endian = decode.LittleEndian
+
+	start := d.Pos()
+	canDecompress := true
+	var frames []lz4Frame
+	d.FieldArray("frames", func(d *decode.D) {
+		for d.BitsLeft() >= 32 {
+			magic := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+			switch {
+			case magic == frameMagic:
+				d.FieldStruct("frame", func(d *decode.D) {
+					frame, ok := lz4DecodeFrame(d, start)
+					if !ok {
+						canDecompress = false
+					}
+					frames = append(frames, frame)
+				})
+			case magic == legacyFrameMagic:
+				d.FieldStruct("frame", func(d *decode.D) {
+					frames = append(frames, lz4DecodeLegacyFrame(d, start))
+				})
+			case isSkippableFrameMagic(magic):
+				d.FieldStruct("frame", lz4DecodeSkippableFrame)
+			default:
+				if len(frames) == 0 {
+					d.Fatalf("invalid frame magic")
+				}
+				return
+			}
+		}
+	})
+
+	// skippable frames only is not enough, magic is shared between lz4 and zstd
+	if len(frames) == 0 {
+		d.Fatalf("no frames found")
+	}
+	if !canDecompress {
+		return nil
+	}
+
+	_, uncompressedBR, dv, _, _ := d.TryFieldReaderRangeFormat("uncompressed", start, d.Pos()-start, func(r io.Reader) io.Reader {
+		return &framesReader{r: r, frames: frames}
+	}, &probeGroup, format.Probe_In{})
+	if dv == nil && uncompressedBR != nil {
+		d.FieldRootBitBuf("uncompressed", uncompressedBR)
+	}
+
+	return nil
+}
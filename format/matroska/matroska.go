@@ -7,13 +7,13 @@ package matroska
 // https://wiki.xiph.org/MatroskaOpus
 
 // TODO: refactor simepleblock/block to just defer decode etc?
-// TODO: CRC
 // TODO: handle garbage (see tcl and example files)
 // TODO: could use md5 here somehow, see flac.go
 
 import (
 	"embed"
 	"fmt"
+	"hash/crc32"
 	"time"
 
 	"github.com/wader/fq/format"
@@ -57,6 +57,7 @@ func init() {
 		&decode.Format{
 			Description: "Matroska file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{0x1a, 0x45, 0xdf, 0xa3}}},
 			DecodeFn:    matroskaDecode,
 			DefaultInArg: format.Matroska_In{
 				DecodeSamples: true,
@@ -260,6 +261,7 @@ type decodeContext struct {
 
 func decodeMaster(d *decode.D, bitsLimit int64, elm *ebml.Master, unknownSize bool, dc *decodeContext) {
 	tagEndBit := d.Pos() + bitsLimit
+	masterUnknownSize := unknownSize
 
 	d.FieldArray("elements", func(d *decode.D) {
 		for d.Pos() < tagEndBit && !d.End() {
@@ -418,6 +420,19 @@ func decodeMaster(d *decode.D, bitsLimit int64, elm *ebml.Master, unknownSize bo
 						d.SeekRel(int64(tagSize) * 8)
 					case ebml_matroska.FileDataID:
 						d.FieldFormatOrRawLen("value", int64(tagSize)*8, &imageGroup, nil)
+					case ebml.CRC32ID:
+						if tagSize != 4 {
+							d.FieldRawLen("value", int64(tagSize)*8)
+							break
+						}
+						// little endian crc32 of the rest of the parent master element
+						d.FieldU32LE("value", scalar.UintHex)
+						crcStart := d.Pos()
+						if !masterUnknownSize && tagEndBit <= d.Len() {
+							crc32W := crc32.NewIEEE()
+							d.CopyBits(crc32W, d.BitBufRange(crcStart, tagEndBit-crcStart))
+							d.ValidateChecksum(d.FieldMustGet("value"), "crc32", crc32W.Sum(nil))
+						}
 					default:
 						d.FieldRawLen("value", int64(tagSize)*8)
 					}
@@ -465,7 +480,10 @@ func matroskaDecode(d *decode.D) any {
 				})
 			})
 		case "A_AAC":
-			_, v := t.parentD.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegASCFrameGroup, nil)
+			var v any
+			t.parentD.EagerFn(func(d *decode.D) {
+				_, v = d.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegASCFrameGroup, nil)
+			})
 			mpegASCOut, ok := v.(format.MPEG_ASC_Out)
 			if !ok {
 				panic(fmt.Sprintf("expected mpegASCOut got %#+v", v))
@@ -488,14 +506,20 @@ func matroskaDecode(d *decode.D) any {
 				})
 			})
 		case "V_MPEG4/ISO/AVC":
-			_, v := t.parentD.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegAVCDCRGroup, nil)
+			var v any
+			t.parentD.EagerFn(func(d *decode.D) {
+				_, v = d.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegAVCDCRGroup, nil)
+			})
 			avcDcrOut, ok := v.(format.AVC_DCR_Out)
 			if !ok {
 				panic(fmt.Sprintf("expected AvcDcrOut got %#+v", v))
 			}
 			t.formatInArg = format.AVC_AU_In(avcDcrOut)
 		case "V_MPEGH/ISO/HEVC":
-			_, v := t.parentD.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegHEVCDCRGroup, nil)
+			var v any
+			t.parentD.EagerFn(func(d *decode.D) {
+				_, v = d.FieldFormatRange("value", t.codecPrivatePos, t.codecPrivateTagSize, &mpegHEVCDCRGroup, nil)
+			})
 			hevcDcrOut, ok := v.(format.HEVC_DCR_Out)
 			if !ok {
 				panic(fmt.Sprintf("expected HevcDcrOut got %#+v", v))
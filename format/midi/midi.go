@@ -42,6 +42,7 @@ func init() {
 		&decode.Format{
 			Description: "Standard MIDI file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("MThd")}},
 			DecodeFn:    decodeMIDI,
 		})
 
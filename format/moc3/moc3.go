@@ -20,6 +20,7 @@ func init() {
 		&decode.Format{
 			Description: "MOC3 file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("MOC3")}},
 			DecodeFn:    decodeMOC3,
 		})
 	interp.RegisterFS(moc3FS)
@@ -21,6 +21,7 @@ func init() {
 			ProbeOrder:  format.ProbeOrderBinFuzzy, // after most others (silent samples and jpeg header can look like mp3 sync)
 			Description: "MP3 file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("ID3")}},
 			DecodeFn:    mp3Decode,
 			DefaultInArg: format.MP3_In{
 				MaxUniqueHeaderConfigs: 5,
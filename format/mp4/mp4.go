@@ -66,7 +66,8 @@ func init() {
 				format.Probe,
 				format.Image, // avif
 			},
-			DecodeFn: mp4Decode,
+			Signatures: []decode.Signature{{Offset: 4, Bytes: []byte("ftyp")}},
+			DecodeFn:   mp4Decode,
 			DefaultInArg: format.MP4_In{
 				DecodeSamples:  true,
 				AllowTruncated: false,
@@ -366,7 +367,6 @@ func mp4Tracks(d *decode.D, ctx *decodeContext) {
 							if stszEntryNr >= stszEntry.count {
 								stszIndex++
 								if stszIndex >= len(t.stsz) {
-									// TODO: warning if unused stsc/stco entries?
 									break
 								}
 
@@ -398,6 +398,10 @@ func mp4Tracks(d *decode.D, ctx *decodeContext) {
 							stszEntryNr++
 							sampleNr++
 						}
+
+						if stcoIndex < len(t.stco)-1 {
+							d.Warnf("track %d: %d unused chunk offsets", t.id, len(t.stco)-1-stcoIndex)
+						}
 					}
 
 					sampleNr := 0
@@ -386,7 +386,7 @@ func frameDecode(d *decode.D) any {
 	d.CopyBits(crcHash, d.BitBufRange(6*8, int64(sideInfoBytes)*8))
 
 	if crcValue != nil {
-		_ = crcValue.TryUintScalarFn(d.UintValidateBytes(crcHash.Sum(nil)))
+		d.ValidateChecksum(crcValue, "crc16_cms", crcHash.Sum(nil))
 	}
 
 	d.FieldValueBitBuf("crc_calculated", bitio.NewBitReader(crcHash.Sum(nil), -1), scalar.RawHex)
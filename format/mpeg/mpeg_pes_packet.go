@@ -10,12 +10,21 @@ import (
 	"github.com/wader/fq/pkg/scalar"
 )
 
+var pesAVCAnnexbGroup decode.Group
+var pesHEVCAnnexbGroup decode.Group
+var pesADTSGroup decode.Group
+
 func init() {
 	interp.RegisterFormat(
 		format.MPEG_PES_Packet,
 		&decode.Format{
 			Description: "MPEG Packetized elementary stream packet",
 			DecodeFn:    pesPacketDecode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.AVC_Annexb}, Out: &pesAVCAnnexbGroup},
+				{Groups: []*decode.Group{format.HEVC_Annexb}, Out: &pesHEVCAnnexbGroup},
+				{Groups: []*decode.Group{format.ADTS}, Out: &pesADTSGroup},
+			},
 		})
 }
 
@@ -70,8 +79,24 @@ var mpegVersion = scalar.UintMapDescription{
 	0b10: "MPEG1",
 }
 
+func pesDecodeTimestamp(d *decode.D, name string) {
+	d.FieldStruct(name, func(d *decode.D) {
+		d.FieldU4("prefix")
+		ts0 := d.FieldU3("ts0")
+		d.FieldU1("marker_bit0")
+		ts1 := d.FieldU15("ts1")
+		d.FieldU1("marker_bit1")
+		ts2 := d.FieldU15("ts2")
+		d.FieldU1("marker_bit2")
+		// 90kHz clock
+		d.FieldValueUint("value", ts0<<30|ts1<<15|ts2)
+	})
+}
+
 func pesPacketDecode(d *decode.D) any {
 	var v any
+	var pi format.MPEG_PES_Packet_In
+	d.ArgAs(&pi)
 
 	d.FieldU24("prefix", d.UintAssert(0b0000_0000_0000_0000_0000_0001), scalar.UintBin)
 	startCode := d.FieldU8("start_code", startAndStreamNames, scalar.UintHex)
@@ -158,6 +183,7 @@ func pesPacketDecode(d *decode.D) any {
 		hasExtension := startCode == 0xbd || (startCode >= 0xc0 && startCode <= 0xef)
 		var headerDataLength uint64
 		var extensionLength uint64
+		var ptsDTSFlags uint64
 		if hasExtension {
 			extensionLength = 3
 			d.FieldStruct("extension", func(d *decode.D) {
@@ -167,7 +193,7 @@ func pesPacketDecode(d *decode.D) any {
 				d.FieldU1("data_alignment_indicator")
 				d.FieldU1("copyright")
 				d.FieldU1("original")
-				d.FieldU2("pts_dts_flags")
+				ptsDTSFlags = d.FieldU2("pts_dts_flags")
 				d.FieldU1("escr_flag")
 				d.FieldU1("es_rate_flag")
 				d.FieldU1("dsm_trick_mode_flag")
@@ -176,11 +202,32 @@ func pesPacketDecode(d *decode.D) any {
 				d.FieldU1("pes_ext_flag")
 				headerDataLength = d.FieldU8("header_data_length")
 			})
-			// TODO:
-			d.FieldRawLen("header_data", int64(headerDataLength)*8)
+			if headerDataLength > 0 {
+				d.FieldStruct("header_data", func(d *decode.D) {
+					d.FramedFn(int64(headerDataLength)*8, func(d *decode.D) {
+						// TODO: escr, es_rate etc
+						switch ptsDTSFlags {
+						case 0b10:
+							pesDecodeTimestamp(d, "pts")
+						case 0b11:
+							pesDecodeTimestamp(d, "pts")
+							pesDecodeTimestamp(d, "dts")
+						}
+						if d.BitsLeft() > 0 {
+							d.FieldRawLen("data", d.BitsLeft())
+						}
+					})
+				})
+			}
 		}
 
-		dataLen := int64(length-headerDataLength-extensionLength) * 8
+		var dataLen int64
+		if length == 0 {
+			// unbounded, allowed for video streams in transport streams
+			dataLen = d.BitsLeft()
+		} else {
+			dataLen = int64(length-headerDataLength-extensionLength) * 8
+		}
 
 		switch startCode {
 		case privateStream1:
@@ -196,7 +243,20 @@ func pesPacketDecode(d *decode.D) any {
 				})
 			})
 		default:
-			d.FieldRawLen("stream_data", dataLen)
+			var esGroup *decode.Group
+			switch pi.StreamType {
+			case tsStreamTypeH264:
+				esGroup = &pesAVCAnnexbGroup
+			case tsStreamTypeHEVC:
+				esGroup = &pesHEVCAnnexbGroup
+			case tsStreamTypeADTS:
+				esGroup = &pesADTSGroup
+			}
+			if esGroup != nil {
+				d.FieldFormatOrRawLen("stream_data", dataLen, esGroup, nil)
+			} else {
+				d.FieldRawLen("stream_data", dataLen)
+			}
 		}
 	default:
 		// nop
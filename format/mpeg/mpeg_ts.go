@@ -1,12 +1,23 @@
 package mpeg
 
+// ISO/IEC 13818-1 (H.222.0) Transport Stream
+// ETSI EN 300 468 Service Description Table
+// https://en.wikipedia.org/wiki/MPEG_transport_stream
+
+// TODO: m2ts 192 byte packets?
+// TODO: more PSI tables (EIT, TDT, ...)
+
 import (
 	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
 	"github.com/wader/fq/pkg/decode"
 	"github.com/wader/fq/pkg/interp"
 	"github.com/wader/fq/pkg/scalar"
 )
 
+var tsPESPacketGroup decode.Group
+
 func init() {
 	interp.RegisterFormat(
 		format.MPEG_TS,
@@ -15,20 +26,566 @@ func init() {
 			Description: "MPEG Transport Stream",
 			Groups:      []*decode.Group{format.Probe},
 			DecodeFn:    tsDecode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.MPEG_PES_Packet}, Out: &tsPESPacketGroup},
+			},
 		})
 }
 
-// TODO: ts_packet
+const (
+	tsPacketLength = 188
+	tsSyncByte     = 0x47
+)
 
-func tsDecode(d *decode.D) any {
-	d.FieldU8("sync", d.UintAssert(0x47), scalar.UintHex)
-	d.FieldBool("transport_error_indicator")
-	d.FieldBool("payload_unit_start")
+const (
+	tsPIDPAT  = 0x0000
+	tsPIDSDT  = 0x0011
+	tsPIDNull = 0x1fff
+)
+
+var tsPIDMap = scalar.UintMapSymStr{
+	0x0000: "pat",
+	0x0001: "cat",
+	0x0002: "tsdt",
+	0x0003: "ipmp",
+	0x0010: "nit",
+	0x0011: "sdt",
+	0x0012: "eit",
+	0x0013: "rst",
+	0x0014: "tdt",
+	0x1fff: "null",
+}
+
+var tsScramblingControlMap = scalar.UintMapSymStr{
+	0b00: "not_scrambled",
+	0b01: "reserved",
+	0b10: "even_key",
+	0b11: "odd_key",
+}
+
+const (
+	tsAdaptationFieldControlPayload         = 0b01
+	tsAdaptationFieldControlAdaptationField = 0b10
+)
+
+var tsAdaptationFieldControlMap = scalar.UintMapSymStr{
+	0b00: "reserved",
+	0b01: "payload_only",
+	0b10: "adaptation_field_only",
+	0b11: "adaptation_field_and_payload",
+}
+
+const (
+	tsTableIDPAT       = 0x00
+	tsTableIDCAT       = 0x01
+	tsTableIDPMT       = 0x02
+	tsTableIDSDTActual = 0x42
+	tsTableIDSDTOther  = 0x46
+)
+
+var tsTableIDMap = scalar.UintMapSymStr{
+	tsTableIDPAT:       "program_association",
+	tsTableIDCAT:       "conditional_access",
+	tsTableIDPMT:       "program_map",
+	0x03:               "transport_stream_description",
+	0x40:               "network_information_actual",
+	0x41:               "network_information_other",
+	tsTableIDSDTActual: "service_description_actual",
+	tsTableIDSDTOther:  "service_description_other",
+	0x4a:               "bouquet_association",
+	0x4e:               "event_information_actual_present",
+	0x4f:               "event_information_other_present",
+	0x70:               "time_date",
+	0x73:               "time_offset",
+	0xff:               "stuffing",
+}
+
+const (
+	tsStreamTypeMPEG1Video = 0x01
+	tsStreamTypeMPEG2Video = 0x02
+	tsStreamTypeADTS       = 0x0f
+	tsStreamTypeH264       = 0x1b
+	tsStreamTypeHEVC       = 0x24
+)
+
+var tsStreamTypeMap = scalar.UintMapSymStr{
+	tsStreamTypeMPEG1Video: "mpeg1_video",
+	tsStreamTypeMPEG2Video: "mpeg2_video",
+	0x03:                   "mpeg1_audio",
+	0x04:                   "mpeg2_audio",
+	0x05:                   "private_sections",
+	0x06:                   "private_pes",
+	0x0d:                   "dsm_cc",
+	tsStreamTypeADTS:       "adts_aac",
+	0x10:                   "mpeg4_visual",
+	0x11:                   "latm_aac",
+	0x15:                   "metadata_pes",
+	tsStreamTypeH264:       "h264",
+	tsStreamTypeHEVC:       "hevc",
+	0x81:                   "ac3",
+	0x86:                   "scte35",
+	0x87:                   "eac3",
+}
+
+var tsDescriptorTagMap = scalar.UintMapSymStr{
+	0x02: "video_stream",
+	0x03: "audio_stream",
+	0x05: "registration",
+	0x06: "data_stream_alignment",
+	0x09: "ca",
+	0x0a: "iso_639_language",
+	0x0e: "maximum_bitrate",
+	0x28: "avc_video",
+	0x2a: "avc_timing_and_hrd",
+	0x38: "hevc_video",
+	0x48: "service",
+	0x52: "stream_identifier",
+	0x56: "teletext",
+	0x59: "subtitling",
+	0x6a: "ac3",
+	0x7a: "enhanced_ac3",
+}
+
+var tsServiceTypeMap = scalar.UintMapSymStr{
+	0x01: "digital_television",
+	0x02: "digital_radio_sound",
+	0x03: "teletext",
+	0x0a: "advanced_codec_digital_radio_sound",
+	0x11: "mpeg2_hd_digital_television",
+	0x16: "advanced_codec_sd_digital_television",
+	0x19: "advanced_codec_hd_digital_television",
+	0x1f: "hevc_digital_television",
+}
+
+var tsRunningStatusMap = scalar.UintMapSymStr{
+	0: "undefined",
+	1: "not_running",
+	2: "starts_in_a_few_seconds",
+	3: "pausing",
+	4: "running",
+	5: "service_off_air",
+}
+
+type tsPIDKind int
+
+const (
+	tsPIDKindUnknown tsPIDKind = iota
+	tsPIDKindPSI
+	tsPIDKindPES
+)
+
+type tsPID struct {
+	kind              tsPIDKind
+	streamType        int
+	buf               []byte
+	started           bool
+	continuityCounter int
+	packetsD          *decode.D
+}
+
+type tsDemuxer struct {
+	pids      map[int]*tsPID
+	sectionsD *decode.D
+	streamsD  *decode.D
+}
+
+func (dm *tsDemuxer) pid(pid int) *tsPID {
+	p, ok := dm.pids[pid]
+	if !ok {
+		p = &tsPID{continuityCounter: -1}
+		dm.pids[pid] = p
+	}
+	return p
+}
+
+func (dm *tsDemuxer) addPSIPID(pid int) {
+	p := dm.pid(pid)
+	if p.kind == tsPIDKindUnknown {
+		p.kind = tsPIDKindPSI
+	}
+}
+
+func (dm *tsDemuxer) addPESPID(pid int, streamType int) {
+	p := dm.pid(pid)
+	if p.kind != tsPIDKindUnknown {
+		return
+	}
+	p.kind = tsPIDKindPES
+	p.streamType = streamType
+	dm.streamsD.FieldStruct("stream", func(d *decode.D) {
+		d.FieldValueUint("pid", uint64(pid), scalar.UintHex)
+		d.FieldValueUint("stream_type", uint64(streamType), tsStreamTypeMap, scalar.UintHex)
+		p.packetsD = d.FieldArrayValue("packets")
+	})
+}
+
+func tsDecodePCR(d *decode.D) {
+	base := d.FieldU33("base")
+	d.FieldU6("reserved")
+	ext := d.FieldU9("extension")
+	// 27MHz clock
+	d.FieldValueUint("value", base*300+ext)
+}
+
+func tsDecodeAdaptationField(d *decode.D) {
+	length := d.FieldU8("length")
+	if length == 0 {
+		return
+	}
+	if int64(length)*8 > d.BitsLeft() {
+		d.Fatalf("adaptation_field_length %d larger than packet", length)
+	}
+	d.FramedFn(int64(length)*8, func(d *decode.D) {
+		d.FieldBool("discontinuity_indicator")
+		d.FieldBool("random_access_indicator")
+		d.FieldBool("elementary_stream_priority_indicator")
+		pcrFlag := d.FieldBool("pcr_flag")
+		opcrFlag := d.FieldBool("opcr_flag")
+		splicingPointFlag := d.FieldBool("splicing_point_flag")
+		transportPrivateDataFlag := d.FieldBool("transport_private_data_flag")
+		extensionFlag := d.FieldBool("adaptation_field_extension_flag")
+		if pcrFlag {
+			d.FieldStruct("pcr", tsDecodePCR)
+		}
+		if opcrFlag {
+			d.FieldStruct("opcr", tsDecodePCR)
+		}
+		if splicingPointFlag {
+			d.FieldS8("splice_countdown")
+		}
+		if transportPrivateDataFlag {
+			privateDataLength := d.FieldU8("transport_private_data_length")
+			d.FieldRawLen("transport_private_data", int64(privateDataLength)*8)
+		}
+		if extensionFlag {
+			extensionLength := d.FieldU8("adaptation_field_extension_length")
+			d.FieldRawLen("adaptation_field_extension", int64(extensionLength)*8)
+		}
+		if d.BitsLeft() > 0 {
+			d.FieldRawLen("stuffing", d.BitsLeft())
+		}
+	})
+}
+
+func tsDecodeDescriptors(d *decode.D, nBytes int64) {
+	d.FramedFn(nBytes*8, func(d *decode.D) {
+		for d.NotEnd() {
+			d.FieldStruct("descriptor", func(d *decode.D) {
+				tag := d.FieldU8("tag", tsDescriptorTagMap, scalar.UintHex)
+				length := d.FieldU8("length")
+				if int64(length)*8 > d.BitsLeft() {
+					d.Fatalf("descriptor length %d past end of descriptors", length)
+				}
+				d.FramedFn(int64(length)*8, func(d *decode.D) {
+					switch tag {
+					case 0x0a:
+						d.FieldArray("languages", func(d *decode.D) {
+							for d.BitsLeft() >= 32 {
+								d.FieldStruct("language", func(d *decode.D) {
+									d.FieldUTF8("code", 3)
+									d.FieldU8("audio_type")
+								})
+							}
+						})
+					case 0x48:
+						d.FieldU8("service_type", tsServiceTypeMap, scalar.UintHex)
+						providerNameLength := d.FieldU8("service_provider_name_length")
+						d.FieldUTF8("service_provider_name", int(providerNameLength))
+						serviceNameLength := d.FieldU8("service_name_length")
+						d.FieldUTF8("service_name", int(serviceNameLength))
+					default:
+						d.FieldRawLen("data", d.BitsLeft())
+					}
+				})
+			})
+		}
+	})
+}
+
+func tsDecodeSection(dm *tsDemuxer, d *decode.D) {
+	sectionStart := d.Pos()
+	tableID := d.FieldU8("table_id", tsTableIDMap, scalar.UintHex)
+	sectionSyntaxIndicator := d.FieldBool("section_syntax_indicator")
+	d.FieldBool("private_indicator")
+	d.FieldU2("reserved0")
+	sectionLength := d.FieldU12("section_length")
+
+	if !sectionSyntaxIndicator {
+		d.FieldRawLen("data", int64(sectionLength)*8)
+		return
+	}
+	if sectionLength < 9 {
+		d.Fatalf("section_length %d too small", sectionLength)
+	}
+
+	d.FramedFn(int64(sectionLength-4)*8, func(d *decode.D) {
+		switch tableID {
+		case tsTableIDPAT:
+			d.FieldU16("transport_stream_id")
+		case tsTableIDPMT:
+			d.FieldU16("program_number")
+		case tsTableIDSDTActual, tsTableIDSDTOther:
+			d.FieldU16("transport_stream_id")
+		default:
+			d.FieldU16("table_id_extension")
+		}
+		d.FieldU2("reserved1")
+		d.FieldU5("version_number")
+		d.FieldBool("current_next_indicator")
+		d.FieldU8("section_number")
+		d.FieldU8("last_section_number")
+
+		switch tableID {
+		case tsTableIDPAT:
+			d.FieldArray("programs", func(d *decode.D) {
+				for d.BitsLeft() >= 32 {
+					d.FieldStruct("program", func(d *decode.D) {
+						programNumber := d.FieldU16("program_number")
+						d.FieldU3("reserved")
+						if programNumber == 0 {
+							d.FieldU13("network_pid", scalar.UintHex)
+						} else {
+							pmtPID := d.FieldU13("program_map_pid", scalar.UintHex)
+							dm.addPSIPID(int(pmtPID))
+						}
+					})
+				}
+			})
+		case tsTableIDPMT:
+			d.FieldU3("reserved2")
+			d.FieldU13("pcr_pid", scalar.UintHex)
+			d.FieldU4("reserved3")
+			programInfoLength := d.FieldU12("program_info_length")
+			d.FieldArray("descriptors", func(d *decode.D) {
+				tsDecodeDescriptors(d, int64(programInfoLength))
+			})
+			d.FieldArray("streams", func(d *decode.D) {
+				for d.BitsLeft() >= 40 {
+					d.FieldStruct("stream", func(d *decode.D) {
+						streamType := d.FieldU8("stream_type", tsStreamTypeMap, scalar.UintHex)
+						d.FieldU3("reserved0")
+						elementaryPID := d.FieldU13("elementary_pid", scalar.UintHex)
+						d.FieldU4("reserved1")
+						esInfoLength := d.FieldU12("es_info_length")
+						d.FieldArray("descriptors", func(d *decode.D) {
+							tsDecodeDescriptors(d, int64(esInfoLength))
+						})
+						dm.addPESPID(int(elementaryPID), int(streamType))
+					})
+				}
+			})
+		case tsTableIDSDTActual, tsTableIDSDTOther:
+			d.FieldU16("original_network_id")
+			d.FieldU8("reserved2")
+			d.FieldArray("services", func(d *decode.D) {
+				for d.BitsLeft() >= 40 {
+					d.FieldStruct("service", func(d *decode.D) {
+						d.FieldU16("service_id")
+						d.FieldU6("reserved")
+						d.FieldBool("eit_schedule_flag")
+						d.FieldBool("eit_present_following_flag")
+						d.FieldU3("running_status", tsRunningStatusMap)
+						d.FieldBool("free_ca_mode")
+						descriptorsLoopLength := d.FieldU12("descriptors_loop_length")
+						d.FieldArray("descriptors", func(d *decode.D) {
+							tsDecodeDescriptors(d, int64(descriptorsLoopLength))
+						})
+					})
+				}
+			})
+		default:
+			d.FieldRawLen("data", d.BitsLeft())
+		}
+	})
+
+	sectionCRC := &checksum.CRC{Bits: 32, Current: 0xffff_ffff, Table: checksum.Poly04c11db7Table}
+	d.CopyBits(sectionCRC, d.BitBufRange(sectionStart, d.Pos()-sectionStart))
+	d.FieldChecksumU("crc32", 32, "crc32_mpeg2", sectionCRC.Sum(nil), scalar.UintHex)
+}
+
+// emit all complete sections in buffer and keep what is left
+func (dm *tsDemuxer) flushSections(pid int, p *tsPID) {
+	for len(p.buf) >= 3 {
+		if p.buf[0] == 0xff {
+			// rest is stuffing
+			p.buf = nil
+			p.started = false
+			break
+		}
+		sectionLength := 3 + (int(p.buf[1]&0x0f)<<8 | int(p.buf[2]))
+		if len(p.buf) < sectionLength {
+			break
+		}
+
+		br := bitio.NewBitReader(p.buf[0:sectionLength], -1)
+		if _, err := dm.sectionsD.TryFieldStructRootBitBufFn("section", br, func(d *decode.D) {
+			d.FieldValueUint("pid", uint64(pid), tsPIDMap, scalar.UintHex)
+			tsDecodeSection(dm, d)
+		}); err != nil {
+			// keep broken section as raw and continue with next
+			v := dm.sectionsD.FieldStructRootBitBufFn("section", br, func(d *decode.D) {
+				d.FieldValueUint("pid", uint64(pid), tsPIDMap, scalar.UintHex)
+				d.FieldRawLen("data", d.BitsLeft())
+			})
+			v.Err = err
+		}
+
+		p.buf = p.buf[sectionLength:]
+	}
+}
+
+func (dm *tsDemuxer) flushPES(p *tsPID) {
+	if !p.started || len(p.buf) == 0 {
+		return
+	}
+
+	br := bitio.NewBitReader(p.buf, -1)
+	if _, _, err := p.packetsD.TryFieldFormatBitBuf("packet", br, &tsPESPacketGroup, format.MPEG_PES_Packet_In{StreamType: p.streamType}); err != nil {
+		p.packetsD.FieldRootBitBuf("packet", br)
+	}
+
+	p.buf = nil
+	p.started = false
+}
+
+func (dm *tsDemuxer) payload(pid int, payloadUnitStart bool, continuityCounter int, payload []byte) {
+	p, ok := dm.pids[pid]
+	if !ok {
+		return
+	}
+
+	if p.continuityCounter != -1 && continuityCounter != (p.continuityCounter+1)&0xf {
+		if continuityCounter == p.continuityCounter {
+			// duplicate packet
+			return
+		}
+		// discontinuity, drop partial data
+		p.buf = nil
+		p.started = false
+	}
+	p.continuityCounter = continuityCounter
+
+	switch p.kind {
+	case tsPIDKindPSI:
+		if payloadUnitStart {
+			if len(payload) == 0 {
+				return
+			}
+			pointerField := int(payload[0])
+			if 1+pointerField > len(payload) {
+				return
+			}
+			if p.started {
+				p.buf = append(p.buf, payload[1:1+pointerField]...)
+				dm.flushSections(pid, p)
+			}
+			p.buf = append([]byte{}, payload[1+pointerField:]...)
+			p.started = true
+		} else {
+			if !p.started {
+				return
+			}
+			p.buf = append(p.buf, payload...)
+		}
+		dm.flushSections(pid, p)
+	case tsPIDKindPES:
+		if payloadUnitStart {
+			dm.flushPES(p)
+			p.started = true
+		}
+		if !p.started {
+			return
+		}
+		p.buf = append(p.buf, payload...)
+
+		// flush as soon as possible if length is known
+		if len(p.buf) >= 6 {
+			pesPacketLength := int(p.buf[4])<<8 | int(p.buf[5])
+			if pesPacketLength != 0 && len(p.buf) >= 6+pesPacketLength {
+				p.buf = p.buf[0 : 6+pesPacketLength]
+				dm.flushPES(p)
+			}
+		}
+	}
+}
+
+func tsDecodePacket(dm *tsDemuxer, d *decode.D) {
+	d.FieldU8("sync", d.UintAssert(tsSyncByte), scalar.UintHex)
+	transportErrorIndicator := d.FieldBool("transport_error_indicator")
+	payloadUnitStart := d.FieldBool("payload_unit_start")
 	d.FieldBool("transport_priority")
-	d.FieldU13("pid")
-	d.FieldU2("transport_scrambling_control")
-	d.FieldU2("adaptation_field_control")
-	d.FieldU4("continuity_counter")
+	pid := d.FieldU13("pid", tsPIDMap, scalar.UintHex)
+	transportScramblingControl := d.FieldU2("transport_scrambling_control", tsScramblingControlMap)
+	adaptationFieldControl := d.FieldU2("adaptation_field_control", tsAdaptationFieldControlMap)
+	continuityCounter := d.FieldU4("continuity_counter")
+
+	if adaptationFieldControl&tsAdaptationFieldControlAdaptationField != 0 {
+		length := int64(d.PeekUintBits(8))
+		if v := d.FieldStructOrRawLenFn("adaptation_field", min((1+length)*8, d.BitsLeft()), tsDecodeAdaptationField); v.Err != nil {
+			// payload position is not known
+			return
+		}
+	}
+	if adaptationFieldControl&tsAdaptationFieldControlPayload == 0 || d.BitsLeft() == 0 {
+		return
+	}
+
+	payloadBR := d.FieldRawLen("payload", d.BitsLeft())
+
+	if transportErrorIndicator || transportScramblingControl != 0 || pid == tsPIDNull {
+		return
+	}
+	dm.payload(int(pid), payloadUnitStart, int(continuityCounter), d.ReadAllBits(payloadBR))
+}
+
+func tsDecode(d *decode.D) any {
+	if d.PeekUintBits(8) != tsSyncByte {
+		d.Fatalf("no sync byte found")
+	}
+	if d.BitsLeft() >= (tsPacketLength+1)*8 {
+		d.SeekRel(tsPacketLength*8, func(d *decode.D) {
+			if d.PeekUintBits(8) != tsSyncByte {
+				d.Fatalf("no sync byte found for second packet")
+			}
+		})
+	}
+
+	dm := &tsDemuxer{
+		pids: map[int]*tsPID{},
+	}
+	dm.addPSIPID(tsPIDPAT)
+	dm.addPSIPID(tsPIDSDT)
+
+	var packetsD *decode.D
+	packets := 0
+	d.FieldArray("packets", func(d *decode.D) {
+		packetsD = d
+	})
+	dm.sectionsD = d.FieldArrayValue("sections")
+	dm.streamsD = d.FieldArrayValue("streams")
+
+	for d.BitsLeft() >= tsPacketLength*8 {
+		if d.PeekUintBits(8) != tsSyncByte {
+			break
+		}
+		packetsD.FramedFn(tsPacketLength*8, func(d *decode.D) {
+			d.FieldStruct("packet", func(d *decode.D) {
+				tsDecodePacket(dm, d)
+			})
+		})
+		packets++
+	}
+
+	if packets == 0 {
+		d.Fatalf("no packets found")
+	}
+
+	// flush PES packets with unknown length
+	for _, p := range dm.pids {
+		if p.kind == tsPIDKindPES {
+			dm.flushPES(p)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,100 @@
+#!/usr/bin/env python3
+# generate a small synthetic transport stream with PAT, PMT, SDT and two PES streams
+# usage: mpeg_ts.py avc_annexb adts > mpeg_ts
+import struct
+import sys
+
+
+def crc32_mpeg(data):
+    crc = 0xFFFFFFFF
+    for b in data:
+        crc ^= b << 24
+        for _ in range(8):
+            crc = ((crc << 1) ^ 0x04C11DB7) if crc & 0x80000000 else crc << 1
+            crc &= 0xFFFFFFFF
+    return crc
+
+
+def section(table_id, table_id_ext, body):
+    length = 5 + len(body) + 4
+    s = bytes([table_id, 0xB0 | (length >> 8), length & 0xFF])
+    s += struct.pack(">HBBB", table_id_ext, 0xC1, 0, 0) + body
+    return s + struct.pack(">I", crc32_mpeg(s))
+
+
+continuity_counters = {}
+
+
+def packets(pid, payload, psi=False, pcr_base=None, random_access=False):
+    out = []
+    if psi:
+        payload = b"\x00" + payload  # pointer_field
+    first = True
+    while first or payload:
+        af = None
+        if first and (pcr_base is not None or random_access):
+            af = bytes([(0x40 if random_access else 0) | (0x10 if pcr_base is not None else 0)])
+            if pcr_base is not None:
+                af += ((pcr_base << 15) | (0x3F << 9)).to_bytes(6, "big")
+        room = 184 - (1 + len(af) if af is not None else 0)
+        chunk = payload[:room]
+        payload = payload[len(chunk):]
+        stuffing = room - len(chunk)
+        if stuffing > 0 and not psi:
+            if af is None:
+                af = b"" if stuffing == 1 else b"\x00" + b"\xff" * (stuffing - 2)
+            else:
+                af += b"\xff" * stuffing
+        cc = continuity_counters.get(pid, 0)
+        continuity_counters[pid] = (cc + 1) & 0xF
+        afc = 0x1 if af is None else 0x3
+        pkt = bytes([0x47, (0x40 if first else 0) | (pid >> 8), pid & 0xFF, (afc << 4) | cc])
+        if af is not None:
+            pkt += bytes([len(af)]) + af
+        pkt += chunk
+        pkt += b"\xff" * (188 - len(pkt))
+        out.append(pkt)
+        first = False
+    return out
+
+
+def timestamp(prefix, v):
+    return (
+        bytes([(prefix << 4) | (((v >> 30) & 0x7) << 1) | 1])
+        + struct.pack(">H", (((v >> 15) & 0x7FFF) << 1) | 1)
+        + struct.pack(">H", ((v & 0x7FFF) << 1) | 1)
+    )
+
+
+def pes(stream_id, data, pts, dts=None, unbounded=False):
+    if dts is None:
+        header_data, flags = timestamp(0b0010, pts), 0x80
+    else:
+        header_data, flags = timestamp(0b0011, pts) + timestamp(0b0001, dts), 0xC0
+    ext = bytes([0x84, flags, len(header_data)]) + header_data
+    length = 0 if unbounded else len(ext) + len(data)
+    return b"\x00\x00\x01" + bytes([stream_id]) + struct.pack(">H", length) + ext + data
+
+
+avc = open(sys.argv[1], "rb").read()
+adts = open(sys.argv[2], "rb").read()[:340]
+
+pat = section(0x00, 1, struct.pack(">HH", 1, 0xE000 | 0x1000))
+lang = bytes([0x0A, 4]) + b"eng\x00"
+pmt = section(
+    0x02,
+    1,
+    struct.pack(">HH", 0xE000 | 0x100, 0xF000)
+    + struct.pack(">BHH", 0x1B, 0xE000 | 0x100, 0xF000)
+    + struct.pack(">BHH", 0x0F, 0xE000 | 0x101, 0xF000 | len(lang))
+    + lang,
+)
+service = bytes([0x48, 9, 0x01, 2]) + b"fq" + bytes([4]) + b"test"
+sdt = section(0x42, 1, struct.pack(">HBHBH", 1, 0xFF, 1, 0xFC, 0x8000 | len(service)) + service)
+
+video = packets(0x100, pes(0xE0, avc, 129000, 126000, unbounded=True), pcr_base=117000, random_access=True)
+audio = packets(0x101, pes(0xC0, adts, 126000))
+
+out = packets(0x0, pat, psi=True) + packets(0x1000, pmt, psi=True) + packets(0x11, sdt, psi=True)
+out += video[:8] + audio + video[8:]
+sys.stdout.buffer.write(b"".join(out))
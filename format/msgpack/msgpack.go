@@ -5,12 +5,22 @@ package msgpack
 // TODO: ext types done correctly?
 
 import (
+	"bytes"
 	"embed"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
 
 	"github.com/wader/fq/format"
+	"github.com/wader/fq/internal/gojqx"
+	"github.com/wader/fq/pkg/bitio"
 	"github.com/wader/fq/pkg/decode"
 	"github.com/wader/fq/pkg/interp"
 	"github.com/wader/fq/pkg/scalar"
+	"github.com/wader/gojq"
 )
 
 //go:embed msgpack.jq
@@ -26,6 +36,7 @@ func init() {
 			Functions:   []string{"torepr"},
 		})
 	interp.RegisterFS(msgPackFS)
+	interp.RegisterFunc1("_to_msgpack", toMsgPack)
 }
 
 type formatEntry struct {
@@ -159,3 +170,198 @@ func decodeMsgPack(d *decode.D) any {
 	decodeMsgPackValue(d)
 	return nil
 }
+
+type toMsgPackOpts struct {
+	FloatWidth int `default:"64"`
+	// encode {type: number, value: binary} objects as ext types
+	Ext bool
+}
+
+// values are always encoded using the smallest format and with sorted map keys
+type msgPackEncoder struct {
+	opts toMsgPackOpts
+}
+
+// write length using fix format if fixMax > 0 or 8, 16 or 32 bit format
+func (e msgPackEncoder) length(w *bytes.Buffer, n int, fix byte, fixMax int, f8 byte, f16 byte, f32 byte) error {
+	switch {
+	case n <= fixMax:
+		w.WriteByte(fix | byte(n))
+	case f8 != 0 && n <= math.MaxUint8:
+		w.Write([]byte{f8, byte(n)})
+	case n <= math.MaxUint16:
+		w.Write(binary.BigEndian.AppendUint16([]byte{f16}, uint16(n)))
+	case n <= math.MaxUint32:
+		w.Write(binary.BigEndian.AppendUint32([]byte{f32}, uint32(n)))
+	default:
+		return fmt.Errorf("length %d too large", n)
+	}
+	return nil
+}
+
+func (e msgPackEncoder) int(w *bytes.Buffer, v *big.Int) error {
+	switch {
+	case v.IsInt64() && v.Int64() >= -32 && v.Int64() <= math.MaxInt8:
+		w.WriteByte(byte(v.Int64()))
+	case v.Sign() >= 0 && v.IsUint64():
+		u := v.Uint64()
+		switch {
+		case u <= math.MaxUint8:
+			w.Write([]byte{0xcc, byte(u)})
+		case u <= math.MaxUint16:
+			w.Write(binary.BigEndian.AppendUint16([]byte{0xcd}, uint16(u)))
+		case u <= math.MaxUint32:
+			w.Write(binary.BigEndian.AppendUint32([]byte{0xce}, uint32(u)))
+		default:
+			w.Write(binary.BigEndian.AppendUint64([]byte{0xcf}, u))
+		}
+	case v.IsInt64():
+		i := v.Int64()
+		switch {
+		case i >= math.MinInt8:
+			w.Write([]byte{0xd0, byte(i)})
+		case i >= math.MinInt16:
+			w.Write(binary.BigEndian.AppendUint16([]byte{0xd1}, uint16(i)))
+		case i >= math.MinInt32:
+			w.Write(binary.BigEndian.AppendUint32([]byte{0xd2}, uint32(i)))
+		default:
+			w.Write(binary.BigEndian.AppendUint64([]byte{0xd3}, uint64(i)))
+		}
+	default:
+		return fmt.Errorf("integer %s does not fit in 64 bits", v)
+	}
+	return nil
+}
+
+func (e msgPackEncoder) bytes(v any) ([]byte, error) {
+	br, err := interp.ToBitReader(v)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(bitio.NewIOReader(br))
+}
+
+func (e msgPackEncoder) ext(w *bytes.Buffer, typ int, b []byte) error {
+	switch len(b) {
+	case 1:
+		w.WriteByte(0xd4)
+	case 2:
+		w.WriteByte(0xd5)
+	case 4:
+		w.WriteByte(0xd6)
+	case 8:
+		w.WriteByte(0xd7)
+	case 16:
+		w.WriteByte(0xd8)
+	default:
+		if err := e.length(w, len(b), 0, -1, 0xc7, 0xc8, 0xc9); err != nil {
+			return err
+		}
+	}
+	w.WriteByte(byte(int8(typ)))
+	w.Write(b)
+	return nil
+}
+
+func (e msgPackEncoder) encode(w *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case interp.Binary:
+		b, err := e.bytes(v)
+		if err != nil {
+			return err
+		}
+		if err := e.length(w, len(b), 0, -1, 0xc4, 0xc5, 0xc6); err != nil {
+			return err
+		}
+		w.Write(b)
+	case gojq.JQValue:
+		return e.encode(w, v.JQValueToGoJQ())
+	case nil:
+		w.WriteByte(0xc0)
+	case bool:
+		if v {
+			w.WriteByte(0xc3)
+		} else {
+			w.WriteByte(0xc2)
+		}
+	case int:
+		return e.int(w, big.NewInt(int64(v)))
+	case *big.Int:
+		return e.int(w, v)
+	case float64:
+		// integral numbers are encoded as integers
+		if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+			return e.int(w, big.NewInt(int64(v)))
+		}
+		if e.opts.FloatWidth == 32 && (float64(float32(v)) == v || math.IsNaN(v)) {
+			w.Write(binary.BigEndian.AppendUint32([]byte{0xca}, math.Float32bits(float32(v))))
+		} else {
+			w.Write(binary.BigEndian.AppendUint64([]byte{0xcb}, math.Float64bits(v)))
+		}
+	case string:
+		if err := e.length(w, len(v), 0xa0, 31, 0xd9, 0xda, 0xdb); err != nil {
+			return err
+		}
+		w.WriteString(v)
+	case []any:
+		if err := e.length(w, len(v), 0x90, 15, 0, 0xdc, 0xdd); err != nil {
+			return err
+		}
+		for _, ve := range v {
+			if err := e.encode(w, ve); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		if e.opts.Ext && len(v) == 2 {
+			if typ, ok := gojqx.Cast[int](v["type"]); ok && typ >= math.MinInt8 && typ <= math.MaxInt8 {
+				if ev, ok := v["value"]; ok {
+					b, err := e.bytes(ev)
+					if err != nil {
+						return err
+					}
+					return e.ext(w, typ, b)
+				}
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		if err := e.length(w, len(v), 0x80, 15, 0, 0xde, 0xdf); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := e.encode(w, k); err != nil {
+				return err
+			}
+			if err := e.encode(w, v[k]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	return nil
+}
+
+func toMsgPack(_ *interp.Interp, c any, opts toMsgPackOpts) any {
+	switch opts.FloatWidth {
+	case 32, 64:
+	default:
+		return fmt.Errorf("float width %d should be 32 or 64", opts.FloatWidth)
+	}
+
+	w := &bytes.Buffer{}
+	if err := (msgPackEncoder{opts: opts}).encode(w, c); err != nil {
+		return err
+	}
+
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(w.Bytes(), -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
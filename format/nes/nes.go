@@ -21,6 +21,7 @@ func init() {
 		&decode.Format{
 			Description: "iNES/NES 2.0 cartridge ROM format",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("NES\x1a")}},
 			DecodeFn:    decodeNES,
 		})
 	interp.RegisterFS(nesFS)
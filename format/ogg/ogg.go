@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "OGG file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("OggS")}},
 			DecodeFn:    decodeOgg,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Ogg_Page}, Out: &oggPageGroup},
@@ -44,6 +44,12 @@ func init() {
 		&decode.Format{
 			Description: "PCAP packet capture",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures: []decode.Signature{
+				{Bytes: []byte{0xa1, 0xb2, 0xc3, 0xd4}},
+				{Bytes: []byte{0xd4, 0xc3, 0xb2, 0xa1}},
+				{Bytes: []byte{0xa1, 0xb2, 0x3c, 0x4d}},
+				{Bytes: []byte{0x4d, 0x3c, 0xb2, 0xa1}},
+			},
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Link_Frame}, Out: &pcapLinkFrameGroup},
 				{Groups: []*decode.Group{format.TCP_Stream}, Out: &pcapTCPStreamGroup},
@@ -24,6 +24,7 @@ func init() {
 			Description: "PCAPNG packet capture",
 			RootArray:   true,
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{0x0a, 0x0d, 0x0d, 0x0a}}},
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Link_Frame}, Out: &pcapngLinkFrameGroup},
 				{Groups: []*decode.Group{format.TCP_Stream}, Out: &pcapngTCPStreamGroup},
@@ -0,0 +1,949 @@
+package pe
+
+// https://learn.microsoft.com/en-us/windows/win32/debug/pe-format
+// https://learn.microsoft.com/en-us/windows/win32/menurc/resource-file-formats
+// https://download.microsoft.com/download/9/c/5/9c5b2167-8017-4bae-9fde-d599bac8184a/Authenticode_PE.docx
+// TODO: rich header
+// TODO: COFF symbol table and string table
+// TODO: exception, TLS, load config, delay import and CLR runtime directories
+// TODO: bound imports and import address table
+
+import (
+	"embed"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+//go:embed pe.md
+var peFS embed.FS
+
+var asn1BerGroup decode.Group
+
+func init() {
+	interp.RegisterFormat(
+		format.PE,
+		&decode.Format{
+			Description: "Portable Executable (PE/COFF) Windows executable",
+			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("MZ")}},
+			DecodeFn:    peDecode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.ASN1_BER}, Out: &asn1BerGroup},
+			},
+		})
+	interp.RegisterFS(peFS)
+}
+
+const (
+	dosHeaderSize   = 64
+	peSignature     = "PE\x00\x00"
+	maxResourceTree = 8
+)
+
+const (
+	optionalHeaderMagicPE32     = 0x10b
+	optionalHeaderMagicPE32Plus = 0x20b
+	optionalHeaderMagicROM      = 0x107
+)
+
+var optionalHeaderMagicNames = scalar.UintMapSymStr{
+	optionalHeaderMagicPE32:     "pe32",
+	optionalHeaderMagicPE32Plus: "pe32_plus",
+	optionalHeaderMagicROM:      "rom",
+}
+
+var machineNames = scalar.UintMapSymStr{
+	0x0:    "unknown",
+	0x184:  "alpha",
+	0x284:  "alpha64",
+	0x1d3:  "am33",
+	0x8664: "amd64",
+	0x1c0:  "arm",
+	0xaa64: "arm64",
+	0xa641: "arm64ec",
+	0xa64e: "arm64x",
+	0x1c4:  "armnt",
+	0xebc:  "ebc",
+	0x14c:  "i386",
+	0x200:  "ia64",
+	0x6232: "loongarch32",
+	0x6264: "loongarch64",
+	0x9041: "m32r",
+	0x266:  "mips16",
+	0x366:  "mipsfpu",
+	0x466:  "mipsfpu16",
+	0x1f0:  "powerpc",
+	0x1f1:  "powerpcfp",
+	0x166:  "r4000",
+	0x5032: "riscv32",
+	0x5064: "riscv64",
+	0x5128: "riscv128",
+	0x1a2:  "sh3",
+	0x1a3:  "sh3dsp",
+	0x1a6:  "sh4",
+	0x1a8:  "sh5",
+	0x1c2:  "thumb",
+	0x169:  "wcemipsv2",
+}
+
+var subsystemNames = scalar.UintMapSymStr{
+	0:  "unknown",
+	1:  "native",
+	2:  "windows_gui",
+	3:  "windows_cui",
+	5:  "os2_cui",
+	7:  "posix_cui",
+	8:  "native_windows",
+	9:  "windows_ce_gui",
+	10: "efi_application",
+	11: "efi_boot_service_driver",
+	12: "efi_runtime_driver",
+	13: "efi_rom",
+	14: "xbox",
+	16: "windows_boot_application",
+}
+
+const (
+	dataDirectoryExport              = 0
+	dataDirectoryImport              = 1
+	dataDirectoryResource            = 2
+	dataDirectoryCertificate         = 4
+	dataDirectoryBaseRelocation      = 5
+	dataDirectoryDebug               = 6
+	dataDirectoryNumberOfDirectories = 16
+)
+
+var dataDirectoryNames = []string{
+	"export_table",
+	"import_table",
+	"resource_table",
+	"exception_table",
+	"certificate_table",
+	"base_relocation_table",
+	"debug",
+	"architecture",
+	"global_ptr",
+	"tls_table",
+	"load_config_table",
+	"bound_import",
+	"iat",
+	"delay_import_descriptor",
+	"clr_runtime_header",
+	"reserved",
+}
+
+var baseRelocationTypeNames = scalar.UintMapSymStr{
+	0:  "absolute",
+	1:  "high",
+	2:  "low",
+	3:  "highlow",
+	4:  "highadj",
+	5:  "machine_specific_5",
+	7:  "machine_specific_7",
+	8:  "machine_specific_8",
+	9:  "machine_specific_9",
+	10: "dir64",
+}
+
+const (
+	debugTypeCodeView = 2
+)
+
+var debugTypeNames = scalar.UintMapSymStr{
+	0:                 "unknown",
+	1:                 "coff",
+	debugTypeCodeView: "codeview",
+	3:                 "fpo",
+	4:                 "misc",
+	5:                 "exception",
+	6:                 "fixup",
+	7:                 "omap_to_src",
+	8:                 "omap_from_src",
+	9:                 "borland",
+	10:                "reserved10",
+	11:                "clsid",
+	12:                "vc_feature",
+	13:                "pogo",
+	14:                "iltcg",
+	15:                "mpx",
+	16:                "repro",
+	17:                "embedded_portable_pdb",
+	19:                "pdb_checksum",
+	20:                "ex_dllcharacteristics",
+}
+
+var resourceTypeNames = scalar.UintMapSymStr{
+	1:  "cursor",
+	2:  "bitmap",
+	3:  "icon",
+	4:  "menu",
+	5:  "dialog",
+	6:  "string",
+	7:  "fontdir",
+	8:  "font",
+	9:  "accelerator",
+	10: "rcdata",
+	11: "messagetable",
+	12: "group_cursor",
+	14: "group_icon",
+	16: "version",
+	17: "dlginclude",
+	19: "plugplay",
+	20: "vxd",
+	21: "anicursor",
+	22: "aniicon",
+	23: "html",
+	24: "manifest",
+}
+
+var certificateRevisionNames = scalar.UintMapSymStr{
+	0x100: "revision_1_0",
+	0x200: "revision_2_0",
+}
+
+const (
+	certificateTypePKCSSignedData = 2
+)
+
+var certificateTypeNames = scalar.UintMapSymStr{
+	1:                             "x509",
+	certificateTypePKCSSignedData: "pkcs_signed_data",
+	3:                             "reserved_1",
+	4:                             "ts_stack_signed",
+}
+
+var unixTimeMapper = scalar.UintActualUnixTimeDescription(time.Second, time.RFC3339)
+
+var highBitClear = scalar.UintActualFn(func(a uint64) uint64 { return a &^ 0x8000_0000 })
+
+// GUIDs are stored as little endian u32, u16, u16 followed by 8 bytes
+var guidMapper = scalar.BitBufFn(func(s scalar.BitBuf) (scalar.BitBuf, error) {
+	return scalar.RawSym(s, 16, func(b []byte) string {
+		return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+			binary.LittleEndian.Uint32(b[0:4]),
+			binary.LittleEndian.Uint16(b[4:6]),
+			binary.LittleEndian.Uint16(b[6:8]),
+			b[8:10],
+			b[10:16],
+		)
+	})
+})
+
+type dataDirectory struct {
+	virtualAddress uint64
+	size           uint64
+}
+
+type section struct {
+	virtualAddress   uint64
+	virtualSize      uint64
+	pointerToRawData uint64
+	sizeOfRawData    uint64
+}
+
+type peContext struct {
+	is64            bool
+	sizeOfHeaders   uint64
+	dataDirectories []dataDirectory
+	sections        []section
+}
+
+// rvaToPos translates a relative virtual address to a bit position in the file
+func (pc *peContext) rvaToPos(d *decode.D, rva uint64) (int64, bool) {
+	var offset uint64
+	found := false
+	if rva < pc.sizeOfHeaders {
+		offset = rva
+		found = true
+	} else {
+		for _, s := range pc.sections {
+			size := max(s.virtualSize, s.sizeOfRawData)
+			if rva >= s.virtualAddress && rva < s.virtualAddress+size {
+				delta := rva - s.virtualAddress
+				// inside virtual size but not backed by file data
+				if delta >= s.sizeOfRawData {
+					return 0, false
+				}
+				offset = s.pointerToRawData + delta
+				found = true
+				break
+			}
+		}
+	}
+	if !found || int64(offset)*8 >= d.Len() {
+		return 0, false
+	}
+	return int64(offset) * 8, true
+}
+
+func (pc *peContext) rvaStr(d *decode.D, rva uint64) (string, bool) {
+	pos, ok := pc.rvaToPos(d, rva)
+	if !ok {
+		return "", false
+	}
+	var s string
+	var err error
+	d.SeekAbs(pos, func(d *decode.D) {
+		s, err = d.TryUTF8Null()
+	})
+	return s, err == nil
+}
+
+// rvaStrMapper sets sym to the null terminated string pointed to by the rva
+func (pc *peContext) rvaStrMapper(d *decode.D) scalar.UintFn {
+	return func(s scalar.Uint) (scalar.Uint, error) {
+		if s.Actual == 0 {
+			return s, nil
+		}
+		if str, ok := pc.rvaStr(d, s.Actual); ok {
+			s.Sym = str
+		}
+		return s, nil
+	}
+}
+
+func (pc *peContext) directory(i int) dataDirectory {
+	if i >= len(pc.dataDirectories) {
+		return dataDirectory{}
+	}
+	return pc.dataDirectories[i]
+}
+
+// fail if count entries of entryBits can't fit in what is left after pos,
+// counts are read from the file and should not be trusted
+func peAssertCount(d *decode.D, name string, count uint64, pos int64, entryBits int64) {
+	if left := d.Len() - pos; left < 0 || count > uint64(left/entryBits) {
+		d.Fatalf("%s %d larger than what is left", name, count)
+	}
+}
+
+func peChecksum(b []byte, checksumOffset int) uint64 {
+	var sum uint64
+	n := len(b) &^ 1
+	for i := 0; i < n; i += 2 {
+		if i == checksumOffset || i == checksumOffset+2 {
+			continue
+		}
+		sum += uint64(binary.LittleEndian.Uint16(b[i:]))
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	if len(b)%2 == 1 {
+		sum += uint64(b[len(b)-1])
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	sum = (sum & 0xffff) + (sum >> 16)
+	return (sum + uint64(len(b))) & 0xffff_ffff
+}
+
+func peDecodeDOSHeader(d *decode.D) uint64 {
+	var peOffset uint64
+	d.FieldStruct("dos_header", func(d *decode.D) {
+		d.FieldUTF8("magic", 2, d.StrAssert("MZ"))
+		d.FieldU16("bytes_in_last_page")
+		d.FieldU16("pages_in_file")
+		d.FieldU16("relocations")
+		d.FieldU16("size_of_header_paragraphs")
+		d.FieldU16("minimum_extra_paragraphs")
+		d.FieldU16("maximum_extra_paragraphs")
+		d.FieldU16("initial_ss", scalar.UintHex)
+		d.FieldU16("initial_sp", scalar.UintHex)
+		d.FieldU16("checksum", scalar.UintHex)
+		d.FieldU16("initial_ip", scalar.UintHex)
+		d.FieldU16("initial_cs", scalar.UintHex)
+		d.FieldU16("relocation_table_offset", scalar.UintHex)
+		d.FieldU16("overlay_number")
+		d.FieldRawLen("reserved0", 4*16)
+		d.FieldU16("oem_id")
+		d.FieldU16("oem_info")
+		d.FieldRawLen("reserved1", 10*16)
+		peOffset = d.FieldU32("pe_offset", scalar.UintHex)
+	})
+	return peOffset
+}
+
+func peDecodeCOFFCharacteristics(d *decode.D) {
+	d.FieldBool("bytes_reversed_lo")
+	d.FieldBool("reserved")
+	d.FieldBool("large_address_aware")
+	d.FieldBool("aggressive_ws_trim")
+	d.FieldBool("local_syms_stripped")
+	d.FieldBool("line_nums_stripped")
+	d.FieldBool("executable_image")
+	d.FieldBool("relocs_stripped")
+
+	d.FieldBool("bytes_reversed_hi")
+	d.FieldBool("up_system_only")
+	d.FieldBool("dll")
+	d.FieldBool("system")
+	d.FieldBool("net_run_from_swap")
+	d.FieldBool("removable_run_from_swap")
+	d.FieldBool("debug_stripped")
+	d.FieldBool("machine_32bit")
+}
+
+func peDecodeDLLCharacteristics(d *decode.D) {
+	d.FieldBool("force_integrity")
+	d.FieldBool("dynamic_base")
+	d.FieldBool("high_entropy_va")
+	d.FieldU5("reserved")
+
+	d.FieldBool("terminal_server_aware")
+	d.FieldBool("guard_cf")
+	d.FieldBool("wdm_driver")
+	d.FieldBool("appcontainer")
+	d.FieldBool("no_bind")
+	d.FieldBool("no_seh")
+	d.FieldBool("no_isolation")
+	d.FieldBool("nx_compat")
+}
+
+func peDecodeSectionCharacteristics(d *decode.D) {
+	d.FieldBool("cnt_uninitialized_data")
+	d.FieldBool("cnt_initialized_data")
+	d.FieldBool("cnt_code")
+	d.FieldBool("reserved0")
+	d.FieldBool("type_no_pad")
+	d.FieldU3("reserved1")
+
+	d.FieldBool("mem_16bit")
+	d.FieldBool("gprel")
+	d.FieldBool("reserved2")
+	d.FieldBool("lnk_comdat")
+	d.FieldBool("lnk_remove")
+	d.FieldBool("reserved3")
+	d.FieldBool("lnk_info")
+	d.FieldBool("lnk_other")
+
+	d.FieldU4("align", scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+		if s.Actual != 0 && s.Actual != 0xf {
+			s.Description = fmt.Sprintf("%d bytes", 1<<(s.Actual-1))
+		}
+		return s, nil
+	}))
+	d.FieldU4("mem_locked_preload")
+
+	d.FieldBool("mem_write")
+	d.FieldBool("mem_read")
+	d.FieldBool("mem_execute")
+	d.FieldBool("mem_shared")
+	d.FieldBool("mem_not_paged")
+	d.FieldBool("mem_not_cached")
+	d.FieldBool("mem_discardable")
+	d.FieldBool("lnk_nreloc_ovfl")
+}
+
+func peDecodeOptionalHeader(d *decode.D, pc *peContext, fileBytesFn func() []byte) {
+	magic := d.FieldU16("magic", optionalHeaderMagicNames, scalar.UintHex)
+	switch magic {
+	case optionalHeaderMagicPE32, optionalHeaderMagicROM:
+		pc.is64 = false
+	case optionalHeaderMagicPE32Plus:
+		pc.is64 = true
+	default:
+		d.Fatalf("unknown optional header magic %x", magic)
+	}
+	ptrBits := 32
+	if pc.is64 {
+		ptrBits = 64
+	}
+
+	d.FieldU8("major_linker_version")
+	d.FieldU8("minor_linker_version")
+	d.FieldU32("size_of_code")
+	d.FieldU32("size_of_initialized_data")
+	d.FieldU32("size_of_uninitialized_data")
+	d.FieldU32("address_of_entry_point", scalar.UintHex)
+	d.FieldU32("base_of_code", scalar.UintHex)
+	if !pc.is64 {
+		d.FieldU32("base_of_data", scalar.UintHex)
+	}
+	d.FieldU("image_base", ptrBits, scalar.UintHex)
+	d.FieldU32("section_alignment")
+	d.FieldU32("file_alignment")
+	d.FieldU16("major_operating_system_version")
+	d.FieldU16("minor_operating_system_version")
+	d.FieldU16("major_image_version")
+	d.FieldU16("minor_image_version")
+	d.FieldU16("major_subsystem_version")
+	d.FieldU16("minor_subsystem_version")
+	d.FieldU32("win32_version_value")
+	d.FieldU32("size_of_image")
+	pc.sizeOfHeaders = d.FieldU32("size_of_headers")
+
+	checksum := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+	// zero means no checksum, usually only validated for drivers and system dlls
+	if checksum != 0 {
+		d.FieldU32("checksum", d.UintValidate(peChecksum(fileBytesFn(), int(d.Pos()/8))), scalar.UintHex)
+	} else {
+		d.FieldU32("checksum", scalar.UintHex)
+	}
+
+	d.FieldU16("subsystem", subsystemNames)
+	d.FieldStruct("dll_characteristics", peDecodeDLLCharacteristics)
+	d.FieldU("size_of_stack_reserve", ptrBits)
+	d.FieldU("size_of_stack_commit", ptrBits)
+	d.FieldU("size_of_heap_reserve", ptrBits)
+	d.FieldU("size_of_heap_commit", ptrBits)
+	d.FieldU32("loader_flags", scalar.UintHex)
+	numberOfRvaAndSizes := d.FieldU32("number_of_rva_and_sizes")
+
+	d.FieldStruct("data_directories", func(d *decode.D) {
+		n := min(int(numberOfRvaAndSizes), dataDirectoryNumberOfDirectories)
+		for i := 0; i < n; i++ {
+			d.FieldStruct(dataDirectoryNames[i], func(d *decode.D) {
+				var dd dataDirectory
+				// certificate table address is a file offset and not a rva
+				dd.virtualAddress = d.FieldU32("virtual_address", scalar.UintHex)
+				dd.size = d.FieldU32("size")
+				pc.dataDirectories = append(pc.dataDirectories, dd)
+			})
+		}
+	})
+	if numberOfRvaAndSizes > dataDirectoryNumberOfDirectories {
+		d.FieldRawLen("extra_data_directories", int64(numberOfRvaAndSizes-dataDirectoryNumberOfDirectories)*8*8)
+	}
+}
+
+func peDecodeSectionHeaders(d *decode.D, pc *peContext, numberOfSections uint64) {
+	d.FieldArray("section_headers", func(d *decode.D) {
+		for i := uint64(0); i < numberOfSections; i++ {
+			d.FieldStruct("section_header", func(d *decode.D) {
+				var s section
+				d.FieldUTF8NullFixedLen("name", 8)
+				s.virtualSize = d.FieldU32("virtual_size")
+				s.virtualAddress = d.FieldU32("virtual_address", scalar.UintHex)
+				s.sizeOfRawData = d.FieldU32("size_of_raw_data")
+				s.pointerToRawData = d.FieldU32("pointer_to_raw_data", scalar.UintHex)
+				d.FieldU32("pointer_to_relocations", scalar.UintHex)
+				d.FieldU32("pointer_to_linenumbers", scalar.UintHex)
+				d.FieldU16("number_of_relocations")
+				d.FieldU16("number_of_linenumbers")
+				d.FieldStruct("characteristics", peDecodeSectionCharacteristics)
+				pc.sections = append(pc.sections, s)
+
+				dataPos := int64(s.pointerToRawData) * 8
+				dataLen := int64(s.sizeOfRawData) * 8
+				if s.pointerToRawData != 0 && dataLen > 0 && dataPos+dataLen <= d.Len() {
+					d.SeekAbs(dataPos, func(d *decode.D) {
+						d.FieldRawLen("data", dataLen)
+					})
+				}
+			})
+		}
+	})
+}
+
+func peDecodeExportDirectory(d *decode.D, pc *peContext, dd dataDirectory) {
+	strMapper := pc.rvaStrMapper(d)
+
+	d.FieldU32("export_flags", scalar.UintHex)
+	d.FieldU32("time_date_stamp", unixTimeMapper)
+	d.FieldU16("major_version")
+	d.FieldU16("minor_version")
+	d.FieldU32("name", strMapper, scalar.UintHex)
+	d.FieldU32("ordinal_base")
+	numberOfFunctions := d.FieldU32("number_of_functions")
+	numberOfNames := d.FieldU32("number_of_names")
+	addressTableRVA := d.FieldU32("address_table_rva", scalar.UintHex)
+	namePointerRVA := d.FieldU32("name_pointer_rva", scalar.UintHex)
+	ordinalTableRVA := d.FieldU32("ordinal_table_rva", scalar.UintHex)
+
+	// exported address inside the export directory is a forwarder string
+	addressMapper := scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+		if s.Actual >= dd.virtualAddress && s.Actual < dd.virtualAddress+dd.size {
+			if str, ok := pc.rvaStr(d, s.Actual); ok {
+				s.Sym = str
+				s.Description = "forwarder"
+			}
+		}
+		return s, nil
+	})
+
+	if pos, ok := pc.rvaToPos(d, addressTableRVA); ok && numberOfFunctions > 0 {
+		peAssertCount(d, "number_of_functions", numberOfFunctions, pos, 32)
+		d.SeekAbs(pos, func(d *decode.D) {
+			d.FieldArray("address_table", func(d *decode.D) {
+				for i := uint64(0); i < numberOfFunctions; i++ {
+					d.FieldU32("address", addressMapper, scalar.UintHex)
+				}
+			})
+		})
+	}
+	if pos, ok := pc.rvaToPos(d, namePointerRVA); ok && numberOfNames > 0 {
+		peAssertCount(d, "number_of_names", numberOfNames, pos, 32)
+		d.SeekAbs(pos, func(d *decode.D) {
+			d.FieldArray("name_pointer_table", func(d *decode.D) {
+				for i := uint64(0); i < numberOfNames; i++ {
+					d.FieldU32("name", strMapper, scalar.UintHex)
+				}
+			})
+		})
+	}
+	if pos, ok := pc.rvaToPos(d, ordinalTableRVA); ok && numberOfNames > 0 {
+		peAssertCount(d, "number_of_names", numberOfNames, pos, 16)
+		d.SeekAbs(pos, func(d *decode.D) {
+			d.FieldArray("ordinal_table", func(d *decode.D) {
+				for i := uint64(0); i < numberOfNames; i++ {
+					d.FieldU16("ordinal")
+				}
+			})
+		})
+	}
+}
+
+func peDecodeImportLookupTable(d *decode.D, pc *peContext, rva uint64) {
+	pos, ok := pc.rvaToPos(d, rva)
+	if !ok {
+		return
+	}
+	entryBits := 32
+	if pc.is64 {
+		entryBits = 64
+	}
+	ordinalFlag := uint64(1) << (entryBits - 1)
+
+	d.SeekAbs(pos, func(d *decode.D) {
+		d.FieldArray("functions", func(d *decode.D) {
+			for {
+				endMark := false
+				d.FieldStruct("function", func(d *decode.D) {
+					entry := d.FieldU("entry", entryBits, scalar.UintHex)
+					if entry == 0 {
+						endMark = true
+						d.FieldValueBool("end_mark", true)
+						return
+					}
+					byOrdinal := entry&ordinalFlag != 0
+					d.FieldValueBool("by_ordinal", byOrdinal)
+					if byOrdinal {
+						d.FieldValueUint("ordinal", entry&0xffff)
+						return
+					}
+					hintNameRVA := entry & 0x7fff_ffff
+					d.FieldValueUint("hint_name_rva", hintNameRVA, scalar.UintHex)
+					if hintNamePos, ok := pc.rvaToPos(d, hintNameRVA); ok {
+						d.SeekAbs(hintNamePos, func(d *decode.D) {
+							d.FieldU16("hint")
+							d.FieldUTF8Null("name")
+						})
+					}
+				})
+				if endMark {
+					break
+				}
+			}
+		})
+	})
+}
+
+func peDecodeImportDirectory(d *decode.D, pc *peContext) {
+	strMapper := pc.rvaStrMapper(d)
+
+	for {
+		nullDescriptor := false
+		d.FieldStruct("import", func(d *decode.D) {
+			importLookupTableRVA := d.FieldU32("import_lookup_table_rva", scalar.UintHex)
+			timeDateStamp := d.FieldU32("time_date_stamp")
+			forwarderChain := d.FieldU32("forwarder_chain", scalar.UintHex)
+			name := d.FieldU32("name", strMapper, scalar.UintHex)
+			importAddressTableRVA := d.FieldU32("import_address_table_rva", scalar.UintHex)
+			if importLookupTableRVA == 0 && timeDateStamp == 0 && forwarderChain == 0 && name == 0 && importAddressTableRVA == 0 {
+				nullDescriptor = true
+				return
+			}
+
+			// some linkers only set the import address table, it has the same
+			// content as the lookup table until bound
+			lookupTableRVA := importLookupTableRVA
+			if lookupTableRVA == 0 {
+				lookupTableRVA = importAddressTableRVA
+			}
+			peDecodeImportLookupTable(d, pc, lookupTableRVA)
+		})
+		if nullDescriptor {
+			break
+		}
+	}
+}
+
+func peDecodeResourceDirectory(d *decode.D, pc *peContext, resourceStart int64, level int) {
+	d.FieldU32("characteristics", scalar.UintHex)
+	d.FieldU32("time_date_stamp")
+	d.FieldU16("major_version")
+	d.FieldU16("minor_version")
+	numberOfNamedEntries := d.FieldU16("number_of_named_entries")
+	numberOfIDEntries := d.FieldU16("number_of_id_entries")
+
+	var idMapper scalar.UintMapper = scalar.UintMapSymStr{}
+	if level == 0 {
+		idMapper = resourceTypeNames
+	}
+
+	// each entry is 8 bytes
+	numberOfEntries := numberOfNamedEntries + numberOfIDEntries
+	peAssertCount(d, "number of entries", numberOfEntries, d.Pos(), 64)
+
+	d.FieldArray("entries", func(d *decode.D) {
+		for i := uint64(0); i < numberOfEntries; i++ {
+			d.FieldStruct("entry", func(d *decode.D) {
+				nameOrID := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+				if nameOrID&0x8000_0000 != 0 {
+					nameOffset := d.FieldU32("name_offset", highBitClear, scalar.UintHex)
+					d.SeekAbs(resourceStart+int64(nameOffset)*8, func(d *decode.D) {
+						length := d.FieldU16("name_length")
+						d.FieldUTF16LE("name", int(length)*2)
+					})
+				} else {
+					d.FieldU32("id", idMapper)
+				}
+
+				offset := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+				if offset&0x8000_0000 != 0 {
+					subdirectoryOffset := d.FieldU32("subdirectory_offset", highBitClear, scalar.UintHex)
+					if level+1 >= maxResourceTree {
+						d.Fatalf("resource tree too deep")
+					}
+					d.SeekAbs(resourceStart+int64(subdirectoryOffset)*8, func(d *decode.D) {
+						d.FieldStruct("directory", func(d *decode.D) {
+							peDecodeResourceDirectory(d, pc, resourceStart, level+1)
+						})
+					})
+				} else {
+					dataEntryOffset := d.FieldU32("data_entry_offset", scalar.UintHex)
+					d.SeekAbs(resourceStart+int64(dataEntryOffset)*8, func(d *decode.D) {
+						d.FieldStruct("data_entry", func(d *decode.D) {
+							dataRVA := d.FieldU32("data_rva", scalar.UintHex)
+							size := d.FieldU32("size")
+							d.FieldU32("codepage")
+							d.FieldU32("reserved")
+							if pos, ok := pc.rvaToPos(d, dataRVA); ok && size > 0 {
+								d.SeekAbs(pos, func(d *decode.D) {
+									d.FieldRawLen("data", int64(size)*8)
+								})
+							}
+						})
+					})
+				}
+			})
+		}
+	})
+}
+
+func peDecodeBaseRelocations(d *decode.D) {
+	for !d.End() {
+		d.FieldStruct("block", func(d *decode.D) {
+			pageRVA := d.FieldU32("page_rva", scalar.UintHex)
+			blockSize := d.FieldU32("block_size")
+			if blockSize < 8 {
+				d.Fatalf("invalid block size %d", blockSize)
+			}
+			numberOfEntries := (blockSize - 8) / 2
+			peAssertCount(d, "number of entries", numberOfEntries, d.Pos(), 16)
+			d.FieldArray("entries", func(d *decode.D) {
+				for i := uint64(0); i < numberOfEntries; i++ {
+					d.FieldStruct("entry", func(d *decode.D) {
+						value := d.FieldU16("value", scalar.UintHex)
+						offset := value & 0xfff
+						d.FieldValueUint("type", value>>12, baseRelocationTypeNames)
+						d.FieldValueUint("offset", offset, scalar.UintHex)
+						d.FieldValueUint("rva", pageRVA+offset, scalar.UintHex)
+					})
+				}
+			})
+		})
+	}
+}
+
+func peDecodeCodeView(d *decode.D) {
+	signature := d.FieldUTF8("signature", 4)
+	switch signature {
+	case "RSDS":
+		d.FieldRawLen("guid", 16*8, guidMapper)
+		d.FieldU32("age")
+		d.FieldUTF8Null("pdb_path")
+	case "NB10":
+		d.FieldU32("offset")
+		d.FieldU32("signature_timestamp", unixTimeMapper)
+		d.FieldU32("age")
+		d.FieldUTF8Null("pdb_path")
+	default:
+		d.FieldRawLen("data", d.BitsLeft())
+	}
+}
+
+func peDecodeDebugDirectory(d *decode.D, size uint64) {
+	// IMAGE_DEBUG_DIRECTORY is 28 bytes
+	numberOfEntries := size / 28
+	peAssertCount(d, "number of entries", numberOfEntries, d.Pos(), 28*8)
+	for i := uint64(0); i < numberOfEntries; i++ {
+		d.FieldStruct("entry", func(d *decode.D) {
+			d.FieldU32("characteristics", scalar.UintHex)
+			d.FieldU32("time_date_stamp", unixTimeMapper)
+			d.FieldU16("major_version")
+			d.FieldU16("minor_version")
+			typ := d.FieldU32("type", debugTypeNames)
+			sizeOfData := d.FieldU32("size_of_data")
+			d.FieldU32("address_of_raw_data", scalar.UintHex)
+			pointerToRawData := d.FieldU32("pointer_to_raw_data", scalar.UintHex)
+
+			dataPos := int64(pointerToRawData) * 8
+			dataLen := int64(sizeOfData) * 8
+			if pointerToRawData == 0 || dataLen == 0 || dataPos+dataLen > d.Len() {
+				return
+			}
+			d.SeekAbs(dataPos, func(d *decode.D) {
+				switch typ {
+				case debugTypeCodeView:
+					d.FramedFn(dataLen, func(d *decode.D) {
+						d.FieldStruct("codeview", peDecodeCodeView)
+					})
+				default:
+					d.FieldRawLen("data", dataLen)
+				}
+			})
+		})
+	}
+}
+
+func peDecodeCertificateTable(d *decode.D) {
+	for d.BitsLeft() >= 8*8 {
+		d.FieldStruct("certificate", func(d *decode.D) {
+			length := d.FieldU32("length")
+			if length < 8 {
+				d.Fatalf("invalid certificate length %d", length)
+			}
+			d.FieldU16("revision", certificateRevisionNames, scalar.UintHex)
+			certificateType := d.FieldU16("certificate_type", certificateTypeNames)
+			certificateLen := int64(length-8) * 8
+			if certificateType == certificateTypePKCSSignedData {
+				d.FieldFormatOrRawLen("certificate", certificateLen, &asn1BerGroup, nil)
+			} else {
+				d.FieldRawLen("certificate", certificateLen)
+			}
+			// entries are 8 byte aligned
+			if padding := int64((8 - length%8) % 8); padding > 0 && d.BitsLeft() >= padding*8 {
+				d.FieldRawLen("padding", padding*8, d.BitBufIsZero())
+			}
+		})
+	}
+}
+
+// decode data directory at pos using fn, on decode error the directory is
+// kept as raw bytes with the error so that other directories are still decoded
+func peFieldDataDirectory(d *decode.D, name string, pos int64, dd dataDirectory, fn func(d *decode.D)) {
+	d.SeekAbs(pos, func(d *decode.D) {
+		err := d.TryFn(fn)
+		if err == nil {
+			return
+		}
+		d.FieldRawLen(name, min(int64(dd.size)*8, d.BitsLeft()))
+		d.FieldGet(name).Err = err
+	})
+}
+
+func peDecode(d *decode.D) any {
+	d.Endian = decode.LittleEndian
+
+	pc := &peContext{}
+
+	peOffset := peDecodeDOSHeader(d)
+	if peOffset < dosHeaderSize || int64(peOffset+4)*8 > d.Len() {
+		d.Fatalf("invalid pe offset %d", peOffset)
+	}
+	if string(d.BytesRange(int64(peOffset)*8, 4)) != peSignature {
+		d.Fatalf("pe signature not found")
+	}
+	if peOffset > dosHeaderSize {
+		d.FieldRawLen("dos_stub", int64(peOffset-dosHeaderSize)*8)
+	}
+	d.FieldUTF8("signature", 4, d.StrAssert(peSignature))
+
+	var numberOfSections uint64
+	var sizeOfOptionalHeader uint64
+	d.FieldStruct("coff_header", func(d *decode.D) {
+		d.FieldU16("machine", machineNames, scalar.UintHex)
+		numberOfSections = d.FieldU16("number_of_sections")
+		d.FieldU32("time_date_stamp", unixTimeMapper)
+		d.FieldU32("pointer_to_symbol_table", scalar.UintHex)
+		d.FieldU32("number_of_symbols")
+		sizeOfOptionalHeader = d.FieldU16("size_of_optional_header")
+		d.FieldStruct("characteristics", peDecodeCOFFCharacteristics)
+	})
+
+	if sizeOfOptionalHeader > 0 {
+		fileBytesFn := func() []byte { return d.ReadAllBits(d.BitBufRange(0, d.Len())) }
+		d.FramedFn(int64(sizeOfOptionalHeader)*8, func(d *decode.D) {
+			d.FieldStruct("optional_header", func(d *decode.D) {
+				peDecodeOptionalHeader(d, pc, fileBytesFn)
+			})
+		})
+	}
+
+	peDecodeSectionHeaders(d, pc, numberOfSections)
+
+	if dd := pc.directory(dataDirectoryExport); dd.size > 0 {
+		if pos, ok := pc.rvaToPos(d, dd.virtualAddress); ok {
+			peFieldDataDirectory(d, "export_directory", pos, dd, func(d *decode.D) {
+				d.FieldStruct("export_directory", func(d *decode.D) {
+					peDecodeExportDirectory(d, pc, dd)
+				})
+			})
+		}
+	}
+	if dd := pc.directory(dataDirectoryImport); dd.size > 0 {
+		if pos, ok := pc.rvaToPos(d, dd.virtualAddress); ok {
+			peFieldDataDirectory(d, "imports", pos, dd, func(d *decode.D) {
+				d.FieldArray("imports", func(d *decode.D) {
+					peDecodeImportDirectory(d, pc)
+				})
+			})
+		}
+	}
+	if dd := pc.directory(dataDirectoryResource); dd.size > 0 {
+		if pos, ok := pc.rvaToPos(d, dd.virtualAddress); ok {
+			peFieldDataDirectory(d, "resource_directory", pos, dd, func(d *decode.D) {
+				d.FieldStruct("resource_directory", func(d *decode.D) {
+					peDecodeResourceDirectory(d, pc, pos, 0)
+				})
+			})
+		}
+	}
+	if dd := pc.directory(dataDirectoryBaseRelocation); dd.size > 0 {
+		if pos, ok := pc.rvaToPos(d, dd.virtualAddress); ok && pos+int64(dd.size)*8 <= d.Len() {
+			peFieldDataDirectory(d, "base_relocations", pos, dd, func(d *decode.D) {
+				d.FramedFn(int64(dd.size)*8, func(d *decode.D) {
+					d.FieldArray("base_relocations", peDecodeBaseRelocations)
+				})
+			})
+		}
+	}
+	if dd := pc.directory(dataDirectoryDebug); dd.size > 0 {
+		if pos, ok := pc.rvaToPos(d, dd.virtualAddress); ok {
+			peFieldDataDirectory(d, "debug_directory", pos, dd, func(d *decode.D) {
+				d.FieldArray("debug_directory", func(d *decode.D) {
+					peDecodeDebugDirectory(d, dd.size)
+				})
+			})
+		}
+	}
+	// certificate table is not mapped into memory so address is a file offset
+	if dd := pc.directory(dataDirectoryCertificate); dd.size > 0 {
+		pos := int64(dd.virtualAddress) * 8
+		if pos+int64(dd.size)*8 <= d.Len() {
+			peFieldDataDirectory(d, "certificates", pos, dd, func(d *decode.D) {
+				d.FramedFn(int64(dd.size)*8, func(d *decode.D) {
+					d.FieldArray("certificates", peDecodeCertificateTable)
+				})
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+#!/usr/bin/env python3
+# generate small synthetic PE images with imports, exports, resources, base relocations,
+# debug directory and an authenticode-like certificate table
+# usage: make_pe.py 64 pe32plus_dll [signature.der]
+#        make_pe.py 32 pe32_exe
+# signature.der can be made with:
+# openssl req -x509 -newkey ec -pkeyopt ec_paramgen_curve:prime256v1 -nodes -keyout key.pem -out cert.pem -subj /CN=fq-test -days 1
+# printf fq-test | openssl smime -sign -binary -signer cert.pem -inkey key.pem -outform DER -nodetach -out signature.der
+import struct
+import sys
+
+FILE_ALIGNMENT = 0x200
+SECTION_ALIGNMENT = 0x1000
+HEADERS_SIZE = 0x400
+TIMESTAMP = 0x5F5E1000
+
+
+def align(n, a):
+    return (n + a - 1) // a * a
+
+
+def utf16_str(s):
+    return struct.pack("<H", len(s)) + s.encode("utf-16-le")
+
+
+def pe_checksum(b, checksum_offset):
+    s = 0
+    for i in range(0, len(b), 2):
+        if i == checksum_offset or i == checksum_offset + 2:
+            continue
+        w = b[i] | ((b[i + 1] if i + 1 < len(b) else 0) << 8)
+        s += w
+        s = (s & 0xFFFF) + (s >> 16)
+    s = (s & 0xFFFF) + (s >> 16)
+    return (s + len(b)) & 0xFFFFFFFF
+
+
+class Builder:
+    def __init__(self, rva):
+        self.rva = rva
+        self.b = bytearray()
+
+    def here(self):
+        return self.rva + len(self.b)
+
+    def add(self, b, alignment=1):
+        self.b += b"\x00" * (align(len(self.b), alignment) - len(self.b))
+        rva = self.here()
+        self.b += b
+        return rva
+
+    def patch(self, rva, b):
+        o = rva - self.rva
+        self.b[o : o + len(b)] = b
+
+
+def make_rdata(rva, bits, image_base, text_rva, with_exports):
+    ptr = "<Q" if bits == 64 else "<I"
+    ordinal_flag = 1 << (bits - 1)
+    rd = Builder(rva)
+
+    imports = [
+        ("kernel32.dll", [(0x100, "ExitProcess"), (0x200, "GetStdHandle")]),
+        ("user32.dll", [(None, 5), (0x10, "MessageBoxA")]),
+    ]
+    descriptors_rva = rd.add(b"\x00" * 20 * (len(imports) + 1), 4)
+    for i, (dll, funcs) in enumerate(imports):
+        thunks = []
+        for hint, name in funcs:
+            if hint is None:
+                thunks.append(ordinal_flag | name)
+            else:
+                thunks.append(rd.add(struct.pack("<H", hint) + name.encode() + b"\x00", 2))
+        ilt = rd.add(b"".join(struct.pack(ptr, t) for t in thunks + [0]), 8)
+        iat = rd.add(b"".join(struct.pack(ptr, t) for t in thunks + [0]), 8)
+        name = rd.add(dll.encode() + b"\x00")
+        rd.patch(descriptors_rva + i * 20, struct.pack("<IIIII", ilt, 0, 0, name, iat))
+    import_dir = (descriptors_rva, 20 * (len(imports) + 1))
+
+    export_dir = (0, 0)
+    if with_exports:
+        export_rva = rd.add(b"\x00" * 40, 4)
+        dll_name = rd.add(b"test.dll\x00")
+        forwarder = rd.add(b"kernel32.ExitProcess\x00")
+        functions = [text_rva, text_rva + 0x10, forwarder]
+        names = [("forwarded", 2), ("hello", 0), ("world", 1)]
+        eat = rd.add(b"".join(struct.pack("<I", f) for f in functions), 4)
+        name_rvas = [rd.add(n.encode() + b"\x00") for n, _ in names]
+        npt = rd.add(b"".join(struct.pack("<I", n) for n in name_rvas), 4)
+        ot = rd.add(b"".join(struct.pack("<H", o) for _, o in names), 2)
+        rd.patch(
+            export_rva,
+            struct.pack("<IIHHIIIIIII", 0, TIMESTAMP, 0, 0, dll_name, 1, len(functions), len(names), eat, npt, ot),
+        )
+        export_dir = (export_rva, rd.here() - export_rva)
+
+    debug_rva = rd.add(b"\x00" * 28 * 2, 4)
+    codeview = b"RSDS" + bytes(range(16)) + struct.pack("<I", 1) + b"C:\\src\\test\\test.pdb\x00"
+    codeview_rva = rd.add(codeview, 4)
+    vc_feature = struct.pack("<IIIII", 0, 10, 10, 0, 0)
+    vc_feature_rva = rd.add(vc_feature, 4)
+    debug_entries = [(2, codeview_rva, len(codeview)), (12, vc_feature_rva, len(vc_feature))]
+
+    return rd, import_dir, export_dir, (debug_rva, 28 * len(debug_entries)), debug_entries
+
+
+def make_rsrc(rva):
+    rs = Builder(rva)
+
+    def directory(named, ids):
+        return struct.pack("<IIHHHH", 0, 0, 0, 0, named, ids)
+
+    # type level: named "MYDATA" and id 24 (manifest)
+    root = rs.add(directory(1, 1) + b"\x00" * 8 * 2)
+    mydata_names = rs.add(directory(0, 1) + b"\x00" * 8)
+    mydata_langs = rs.add(directory(0, 1) + b"\x00" * 8)
+    manifest_names = rs.add(directory(0, 1) + b"\x00" * 8)
+    manifest_langs = rs.add(directory(0, 1) + b"\x00" * 8)
+    mydata_entry = rs.add(b"\x00" * 16)
+    manifest_entry = rs.add(b"\x00" * 16)
+    mydata_name = rs.add(utf16_str("MYDATA"), 2)
+    mydata = b"hello resource\n"
+    mydata_rva = rs.add(mydata, 4)
+    manifest = b'<assembly xmlns="urn:schemas-microsoft-com:asm.v1" manifestVersion="1.0"/>\n'
+    manifest_rva = rs.add(manifest, 4)
+
+    def off(r):
+        return r - rva
+
+    rs.patch(
+        root + 16,
+        struct.pack("<II", 0x80000000 | off(mydata_name), 0x80000000 | off(mydata_names))
+        + struct.pack("<II", 24, 0x80000000 | off(manifest_names)),
+    )
+    rs.patch(mydata_names + 16, struct.pack("<II", 1, 0x80000000 | off(mydata_langs)))
+    rs.patch(mydata_langs + 16, struct.pack("<II", 0, off(mydata_entry)))
+    rs.patch(manifest_names + 16, struct.pack("<II", 1, 0x80000000 | off(manifest_langs)))
+    rs.patch(manifest_langs + 16, struct.pack("<II", 1033, off(manifest_entry)))
+    rs.patch(mydata_entry, struct.pack("<IIII", mydata_rva, len(mydata), 0, 0))
+    rs.patch(manifest_entry, struct.pack("<IIII", manifest_rva, len(manifest), 1252, 0))
+
+    return rs, (rva, len(rs.b))
+
+
+def make_reloc(rva, bits, data_rva):
+    # absolute pointers in .data, pad block to 32 bit alignment with an absolute entry
+    typ = 10 if bits == 64 else 3
+    size = bits // 8
+    entries = [typ << 12 | 0, typ << 12 | size, 0]
+    block = struct.pack("<II", data_rva, 8 + 2 * len(entries)) + b"".join(struct.pack("<H", e) for e in entries)
+    return block, (rva, len(block))
+
+
+def main():
+    bits = int(sys.argv[1])
+    out = sys.argv[2]
+    signature = open(sys.argv[3], "rb").read() if len(sys.argv) > 3 else None
+    is_dll = bits == 64
+    image_base = 0x180000000 if bits == 64 else 0x400000
+    ptr = "<Q" if bits == 64 else "<I"
+
+    text_rva, rdata_rva, rsrc_rva, data_rva, reloc_rva = 0x1000, 0x2000, 0x3000, 0x4000, 0x5000
+
+    # mov eax, 1; ret; padding; xor eax, eax; ret
+    text = bytes([0xB8, 0x01, 0x00, 0x00, 0x00, 0xC3]) + b"\xcc" * 10 + bytes([0x31, 0xC0, 0xC3])
+    rdata, import_dir, export_dir, debug_dir, debug_entries = make_rdata(rdata_rva, bits, image_base, text_rva, is_dll)
+    rsrc, resource_dir = make_rsrc(rsrc_rva) if is_dll else (None, (0, 0))
+    data = struct.pack(ptr, image_base + text_rva) + struct.pack(ptr, image_base + text_rva + 0x10)
+    reloc, reloc_dir = make_reloc(reloc_rva, bits, data_rva)
+
+    sections = [
+        (".text", text_rva, text, 0x60000020),
+        (".rdata", rdata_rva, bytes(rdata.b), 0x40000040),
+    ]
+    if rsrc:
+        sections.append((".rsrc", rsrc_rva, bytes(rsrc.b), 0x40000040))
+    sections.append((".data", data_rva, data, 0xC0000040))
+    sections.append((".reloc", reloc_rva, reloc, 0x42000040))
+
+    raw = []
+    offset = HEADERS_SIZE
+    for name, rva, content, characteristics in sections:
+        size = align(len(content), FILE_ALIGNMENT)
+        raw.append((name, rva, content, characteristics, offset, size))
+        offset += size
+    image_end = offset
+    size_of_image = align(sections[-1][1] + len(sections[-1][2]), SECTION_ALIGNMENT)
+
+    cert_dir = (0, 0)
+    cert = b""
+    if signature:
+        cert = struct.pack("<IHH", 8 + len(signature), 0x200, 2) + signature
+        cert += b"\x00" * (align(len(cert), 8) - len(cert))
+        cert_dir = (image_end, len(cert))
+
+    directories = [(0, 0)] * 16
+    directories[0] = export_dir
+    directories[1] = import_dir
+    directories[2] = resource_dir
+    directories[4] = cert_dir
+    directories[5] = reloc_dir
+    directories[6] = debug_dir
+
+    pe_offset = 0x80
+    dos_stub = bytes.fromhex("0e1fba0e00b409cd21b8014ccd21") + b"This program cannot be run in DOS mode.\r\r\n$"
+    dos_header = bytearray(64)
+    struct.pack_into("<2sHHHHHHHHHHHHH", dos_header, 0, b"MZ", 0x90, 3, 0, 4, 0, 0xFFFF, 0, 0xB8, 0, 0, 0, 0x40, 0)
+    struct.pack_into("<I", dos_header, 0x3C, pe_offset)
+    header = bytes(dos_header) + dos_stub
+    header += b"\x00" * (pe_offset - len(header))
+
+    if bits == 64:
+        machine = 0x8664
+        characteristics = 0x2022  # dll, large address aware, executable
+        optional_magic = 0x20B
+    else:
+        machine = 0x14C
+        characteristics = 0x0102  # 32 bit machine, executable
+        optional_magic = 0x10B
+
+    optional = struct.pack(
+        "<HBBIIIII",
+        optional_magic,
+        14,
+        0,
+        align(len(text), FILE_ALIGNMENT),
+        sum(s[5] for s in raw[1:]),
+        0,
+        text_rva if not is_dll else 0,
+        text_rva,
+    )
+    if bits == 32:
+        optional += struct.pack("<I", rdata_rva)
+    optional += struct.pack(ptr, image_base)
+    optional += struct.pack(
+        "<IIHHHHHHIIIIHH",
+        SECTION_ALIGNMENT,
+        FILE_ALIGNMENT,
+        6,
+        0,
+        0,
+        0,
+        6,
+        0,
+        0,
+        size_of_image,
+        HEADERS_SIZE,
+        0,  # checksum, patched below
+        3 if not is_dll else 2,
+        0x0160 if bits == 64 else 0x0140,
+    )
+    optional += struct.pack("<" + ptr[1] * 4, 0x100000, 0x1000, 0x100000, 0x1000)
+    optional += struct.pack("<II", 0, len(directories))
+    optional += b"".join(struct.pack("<II", *d) for d in directories)
+
+    coff = struct.pack("<HHIIIHH", machine, len(sections), TIMESTAMP, 0, 0, len(optional), characteristics)
+
+    section_table = b""
+    for name, rva, content, characteristics, offset, size in raw:
+        section_table += struct.pack(
+            "<8sIIIIIIHHI", name.encode(), len(content), rva, size, offset, 0, 0, 0, 0, characteristics
+        )
+
+    headers = header + b"PE\x00\x00" + coff + optional + section_table
+    checksum_offset = len(header) + 4 + len(coff) + 64
+    headers += b"\x00" * (HEADERS_SIZE - len(headers))
+
+    b = bytearray(headers)
+    for name, rva, content, characteristics, offset, size in raw:
+        b += content + b"\x00" * (size - len(content))
+    b += cert
+
+    # debug directory points to file offsets
+    rdata_offset = raw[1][4]
+    for i, (typ, rva, size) in enumerate(debug_entries):
+        entry = struct.pack("<IIHHIIII", 0, TIMESTAMP, 0, 0, typ, size, rva, rdata_offset + rva - rdata_rva)
+        o = rdata_offset + debug_dir[0] - rdata_rva + i * 28
+        b[o : o + 28] = entry
+
+    struct.pack_into("<I", b, checksum_offset, pe_checksum(b, checksum_offset))
+
+    open(out, "wb").write(b)
+
+
+if __name__ == "__main__":
+    main()
@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "Portable Network Graphics file",
 			Groups:      []*decode.Group{format.Probe, format.Image},
+			Signatures:  []decode.Signature{{Bytes: []byte("\x89PNG\r\n\x1a\n")}},
 			DecodeFn:    pngDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.ICC_Profile}, Out: &iccProfileGroup},
@@ -230,7 +231,7 @@ func pngDecode(d *decode.D) any {
 
 		chunkCRC := crc32.NewIEEE()
 		d.Copy(chunkCRC, bitio.NewIOReader(d.BitBufRange(crcStartPos, d.Pos()-crcStartPos)))
-		d.FieldU32("crc", d.UintValidateBytes(chunkCRC.Sum(nil)), scalar.UintHex)
+		d.FieldChecksumU("crc", 32, "crc32", chunkCRC.Sum(nil), scalar.UintHex)
 	})
 
 	return nil
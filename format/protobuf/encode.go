@@ -0,0 +1,489 @@
+package protobuf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	interp.RegisterFunc1("_to_protobuf", toProtobuf)
+}
+
+type toProtobufOpts struct {
+	Proto         string
+	DescriptorSet string
+	MessageType   string
+}
+
+// well-known types that torepr represents as something else than an object with fields
+var protobufSpecialWellKnownTypes = map[string]bool{
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+func protobufGoValue(v any) any {
+	switch vv := v.(type) {
+	case interp.Binary:
+		return vv
+	case gojq.JQValue:
+		return vv.JQValueToGoJQ()
+	}
+	return v
+}
+
+func protobufBytes(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	case interp.Binary:
+		br, err := interp.ToBitReader(v)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(bitio.NewIOReader(br))
+	}
+	return nil, fmt.Errorf("expected binary or string got %s", gojq.TypeOf(v))
+}
+
+// integer from number or decimal string, strings are used for 64 bit integers and map keys
+func protobufInt(v any) (*big.Int, error) {
+	switch v := v.(type) {
+	case int:
+		return big.NewInt(int64(v)), nil
+	case *big.Int:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%v is not an integer", v)
+		}
+		n, _ := big.NewFloat(v).Int(nil)
+		return n, nil
+	case string:
+		n, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return nil, fmt.Errorf("%q is not an integer", v)
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("expected integer got %s", gojq.TypeOf(v))
+}
+
+func protobufIntRange(v any, lo int64, hi uint64) (*big.Int, error) {
+	n, err := protobufInt(v)
+	if err != nil {
+		return nil, err
+	}
+	if n.Cmp(big.NewInt(lo)) < 0 || n.Cmp(new(big.Int).SetUint64(hi)) > 0 {
+		return nil, fmt.Errorf("%s out of range", n)
+	}
+	return n, nil
+}
+
+func protobufFloat(v any) (float64, error) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, nil
+	case float64:
+		return v, nil
+	case string:
+		// same as proto3 JSON mapping
+		switch v {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("expected number got %s", gojq.TypeOf(v))
+}
+
+// append varint or little endian fixed value for a scalar field
+func protobufAppendScalar(b []byte, pbf format.ProtoBufField, v any) ([]byte, error) {
+	switch pbf.Type {
+	case format.ProtoBufTypeEnum:
+		if s, ok := v.(string); ok {
+			for n, name := range pbf.Enums {
+				if name == s {
+					return binary.AppendUvarint(b, n), nil
+				}
+			}
+			if _, err := protobufInt(s); err != nil {
+				return nil, fmt.Errorf("unknown enum value %q", s)
+			}
+		}
+		n, err := protobufIntRange(v, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		return binary.AppendUvarint(b, uint64(n.Int64())), nil
+	case format.ProtoBufTypeBool:
+		switch v {
+		case true, "true":
+			return append(b, 1), nil
+		case false, "false":
+			return append(b, 0), nil
+		}
+		return nil, fmt.Errorf("expected boolean got %s", gojq.TypeOf(v))
+	case format.ProtoBufTypeFloat:
+		f, err := protobufFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(f))), nil
+	case format.ProtoBufTypeDouble:
+		f, err := protobufFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(f)), nil
+	}
+
+	var lo int64
+	var hi uint64
+	switch pbf.Type {
+	case format.ProtoBufTypeInt32, format.ProtoBufTypeSInt32, format.ProtoBufTypeSFixed32:
+		lo, hi = math.MinInt32, math.MaxInt32
+	case format.ProtoBufTypeInt64, format.ProtoBufTypeSInt64, format.ProtoBufTypeSFixed64:
+		lo, hi = math.MinInt64, math.MaxInt64
+	case format.ProtoBufTypeUInt32, format.ProtoBufTypeFixed32:
+		lo, hi = 0, math.MaxUint32
+	case format.ProtoBufTypeUInt64, format.ProtoBufTypeFixed64:
+		lo, hi = 0, math.MaxUint64
+	default:
+		return nil, fmt.Errorf("unsupported type %s", format.ProtoBufTypeNames[uint64(pbf.Type)])
+	}
+	n, err := protobufIntRange(v, lo, hi)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pbf.Type {
+	case format.ProtoBufTypeInt32, format.ProtoBufTypeInt64:
+		// negative is sign extended to 64 bit so always 10 bytes
+		return binary.AppendUvarint(b, uint64(n.Int64())), nil
+	case format.ProtoBufTypeSInt32, format.ProtoBufTypeSInt64:
+		i := n.Int64()
+		return binary.AppendUvarint(b, uint64(i<<1)^uint64(i>>63)), nil
+	case format.ProtoBufTypeSFixed32:
+		return binary.LittleEndian.AppendUint32(b, uint32(n.Int64())), nil
+	case format.ProtoBufTypeSFixed64:
+		return binary.LittleEndian.AppendUint64(b, uint64(n.Int64())), nil
+	case format.ProtoBufTypeFixed32:
+		return binary.LittleEndian.AppendUint32(b, uint32(n.Uint64())), nil
+	case format.ProtoBufTypeFixed64:
+		return binary.LittleEndian.AppendUint64(b, n.Uint64()), nil
+	default:
+		return binary.AppendUvarint(b, n.Uint64()), nil
+	}
+}
+
+func protobufAppendKey(b []byte, number int, wireType uint64) []byte {
+	return binary.AppendUvarint(b, uint64(number)<<3|wireType)
+}
+
+func protobufAppendLengthDelimited(b []byte, number int, data []byte) []byte {
+	b = protobufAppendKey(b, number, wireTypeLengthDelimited)
+	b = binary.AppendUvarint(b, uint64(len(data)))
+	return append(b, data...)
+}
+
+// "1.5s" and "-0.000001s"
+func protobufParseDuration(s string) (map[string]any, error) {
+	d, ok := strings.CutSuffix(s, "s")
+	if !ok {
+		return nil, fmt.Errorf("invalid duration %q", s)
+	}
+	neg := strings.HasPrefix(d, "-")
+	d = strings.TrimPrefix(d, "-")
+	secs, frac, _ := strings.Cut(d, ".")
+	seconds, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil || len(frac) > 9 {
+		return nil, fmt.Errorf("invalid duration %q", s)
+	}
+	var nanos int64
+	if frac != "" {
+		if nanos, err = strconv.ParseInt((frac + "00000000")[:9], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid duration %q", s)
+		}
+	}
+	if neg {
+		seconds, nanos = -seconds, -nanos
+	}
+	return map[string]any{"seconds": int(seconds), "nanos": int(nanos)}, nil
+}
+
+type protobufEncoder struct {
+	schema *protoSchema
+}
+
+// fromRepr turns torepr form of well-known types back into regular message objects
+func (e protobufEncoder) fromRepr(typeName string, v any) (any, error) {
+	switch typeName {
+	case "google.protobuf.Timestamp":
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{"seconds": int(t.Unix()), "nanos": t.Nanosecond()}, nil
+		}
+	case "google.protobuf.Duration":
+		if s, ok := v.(string); ok {
+			return protobufParseDuration(s)
+		}
+	case "google.protobuf.Struct":
+		return map[string]any{"fields": v}, nil
+	case "google.protobuf.ListValue":
+		return map[string]any{"values": v}, nil
+	case "google.protobuf.Value":
+		switch v.(type) {
+		case nil:
+			return map[string]any{"null_value": 0}, nil
+		case bool:
+			return map[string]any{"bool_value": v}, nil
+		case int, float64, *big.Int:
+			return map[string]any{"number_value": v}, nil
+		case string:
+			return map[string]any{"string_value": v}, nil
+		case []any:
+			return map[string]any{"list_value": v}, nil
+		case map[string]any:
+			return map[string]any{"struct_value": v}, nil
+		}
+	case "google.protobuf.Any":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			break
+		}
+		typeURL, ok := obj["@type"].(string)
+		if !ok {
+			break
+		}
+		rest := map[string]any{}
+		for k, fv := range obj {
+			if k != "@type" {
+				rest[k] = fv
+			}
+		}
+		n, m, err := e.schema.message(typeURL[strings.LastIndex(typeURL, "/")+1:])
+		if err != nil {
+			// unknown type with already encoded value
+			if _, ok := rest["value"]; ok && len(rest) == 1 {
+				return map[string]any{"type_url": typeURL, "value": rest["value"]}, nil
+			}
+			return nil, err
+		}
+		var mv any = rest
+		if protobufSpecialWellKnownTypes[n] {
+			mv = rest["value"]
+		}
+		b, err := e.message(nil, n, m, mv)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type_url": typeURL, "value": b}, nil
+	default:
+		if protobufSpecialWellKnownTypes[typeName] {
+			// wrappers
+			if _, ok := v.(map[string]any); !ok {
+				return map[string]any{"value": v}, nil
+			}
+		}
+	}
+	return v, nil
+}
+
+func (e protobufEncoder) field(b []byte, number int, pbf format.ProtoBufField, v any) ([]byte, error) {
+	switch pbf.Type {
+	case format.ProtoBufTypeMessage:
+		mb, err := e.message(nil, pbf.TypeName, pbf.Message, v)
+		if err != nil {
+			return nil, err
+		}
+		return protobufAppendLengthDelimited(b, number, mb), nil
+	case format.ProtoBufTypeString:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string got %s", gojq.TypeOf(v))
+		}
+		if pbf.Implicit && s == "" {
+			return b, nil
+		}
+		return protobufAppendLengthDelimited(b, number, []byte(s)), nil
+	case format.ProtoBufTypeBytes:
+		bs, err := protobufBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if pbf.Implicit && len(bs) == 0 {
+			return b, nil
+		}
+		return protobufAppendLengthDelimited(b, number, bs), nil
+	default:
+		sb, err := protobufAppendScalar(nil, pbf, v)
+		if err != nil {
+			return nil, err
+		}
+		// zero value encodes as only zero bytes, -0.0 has sign bit set so is kept
+		if pbf.Implicit && strings.Trim(string(sb), "\x00") == "" {
+			return b, nil
+		}
+		return append(protobufAppendKey(b, number, protobufWireType(pbf.Type)), sb...), nil
+	}
+}
+
+func (e protobufEncoder) fieldValues(b []byte, number int, pbf format.ProtoBufField, v any) ([]byte, error) {
+	switch {
+	case pbf.Map:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected object got %s", gojq.TypeOf(v))
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			var err error
+			entry := map[string]any{"key": k, "value": protobufGoValue(obj[k])}
+			if b, err = e.field(b, number, pbf, entry); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		return b, nil
+	case pbf.Repeated:
+		vs, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected array got %s", gojq.TypeOf(v))
+		}
+		if pbf.Packed {
+			if len(vs) == 0 {
+				return b, nil
+			}
+			var pb []byte
+			for i, ve := range vs {
+				var err error
+				if pb, err = protobufAppendScalar(pb, pbf, protobufGoValue(ve)); err != nil {
+					return nil, fmt.Errorf("%d: %w", i, err)
+				}
+			}
+			return protobufAppendLengthDelimited(b, number, pb), nil
+		}
+		for i, ve := range vs {
+			var err error
+			if b, err = e.field(b, number, pbf, protobufGoValue(ve)); err != nil {
+				return nil, fmt.Errorf("%d: %w", i, err)
+			}
+		}
+		return b, nil
+	default:
+		return e.field(b, number, pbf, v)
+	}
+}
+
+// message appends fields in field number order, null fields are skipped
+func (e protobufEncoder) message(b []byte, typeName string, pbm format.ProtoBufMessage, v any) ([]byte, error) {
+	v, err := e.fromRepr(typeName, protobufGoValue(v))
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected object got %s", typeName, gojq.TypeOf(v))
+	}
+
+	numbers := make([]int, 0, len(pbm))
+	byName := map[string]int{}
+	for n, pbf := range pbm {
+		numbers = append(numbers, n)
+		byName[pbf.Name] = n
+	}
+	sort.Ints(numbers)
+	for k := range obj {
+		if _, ok := byName[k]; !ok {
+			return nil, fmt.Errorf("%s: unknown field %q", typeName, k)
+		}
+	}
+
+	oneofs := map[string]string{}
+	for _, n := range numbers {
+		pbf := pbm[n]
+		fv := protobufGoValue(obj[pbf.Name])
+		if fv == nil && pbf.TypeName != "google.protobuf.Value" {
+			continue
+		}
+		if _, ok := obj[pbf.Name]; !ok {
+			continue
+		}
+		if pbf.Oneof != "" {
+			if other, ok := oneofs[pbf.Oneof]; ok {
+				return nil, fmt.Errorf("%s: oneof %s has both %s and %s set", typeName, pbf.Oneof, other, pbf.Name)
+			}
+			oneofs[pbf.Oneof] = pbf.Name
+		}
+		if b, err = e.fieldValues(b, n, pbf, fv); err != nil {
+			return nil, fmt.Errorf("%s: %w", pbf.Name, err)
+		}
+	}
+
+	return b, nil
+}
+
+func toProtobuf(_ *interp.Interp, c any, opts toProtobufOpts) any {
+	if opts.Proto == "" && opts.DescriptorSet == "" {
+		return errors.New("proto or descriptor_set option is required")
+	}
+	s, err := loadProtoSchema(opts.Proto, opts.DescriptorSet)
+	if err != nil {
+		return err
+	}
+	n, m, err := s.message(opts.MessageType)
+	if err != nil {
+		return err
+	}
+
+	b, err := protobufEncoder{schema: s}.message(nil, n, m, c)
+	if err != nil {
+		return err
+	}
+
+	bb, err := interp.NewBinaryFromBitReader(bitio.NewBitReader(b, -1), 8, 0)
+	if err != nil {
+		return err
+	}
+	return bb
+}
@@ -4,6 +4,8 @@ package protobuf
 
 import (
 	"embed"
+	"math"
+	"strings"
 
 	"github.com/wader/fq/format"
 	"github.com/wader/fq/internal/mathx"
@@ -12,15 +14,17 @@ import (
 	"github.com/wader/fq/pkg/scalar"
 )
 
-//go:embed protobuf.md
+//go:embed protobuf.jq protobuf.md
 var protobufFS embed.FS
 
 func init() {
 	interp.RegisterFormat(
 		format.Protobuf,
 		&decode.Format{
-			Description: "Protobuf",
-			DecodeFn:    protobufDecode,
+			Description:  "Protobuf",
+			DecodeFn:     protobufDecode,
+			DefaultInArg: format.Protobuf_In{},
+			Functions:    []string{"torepr"},
 		})
 	interp.RegisterFS(protobufFS)
 }
@@ -29,6 +33,8 @@ const (
 	wireTypeVarint          = 0
 	wireType64Bit           = 1
 	wireTypeLengthDelimited = 2
+	wireTypeStartGroup      = 3
+	wireTypeEndGroup        = 4
 	wireType32Bit           = 5
 )
 
@@ -36,10 +42,78 @@ var wireTypeNames = scalar.UintMapSymStr{
 	0: "varint",
 	1: "64bit",
 	2: "length_delimited",
+	3: "start_group",
+	4: "end_group",
 	5: "32bit",
 }
 
-func protobufDecodeField(d *decode.D, pbm *format.ProtoBufMessage) {
+const anyTypeName = "google.protobuf.Any"
+
+func protobufWireType(typ int) uint64 {
+	switch typ {
+	case format.ProtoBufTypeFixed64,
+		format.ProtoBufTypeSFixed64,
+		format.ProtoBufTypeDouble:
+		return wireType64Bit
+	case format.ProtoBufTypeFixed32,
+		format.ProtoBufTypeSFixed32,
+		format.ProtoBufTypeFloat:
+		return wireType32Bit
+	case format.ProtoBufTypeString,
+		format.ProtoBufTypeBytes,
+		format.ProtoBufTypeMessage:
+		return wireTypeLengthDelimited
+	default:
+		return wireTypeVarint
+	}
+}
+
+type protobufDecoder struct {
+	schema *protoSchema
+}
+
+// add typed "value" field for a varint or little endian fixed wire value
+func protobufDecodeScalar(d *decode.D, pbf format.ProtoBufField, value uint64) {
+	var enumKey uint64
+	switch pbf.Type {
+	case format.ProtoBufTypeInt32, format.ProtoBufTypeEnum:
+		v := int64(int32(value))
+		d.FieldValueSint("value", v)
+		enumKey = uint64(v)
+	case format.ProtoBufTypeInt64:
+		d.FieldValueSint("value", int64(value))
+		enumKey = value
+	case format.ProtoBufTypeUInt32:
+		d.FieldValueUint("value", uint64(uint32(value)))
+		enumKey = value
+	case format.ProtoBufTypeUInt64:
+		d.FieldValueUint("value", value)
+		enumKey = value
+	case format.ProtoBufTypeSInt32, format.ProtoBufTypeSInt64:
+		v := mathx.ZigZag[uint64, int64](value)
+		d.FieldValueSint("value", v)
+		enumKey = uint64(v)
+	case format.ProtoBufTypeBool:
+		d.FieldValueBool("value", value != 0)
+	case format.ProtoBufTypeFixed32, format.ProtoBufTypeFixed64:
+		d.FieldValueUint("value", value)
+	case format.ProtoBufTypeSFixed32:
+		d.FieldValueSint("value", int64(int32(value)))
+	case format.ProtoBufTypeSFixed64:
+		d.FieldValueSint("value", int64(value))
+	case format.ProtoBufTypeFloat:
+		d.FieldValueFlt("value", float64(math.Float32frombits(uint32(value))))
+	case format.ProtoBufTypeDouble:
+		d.FieldValueFlt("value", math.Float64frombits(value))
+	}
+	if len(pbf.Enums) > 0 {
+		if s, ok := pbf.Enums[enumKey]; ok {
+			d.FieldValueStr("enum", s)
+		}
+	}
+}
+
+func (pd protobufDecoder) decodeField(d *decode.D, typeName string, pbm format.ProtoBufMessage, anyType *string) {
 	d.FieldStruct("field", func(d *decode.D) {
 		keyN := d.FieldULEB128("key_n")
 		fieldNumber := keyN >> 3
@@ -54,78 +128,100 @@ func protobufDecodeField(d *decode.D, pbm *format.ProtoBufMessage) {
 		case wireTypeVarint:
 			value = d.FieldULEB128("wire_value")
 		case wireType64Bit:
-			value = d.FieldU64("wire_value")
+			value = d.FieldU64LE("wire_value")
 		case wireTypeLengthDelimited:
 			length = d.FieldULEB128("length")
 			valuePos = d.Pos()
 			d.FieldRawLen("wire_value", int64(length)*8)
 		case wireType32Bit:
-			value = d.FieldU32("wire_value")
+			value = d.FieldU32LE("wire_value")
+		case wireTypeStartGroup, wireTypeEndGroup:
+			// deprecated groups, fields in group follows start group
+		default:
+			d.Fatalf("unsupported wire type %d", wireType)
 		}
 
-		if pbm != nil {
-			if pbf, ok := (*pbm)[int(fieldNumber)]; ok {
-				d.FieldValueStr("name", pbf.Name)
-				d.FieldValueStr("type", format.ProtoBufTypeNames[uint64(pbf.Type)])
-
-				switch pbf.Type {
-				case format.ProtoBufTypeInt32, format.ProtoBufTypeInt64:
-					v := mathx.ZigZag[uint64, int64](value)
-					d.FieldValueSint("value", v)
-					if len(pbf.Enums) > 0 {
-						d.FieldValueStr("enum", pbf.Enums[uint64(v)])
-					}
-				case format.ProtoBufTypeUInt32, format.ProtoBufTypeUInt64:
-					d.FieldValueUint("value", value)
-					if len(pbf.Enums) > 0 {
-						d.FieldValueStr("enum", pbf.Enums[value])
-					}
-				case format.ProtoBufTypeSInt32, format.ProtoBufTypeSInt64:
-					// TODO: correct? 32 different?
-					v := mathx.TwosComplement(64, value)
-					d.FieldValueSint("value", v)
-					if len(pbf.Enums) > 0 {
-						d.FieldValueStr("enum", pbf.Enums[uint64(v)])
+		pbf, ok := pbm[int(fieldNumber)]
+		if !ok {
+			return
+		}
+		// any value is decoded as the message type in type_url if known
+		if typeName == anyTypeName && fieldNumber == 2 && *anyType != "" && pd.schema != nil {
+			if n, m, err := pd.schema.message(*anyType); err == nil {
+				pbf = format.ProtoBufField{Type: format.ProtoBufTypeMessage, Name: pbf.Name, Message: m, TypeName: n}
+			}
+		}
+
+		d.FieldValueStr("name", pbf.Name)
+		d.FieldValueStr("type", format.ProtoBufTypeNames[uint64(pbf.Type)])
+		if pbf.TypeName != "" {
+			d.FieldValueStr("type_name", pbf.TypeName)
+		}
+		if pbf.Repeated {
+			d.FieldValueBool("repeated", true)
+		}
+		if pbf.Map {
+			d.FieldValueBool("map", true)
+		}
+		if pbf.Oneof != "" {
+			d.FieldValueStr("oneof", pbf.Oneof)
+		}
+
+		expectedWireType := protobufWireType(pbf.Type)
+		switch {
+		case wireType == wireTypeLengthDelimited && expectedWireType != wireTypeLengthDelimited:
+			// packed repeated scalars, accepted even if not declared as packed
+			// invalid packed values are left as raw wire_value with error
+			d.SeekAbs(valuePos)
+			if err := d.TryFramedFn(int64(length)*8, func(d *decode.D) {
+				d.FieldArray("values", func(d *decode.D) {
+					for d.BitsLeft() > 0 {
+						d.FieldStruct("value", func(d *decode.D) {
+							var v uint64
+							switch expectedWireType {
+							case wireTypeVarint:
+								v = d.FieldULEB128("wire_value")
+							case wireType64Bit:
+								v = d.FieldU64LE("wire_value")
+							case wireType32Bit:
+								v = d.FieldU32LE("wire_value")
+							}
+							protobufDecodeScalar(d, pbf, v)
+						})
 					}
-				case format.ProtoBufTypeBool:
-					d.FieldValueBool("value", value != 0)
-				case format.ProtoBufTypeEnum:
-					d.FieldValueStr("enum", pbf.Enums[value])
-				case format.ProtoBufTypeFixed64:
-					// TODO:
-				case format.ProtoBufTypeSFixed64:
-					// TODO:
-				case format.ProtoBufTypeDouble:
-					// TODO:
-				case format.ProtoBufTypeString:
-					d.SeekAbs(valuePos)
-					d.FieldUTF8("value", int(length))
-				case format.ProtoBufTypeBytes:
-					d.SeekAbs(valuePos)
-					d.FieldRawLen("value", int64(length)*8)
-				case format.ProtoBufTypeMessage:
-					// TODO: test
-					d.FramedFn(int64(length)*8, func(d *decode.D) {
-						protobufDecodeFields(d, &pbf.Message)
-					})
-				case format.ProtoBufTypePackedRepeated:
-					// TODO:
-				case format.ProtoBufTypeFixed32:
-					// TODO:
-				case format.ProtoBufTypeSFixed32:
-					// TODO:
-				case format.ProtoBufTypeFloat:
-					// TODO:
-				}
+				})
+			}); err != nil {
+				d.SeekAbs(valuePos + int64(length)*8)
+				d.FieldGet("wire_value").Err = err
+			}
+		case wireType != expectedWireType:
+			// wire type does not match schema, leave as is
+		case pbf.Type == format.ProtoBufTypeString:
+			d.SeekAbs(valuePos)
+			s := d.FieldUTF8("value", int(length))
+			if typeName == anyTypeName && fieldNumber == 1 {
+				// type.googleapis.com/pkg.Msg
+				*anyType = s[strings.LastIndex(s, "/")+1:]
 			}
+		case pbf.Type == format.ProtoBufTypeBytes:
+			d.SeekAbs(valuePos)
+			d.FieldRawLen("value", int64(length)*8)
+		case pbf.Type == format.ProtoBufTypeMessage:
+			d.SeekAbs(valuePos)
+			d.FramedFn(int64(length)*8, func(d *decode.D) {
+				pd.decodeFields(d, pbf.TypeName, pbf.Message)
+			})
+		default:
+			protobufDecodeScalar(d, pbf, value)
 		}
 	})
 }
 
-func protobufDecodeFields(d *decode.D, pbm *format.ProtoBufMessage) {
+func (pd protobufDecoder) decodeFields(d *decode.D, typeName string, pbm format.ProtoBufMessage) {
+	var anyType string
 	d.FieldArray("fields", func(d *decode.D) {
 		for d.BitsLeft() > 0 {
-			protobufDecodeField(d, pbm)
+			pd.decodeField(d, typeName, pbm, &anyType)
 		}
 	})
 }
@@ -134,7 +230,23 @@ func protobufDecode(d *decode.D) any {
 	var pbi format.Protobuf_In
 	d.ArgAs(&pbi)
 
-	protobufDecodeFields(d, &pbi.Message)
+	var pd protobufDecoder
+	pbm := pbi.Message
+	var typeName string
+	if pbm == nil && (pbi.Proto != "" || pbi.DescriptorSet != "") {
+		s, err := loadProtoSchema(pbi.Proto, pbi.DescriptorSet)
+		if err != nil {
+			d.Fatalf("%s", err)
+		}
+		typeName, pbm, err = s.message(pbi.MessageType)
+		if err != nil {
+			d.Fatalf("%s", err)
+		}
+		pd.schema = s
+		d.FieldValueStr("message_type", typeName)
+	}
+
+	pd.decodeFields(d, typeName, pbm)
 
 	return nil
 }
@@ -0,0 +1,264 @@
+package protobuf
+
+// Schema from .proto source or FileDescriptorSet resolved into format.ProtoBufMessage
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/wader/fq/format"
+)
+
+//go:embed wellknown.proto
+var wellKnownProto string
+
+// subset of google/protobuf/descriptor.proto used by both .proto and descriptor set parsing
+type protoFile struct {
+	pkg      string
+	packed   bool // proto3 and editions packs repeated scalars by default
+	implicit bool // proto3 fields has no presence unless optional
+	messages []*protoMessage
+	enums    []*protoEnum
+}
+
+type protoMessage struct {
+	name     string
+	fields   []*protoField
+	messages []*protoMessage
+	enums    []*protoEnum
+	mapEntry bool
+}
+
+type protoField struct {
+	name     string
+	number   int
+	repeated bool
+	typ      string // scalar type or message/enum type name to resolve
+	oneof    string
+	optional bool
+	packed   *bool
+}
+
+type protoEnum struct {
+	name   string
+	values map[uint64]string
+}
+
+var protoScalarTypes = map[string]int{
+	"double":   format.ProtoBufTypeDouble,
+	"float":    format.ProtoBufTypeFloat,
+	"int64":    format.ProtoBufTypeInt64,
+	"uint64":   format.ProtoBufTypeUInt64,
+	"int32":    format.ProtoBufTypeInt32,
+	"fixed64":  format.ProtoBufTypeFixed64,
+	"fixed32":  format.ProtoBufTypeFixed32,
+	"bool":     format.ProtoBufTypeBool,
+	"string":   format.ProtoBufTypeString,
+	"bytes":    format.ProtoBufTypeBytes,
+	"uint32":   format.ProtoBufTypeUInt32,
+	"sfixed32": format.ProtoBufTypeSFixed32,
+	"sfixed64": format.ProtoBufTypeSFixed64,
+	"sint32":   format.ProtoBufTypeSInt32,
+	"sint64":   format.ProtoBufTypeSInt64,
+}
+
+func protoIsPackable(typ int) bool {
+	switch typ {
+	case format.ProtoBufTypeString,
+		format.ProtoBufTypeBytes,
+		format.ProtoBufTypeMessage:
+		return false
+	default:
+		return true
+	}
+}
+
+type protoSchema struct {
+	messages map[string]format.ProtoBufMessage
+	first    string
+}
+
+// message looks up a message by full name, leading dot is optional and a
+// unique name suffix like "Msg" for "pkg.Msg" is also allowed
+func (s *protoSchema) message(name string) (string, format.ProtoBufMessage, error) {
+	if name == "" {
+		name = s.first
+	}
+	if name == "" {
+		return "", nil, fmt.Errorf("no message types in schema")
+	}
+	name = strings.TrimPrefix(name, ".")
+	if m, ok := s.messages[name]; ok {
+		return name, m, nil
+	}
+
+	var found []string
+	for n := range s.messages {
+		if strings.HasSuffix(n, "."+name) {
+			found = append(found, n)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", nil, fmt.Errorf("message type %q not found", name)
+	case 1:
+		return found[0], s.messages[found[0]], nil
+	default:
+		return "", nil, fmt.Errorf("message type %q is ambiguous", name)
+	}
+}
+
+// newProtoSchema links files, main is the file used to find default message type
+func newProtoSchema(files []*protoFile, main *protoFile) (*protoSchema, error) {
+	wkf, err := parseProto(wellKnownProto)
+	if err != nil {
+		return nil, err
+	}
+
+	type scopedMessage struct {
+		name string
+		file *protoFile
+		m    *protoMessage
+	}
+	var msgs []scopedMessage
+	msgByName := map[string]*protoMessage{}
+	enumByName := map[string]*protoEnum{}
+
+	var addAll func(scope string, f *protoFile, ms []*protoMessage, es []*protoEnum)
+	addAll = func(scope string, f *protoFile, ms []*protoMessage, es []*protoEnum) {
+		join := func(n string) string {
+			if scope == "" {
+				return n
+			}
+			return scope + "." + n
+		}
+		for _, e := range es {
+			if _, ok := enumByName[join(e.name)]; !ok {
+				enumByName[join(e.name)] = e
+			}
+		}
+		for _, m := range ms {
+			n := join(m.name)
+			if _, ok := msgByName[n]; ok {
+				// already defined, ex: well-known type also in descriptor set
+				continue
+			}
+			msgByName[n] = m
+			msgs = append(msgs, scopedMessage{name: n, file: f, m: m})
+			addAll(n, f, m.messages, m.enums)
+		}
+	}
+	for _, f := range append(files, wkf) {
+		addAll(f.pkg, f, f.messages, f.enums)
+	}
+
+	s := &protoSchema{messages: map[string]format.ProtoBufMessage{}}
+	for _, sm := range msgs {
+		s.messages[sm.name] = format.ProtoBufMessage{}
+	}
+	if main != nil && len(main.messages) > 0 {
+		if main.pkg != "" {
+			s.first = main.pkg + "." + main.messages[0].name
+		} else {
+			s.first = main.messages[0].name
+		}
+	}
+
+	// resolve relative name by searching from inner to outer scope
+	resolve := func(scope string, name string) string {
+		if n, ok := strings.CutPrefix(name, "."); ok {
+			return n
+		}
+		for {
+			n := name
+			if scope != "" {
+				n = scope + "." + name
+			}
+			if _, ok := msgByName[n]; ok {
+				return n
+			}
+			if _, ok := enumByName[n]; ok {
+				return n
+			}
+			if scope == "" {
+				return ""
+			}
+			if i := strings.LastIndex(scope, "."); i != -1 {
+				scope = scope[:i]
+			} else {
+				scope = ""
+			}
+		}
+	}
+
+	for _, sm := range msgs {
+		pbm := s.messages[sm.name]
+		for _, f := range sm.m.fields {
+			pbf := format.ProtoBufField{
+				Name:     f.name,
+				Repeated: f.repeated,
+				Oneof:    f.oneof,
+			}
+			if t, ok := protoScalarTypes[f.typ]; ok {
+				pbf.Type = t
+			} else {
+				n := resolve(sm.name, f.typ)
+				if m, ok := msgByName[n]; ok {
+					pbf.Type = format.ProtoBufTypeMessage
+					pbf.Message = s.messages[n]
+					pbf.Map = m.mapEntry && f.repeated
+				} else if e, ok := enumByName[n]; ok {
+					pbf.Type = format.ProtoBufTypeEnum
+					pbf.Enums = e.values
+				} else {
+					return nil, fmt.Errorf("%s.%s: type %q not found", sm.name, f.name, f.typ)
+				}
+				pbf.TypeName = n
+			}
+			if sm.file.implicit && !sm.m.mapEntry && !f.repeated && !f.optional && f.oneof == "" {
+				pbf.Implicit = pbf.Type != format.ProtoBufTypeMessage
+			}
+			if f.repeated && protoIsPackable(pbf.Type) {
+				if f.packed != nil {
+					pbf.Packed = *f.packed
+				} else {
+					pbf.Packed = sm.file.packed
+				}
+			}
+			pbm[f.number] = pbf
+		}
+	}
+
+	return s, nil
+}
+
+// loadProtoSchema from .proto source or serialized FileDescriptorSet
+func loadProtoSchema(proto string, descriptorSet string) (*protoSchema, error) {
+	var files []*protoFile
+	if proto != "" {
+		f, err := parseProto(proto)
+		if err != nil {
+			return nil, fmt.Errorf("proto: %w", err)
+		}
+		files = append(files, f)
+	}
+	if descriptorSet != "" {
+		fs, err := parseDescriptorSet([]byte(descriptorSet))
+		if err != nil {
+			return nil, fmt.Errorf("descriptor_set: %w", err)
+		}
+		files = append(files, fs...)
+	}
+
+	// .proto source or last file in descriptor set, protoc puts dependencies first
+	var main *protoFile
+	if len(files) > 0 {
+		main = files[len(files)-1]
+		if proto != "" {
+			main = files[0]
+		}
+	}
+
+	return newProtoSchema(files, main)
+}
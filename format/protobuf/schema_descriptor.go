@@ -0,0 +1,275 @@
+package protobuf
+
+// Reads the parts of a FileDescriptorSet that are needed to decode messages
+// https://github.com/protocolbuffers/protobuf/blob/main/src/google/protobuf/descriptor.proto
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var errDescriptorTruncated = errors.New("truncated message")
+
+// calls fn for each field in a serialized message, data is set for length delimited fields
+func protoWireFields(b []byte, fn func(number int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errDescriptorTruncated
+		}
+		b = b[n:]
+
+		var v uint64
+		var data []byte
+		switch key & 0x7 {
+		case wireTypeVarint:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return errDescriptorTruncated
+			}
+			b = b[n:]
+		case wireType64Bit:
+			if len(b) < 8 {
+				return errDescriptorTruncated
+			}
+			v = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case wireTypeLengthDelimited:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return errDescriptorTruncated
+			}
+			data = b[n : n+int(l)]
+			b = b[n+int(l):]
+		case wireType32Bit:
+			if len(b) < 4 {
+				return errDescriptorTruncated
+			}
+			v = uint64(binary.LittleEndian.Uint32(b))
+			b = b[4:]
+		default:
+			return fmt.Errorf("unsupported wire type %d", key&0x7)
+		}
+
+		if err := fn(int(key>>3), v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FieldDescriptorProto.Type to .proto scalar type names, message, enum and group use type_name
+var descriptorFieldTypes = map[uint64]string{
+	1:  "double",
+	2:  "float",
+	3:  "int64",
+	4:  "uint64",
+	5:  "int32",
+	6:  "fixed64",
+	7:  "fixed32",
+	8:  "bool",
+	9:  "string",
+	12: "bytes",
+	13: "uint32",
+	15: "sfixed32",
+	16: "sfixed64",
+	17: "sint32",
+	18: "sint64",
+}
+
+const (
+	descriptorLabelRepeated = 3
+	descriptorTypeGroup     = 10
+)
+
+func parseDescriptorEnum(b []byte) (*protoEnum, error) {
+	e := &protoEnum{values: map[uint64]string{}}
+	err := protoWireFields(b, func(number int, _ uint64, data []byte) error {
+		switch number {
+		case 1: // name
+			e.name = string(data)
+		case 2: // value EnumValueDescriptorProto
+			var name string
+			var n uint64
+			if err := protoWireFields(data, func(number int, v uint64, data []byte) error {
+				switch number {
+				case 1:
+					name = string(data)
+				case 2:
+					// int32 so negative is sign extended
+					n = v
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if _, ok := e.values[n]; !ok {
+				e.values[n] = name
+			}
+		}
+		return nil
+	})
+	return e, err
+}
+
+func parseDescriptorField(b []byte, oneofs *[]int) (*protoField, error) {
+	f := &protoField{}
+	var typ uint64
+	var typeName string
+	oneofIndex := -1
+	err := protoWireFields(b, func(number int, v uint64, data []byte) error {
+		switch number {
+		case 1: // name
+			f.name = string(data)
+		case 3: // number
+			f.number = int(v)
+		case 4: // label
+			f.repeated = v == descriptorLabelRepeated
+		case 5: // type
+			typ = v
+		case 6: // type_name
+			typeName = string(data)
+		case 8: // options FieldOptions
+			return protoWireFields(data, func(number int, v uint64, _ []byte) error {
+				if number == 2 { // packed
+					p := v != 0
+					f.packed = &p
+				}
+				return nil
+			})
+		case 9: // oneof_index
+			oneofIndex = int(v)
+		case 17: // proto3_optional, oneof_index is a synthetic oneof
+			f.optional = v != 0
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if typ == descriptorTypeGroup {
+		return nil, fmt.Errorf("%s: groups are not supported", f.name)
+	}
+	if s, ok := descriptorFieldTypes[typ]; ok {
+		f.typ = s
+	} else {
+		f.typ = typeName
+	}
+	if f.optional {
+		oneofIndex = -1
+	}
+	*oneofs = append(*oneofs, oneofIndex)
+
+	return f, nil
+}
+
+func parseDescriptorMessage(b []byte) (*protoMessage, error) {
+	m := &protoMessage{}
+	var oneofNames []string
+	var fieldOneofs []int
+	err := protoWireFields(b, func(number int, _ uint64, data []byte) error {
+		switch number {
+		case 1: // name
+			m.name = string(data)
+		case 2: // field
+			f, err := parseDescriptorField(data, &fieldOneofs)
+			if err != nil {
+				return err
+			}
+			m.fields = append(m.fields, f)
+		case 3: // nested_type
+			nm, err := parseDescriptorMessage(data)
+			if err != nil {
+				return err
+			}
+			m.messages = append(m.messages, nm)
+		case 4: // enum_type
+			e, err := parseDescriptorEnum(data)
+			if err != nil {
+				return err
+			}
+			m.enums = append(m.enums, e)
+		case 7: // options MessageOptions
+			return protoWireFields(data, func(number int, v uint64, _ []byte) error {
+				if number == 7 { // map_entry
+					m.mapEntry = v != 0
+				}
+				return nil
+			})
+		case 8: // oneof_decl OneofDescriptorProto
+			var name string
+			if err := protoWireFields(data, func(number int, _ uint64, data []byte) error {
+				if number == 1 {
+					name = string(data)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			oneofNames = append(oneofNames, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, oi := range fieldOneofs {
+		if oi >= 0 && oi < len(oneofNames) {
+			m.fields[i].oneof = oneofNames[oi]
+		}
+	}
+
+	return m, nil
+}
+
+func parseDescriptorFile(b []byte) (*protoFile, error) {
+	f := &protoFile{}
+	err := protoWireFields(b, func(number int, _ uint64, data []byte) error {
+		switch number {
+		case 2: // package
+			f.pkg = string(data)
+		case 4: // message_type
+			m, err := parseDescriptorMessage(data)
+			if err != nil {
+				return err
+			}
+			f.messages = append(f.messages, m)
+		case 5: // enum_type
+			e, err := parseDescriptorEnum(data)
+			if err != nil {
+				return err
+			}
+			f.enums = append(f.enums, e)
+		case 12: // syntax
+			f.packed = string(data) == "proto3" || string(data) == "editions"
+			f.implicit = string(data) == "proto3"
+		}
+		return nil
+	})
+	return f, err
+}
+
+func parseDescriptorSet(b []byte) ([]*protoFile, error) {
+	var files []*protoFile
+	err := protoWireFields(b, func(number int, _ uint64, data []byte) error {
+		if number != 1 { // file
+			return nil
+		}
+		f, err := parseDescriptorFile(data)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no files found")
+	}
+	return files, nil
+}
@@ -0,0 +1,457 @@
+package protobuf
+
+// Parser for the parts of .proto files that are needed to decode messages
+// https://protobuf.dev/reference/protobuf/proto3-spec/
+// https://protobuf.dev/reference/protobuf/proto2-spec/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type protoToken struct {
+	s    string
+	line int
+}
+
+func protoTokenize(s string) ([]protoToken, error) {
+	var ts []protoToken
+	line := 1
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(s[i:i+2+end], "\n")
+			i += 2 + end + 2
+		case c == '"' || c == '\'':
+			j := i + 1
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\\' {
+					j++
+				} else if s[j] == '\n' {
+					break
+				}
+			}
+			if j >= len(s) || s[j] != c {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			ts = append(ts, protoToken{s: s[i : j+1], line: line})
+			i = j + 1
+		case c == '_' || c == '.' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)):
+			j := i
+			for j < len(s) && (s[j] == '_' || s[j] == '.' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			ts = append(ts, protoToken{s: s[i:j], line: line})
+			i = j
+		default:
+			ts = append(ts, protoToken{s: string(c), line: line})
+			i++
+		}
+	}
+	return ts, nil
+}
+
+type protoParser struct {
+	ts []protoToken
+	i  int
+}
+
+func (p *protoParser) peek() string {
+	if p.i >= len(p.ts) {
+		return ""
+	}
+	return p.ts[p.i].s
+}
+
+func (p *protoParser) next() string {
+	s := p.peek()
+	p.i++
+	return s
+}
+
+func (p *protoParser) errorf(format string, a ...any) error {
+	line := 0
+	if len(p.ts) > 0 {
+		line = p.ts[min(p.i, len(p.ts)-1)].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, a...))
+}
+
+func (p *protoParser) expect(s string) error {
+	if t := p.next(); t != s {
+		return p.errorf("expected %q got %q", s, t)
+	}
+	return nil
+}
+
+func (p *protoParser) ident() (string, error) {
+	t := p.next()
+	if t == "" || !(t[0] == '_' || t[0] == '.' || unicode.IsLetter(rune(t[0]))) {
+		return "", p.errorf("expected identifier got %q", t)
+	}
+	return t, nil
+}
+
+func (p *protoParser) str() (string, error) {
+	t := p.next()
+	if t == "" || (t[0] != '"' && t[0] != '\'') {
+		return "", p.errorf("expected string got %q", t)
+	}
+	if t[0] == '\'' {
+		t = `"` + strings.ReplaceAll(t[1:len(t)-1], `"`, `\"`) + `"`
+	}
+	s, err := strconv.Unquote(t)
+	if err != nil {
+		return "", p.errorf("invalid string %s", t)
+	}
+	return s, nil
+}
+
+func (p *protoParser) int() (int64, error) {
+	neg := false
+	if p.peek() == "-" {
+		neg = true
+		p.next()
+	}
+	t := p.next()
+	n, err := strconv.ParseInt(t, 0, 64)
+	if err != nil {
+		return 0, p.errorf("expected integer got %q", t)
+	}
+	if neg {
+		n = -n
+	}
+	return n, nil
+}
+
+// skip to and including ; or a {} block, whichever ends the statement
+func (p *protoParser) skipStatement() error {
+	depth := 0
+	for {
+		switch p.next() {
+		case "":
+			return p.errorf("unexpected end")
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		case ";":
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// [name = value, ...] returns packed option if found
+func (p *protoParser) fieldOptions() (*bool, error) {
+	var packed *bool
+	if p.peek() != "[" {
+		return nil, nil
+	}
+	p.next()
+	for {
+		var name strings.Builder
+		for p.peek() != "=" {
+			t := p.next()
+			if t == "" {
+				return nil, p.errorf("unexpected end")
+			}
+			name.WriteString(t)
+		}
+		p.next()
+		depth := 0
+		var value []string
+		for depth > 0 || (p.peek() != "," && p.peek() != "]") {
+			t := p.next()
+			switch t {
+			case "":
+				return nil, p.errorf("unexpected end")
+			case "{":
+				depth++
+			case "}":
+				depth--
+			}
+			value = append(value, t)
+		}
+		if name.String() == "packed" && len(value) == 1 {
+			b := value[0] == "true"
+			packed = &b
+		}
+		if p.next() == "]" {
+			return packed, nil
+		}
+	}
+}
+
+func (p *protoParser) enum() (*protoEnum, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	e := &protoEnum{name: name, values: map[uint64]string{}}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for {
+		switch t := p.peek(); t {
+		case "}":
+			p.next()
+			return e, nil
+		case ";":
+			p.next()
+		case "option", "reserved":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		default:
+			vn, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			n, err := p.int()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.fieldOptions(); err != nil {
+				return nil, err
+			}
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			// first name wins for aliases
+			if _, ok := e.values[uint64(n)]; !ok {
+				e.values[uint64(n)] = vn
+			}
+		}
+	}
+}
+
+// field after label, typ is already read
+func (p *protoParser) field(typ string, repeated bool, optional bool, oneof string) (*protoField, error) {
+	if typ == "group" {
+		return nil, p.errorf("groups are not supported")
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	n, err := p.int()
+	if err != nil {
+		return nil, err
+	}
+	packed, err := p.fieldOptions()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(";"); err != nil {
+		return nil, err
+	}
+	return &protoField{
+		name:     name,
+		number:   int(n),
+		repeated: repeated,
+		optional: optional,
+		typ:      typ,
+		oneof:    oneof,
+		packed:   packed,
+	}, nil
+}
+
+// map<K, V> name = N; is a repeated NameEntry message with key = 1 and value = 2
+func (p *protoParser) mapField(m *protoMessage) error {
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	kt, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(","); err != nil {
+		return err
+	}
+	vt, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(">"); err != nil {
+		return err
+	}
+	f, err := p.field("", true, false, "")
+	if err != nil {
+		return err
+	}
+
+	var entryName strings.Builder
+	for _, part := range strings.Split(f.name, "_") {
+		if part != "" {
+			entryName.WriteString(strings.ToUpper(part[0:1]) + part[1:])
+		}
+	}
+	entryName.WriteString("Entry")
+
+	f.typ = entryName.String()
+	m.fields = append(m.fields, f)
+	m.messages = append(m.messages, &protoMessage{
+		name: f.typ,
+		fields: []*protoField{
+			{name: "key", number: 1, typ: kt},
+			{name: "value", number: 2, typ: vt},
+		},
+		mapEntry: true,
+	})
+
+	return nil
+}
+
+func (p *protoParser) message() (*protoMessage, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	m := &protoMessage{name: name}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	oneof := ""
+	for {
+		switch t := p.next(); t {
+		case "":
+			return nil, p.errorf("unexpected end")
+		case "}":
+			if oneof != "" {
+				oneof = ""
+				continue
+			}
+			return m, nil
+		case ";":
+		case "message":
+			nm, err := p.message()
+			if err != nil {
+				return nil, err
+			}
+			m.messages = append(m.messages, nm)
+		case "enum":
+			e, err := p.enum()
+			if err != nil {
+				return nil, err
+			}
+			m.enums = append(m.enums, e)
+		case "oneof":
+			if oneof, err = p.ident(); err != nil {
+				return nil, err
+			}
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+		case "option", "reserved", "extensions", "extend":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case "map":
+			if p.peek() == "<" {
+				if err := p.mapField(m); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			fallthrough
+		default:
+			typ := t
+			switch t {
+			case "optional", "required", "repeated":
+				if typ, err = p.ident(); err != nil {
+					return nil, err
+				}
+			}
+			f, err := p.field(typ, t == "repeated", t == "optional", oneof)
+			if err != nil {
+				return nil, err
+			}
+			m.fields = append(m.fields, f)
+		}
+	}
+}
+
+func parseProto(s string) (*protoFile, error) {
+	ts, err := protoTokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &protoParser{ts: ts}
+	f := &protoFile{}
+
+	for p.peek() != "" {
+		switch t := p.next(); t {
+		case ";":
+		case "syntax", "edition":
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			v, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			f.packed = t == "edition" || v == "proto3"
+			f.implicit = t == "syntax" && v == "proto3"
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+		case "package":
+			if f.pkg, err = p.ident(); err != nil {
+				return nil, err
+			}
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+		case "import", "option", "service", "extend":
+			// imports are not resolved, well-known types are always available
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case "message":
+			m, err := p.message()
+			if err != nil {
+				return nil, err
+			}
+			f.messages = append(f.messages, m)
+		case "enum":
+			e, err := p.enum()
+			if err != nil {
+				return nil, err
+			}
+			f.enums = append(f.enums, e)
+		default:
+			return nil, p.errorf("unexpected %q", t)
+		}
+	}
+
+	return f, nil
+}
@@ -16,6 +16,7 @@ func init() {
 			ProbeOrder:  format.ProbeOrderBinFuzzy,
 			Description: "Audio Interchange File Format",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Offset: 8, Bytes: []byte(aiffRiffType)}},
 			DecodeFn:    aiffDecode,
 		})
 }
@@ -50,7 +50,8 @@ func init() {
 				{Groups: []*decode.Group{format.MP3_Frame}, Out: &aviMp3FrameGroup},
 				{Groups: []*decode.Group{format.FLAC_Frame}, Out: &aviFLACFrameGroup},
 			},
-			Groups: []*decode.Group{format.Probe},
+			Groups:     []*decode.Group{format.Probe},
+			Signatures: []decode.Signature{{Offset: 8, Bytes: []byte("AVI ")}},
 		})
 	interp.RegisterFS(aviFS)
 }
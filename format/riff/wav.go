@@ -24,6 +24,7 @@ func init() {
 			ProbeOrder:  format.ProbeOrderBinFuzzy, // after most others (overlap some with webp)
 			Description: "WAV file",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Offset: 8, Bytes: []byte(wavRiffType)}},
 			DecodeFn:    wavDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.ID3v2}, Out: &wavHeaderGroup},
@@ -20,6 +20,7 @@ func init() {
 		&decode.Format{
 			Description: "WebP image",
 			Groups:      []*decode.Group{format.Probe, format.Image},
+			Signatures:  []decode.Signature{{Offset: 8, Bytes: []byte(webpRiffType)}},
 			DecodeFn:    webpDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Exif}, Out: &exifGroup},
@@ -45,10 +45,16 @@ var ProtoBufTypeNames = scalar.UintMapSymStr{
 }
 
 type ProtoBufField struct {
-	Type    int
-	Name    string
-	Message ProtoBufMessage
-	Enums   map[uint64]string
+	Type     int
+	Name     string
+	Message  ProtoBufMessage
+	Enums    map[uint64]string
+	TypeName string // full message or enum type name, ex: google.protobuf.Timestamp
+	Repeated bool
+	Packed   bool   // encode repeated scalars as packed
+	Map      bool   // repeated key/value entry message
+	Oneof    string // name of oneof field is part of
+	Implicit bool   // proto3 field without presence, zero value is not encoded
 }
 
 type ProtoBufMessage map[int]ProtoBufField
@@ -21,6 +21,7 @@ func init() {
 		&decode.Format{
 			Description: "Tar archive",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Offset: 257, Bytes: []byte("ustar")}},
 			DecodeFn:    tarDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
@@ -17,7 +17,11 @@ func init() {
 		&decode.Format{
 			Description: "Tag Image File Format",
 			Groups:      []*decode.Group{format.Probe, format.Image},
-			DecodeFn:    tiffDecode,
+			Signatures: []decode.Signature{
+				{Bytes: []byte("II*\x00")},
+				{Bytes: []byte("MM\x00*")},
+			},
+			DecodeFn: tiffDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.ICC_Profile}, Out: &tiffIccProfile},
 			},
@@ -20,6 +20,7 @@ func init() {
 			Description: "Time Zone Information Format",
 			DecodeFn:    decodeTZIF,
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("TZif")}},
 		})
 	interp.RegisterFS(tzifFS)
 }
@@ -24,6 +24,7 @@ func init() {
 		&decode.Format{
 			Description: "TZX tape format for ZX Spectrum computers",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("ZXTape!\x1a")}},
 			DecodeFn:    tzxDecode,
 			Dependencies: []decode.Dependency{
 				{Groups: []*decode.Group{format.TAP}, Out: &tapFormat},
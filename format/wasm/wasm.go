@@ -23,6 +23,7 @@ func init() {
 			Description: "WebAssembly Binary Format",
 			DecodeFn:    decodeWASM,
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte("\x00asm")}},
 		})
 	interp.RegisterFS(wasmFS)
 }
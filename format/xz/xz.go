@@ -0,0 +1,504 @@
+package xz
+
+// https://tukaani.org/xz/xz-file-format.txt
+// https://github.com/tukaani-project/xz/blob/master/doc/lzma-file-format.txt
+// TODO: decompress blocks with other filters than LZMA2 (delta, BCJ)
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+
+	"github.com/ulikunitz/xz/lzma"
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+var probeGroup decode.Group
+
+func init() {
+	interp.RegisterFormat(
+		format.XZ,
+		&decode.Format{
+			Description: "xz compression",
+			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: headerMagic}},
+			DecodeFn:    xzDecode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
+			},
+			SkipFromFunction: true, // from_xz is defined in compress and returns uncompressed binary
+		})
+}
+
+var headerMagic = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+var footerMagic = []byte{'Y', 'Z'}
+
+const (
+	checkNone   = 0x0
+	checkCRC32  = 0x1
+	checkCRC64  = 0x4
+	checkSHA256 = 0xa
+)
+
+var checkTypeNames = scalar.UintMapSymStr{
+	checkNone:   "none",
+	checkCRC32:  "crc32",
+	checkCRC64:  "crc64",
+	checkSHA256: "sha256",
+}
+
+// size in bytes of check field, also defined for reserved check types
+func checkSize(checkType uint64) int64 {
+	if checkType == 0 {
+		return 0
+	}
+	return 4 << ((checkType - 1) / 3)
+}
+
+const (
+	filterDelta    = 0x03
+	filterX86      = 0x04
+	filterPowerPC  = 0x05
+	filterIA64     = 0x06
+	filterARM      = 0x07
+	filterARMThumb = 0x08
+	filterSPARC    = 0x09
+	filterARM64    = 0x0a
+	filterRISCV    = 0x0b
+	filterLZMA2    = 0x21
+)
+
+var filterIDNames = scalar.UintMapSymStr{
+	filterDelta:    "delta",
+	filterX86:      "x86",
+	filterPowerPC:  "powerpc",
+	filterIA64:     "ia64",
+	filterARM:      "arm",
+	filterARMThumb: "armthumb",
+	filterSPARC:    "sparc",
+	filterARM64:    "arm64",
+	filterRISCV:    "riscv",
+	filterLZMA2:    "lzma2",
+}
+
+const (
+	lzma2ControlEnd                          = 0x00
+	lzma2ControlUncompressedResetDictionary  = 0x01
+	lzma2ControlUncompressedNoResetDictinary = 0x02
+)
+
+var lzma2ControlNames = scalar.UintRangeToScalar{
+	{Range: [2]uint64{0x00, 0x00}, S: scalar.Uint{Sym: "end"}},
+	{Range: [2]uint64{0x01, 0x01}, S: scalar.Uint{Sym: "uncompressed_reset_dictionary"}},
+	{Range: [2]uint64{0x02, 0x02}, S: scalar.Uint{Sym: "uncompressed"}},
+	{Range: [2]uint64{0x03, 0x7f}, S: scalar.Uint{Sym: "invalid"}},
+	{Range: [2]uint64{0x80, 0xff}, S: scalar.Uint{Sym: "lzma"}},
+}
+
+var lzma2ResetNames = scalar.UintMapSymStr{
+	0: "none",
+	1: "state",
+	2: "state_properties",
+	3: "state_properties_dictionary",
+}
+
+// https://github.com/tukaani-project/xz/blob/master/src/liblzma/lzma/lzma2_decoder.c
+func lzma2DictionarySize(p uint64) uint64 {
+	if p == 40 {
+		return 0xffff_ffff
+	}
+	return (2 | (p & 1)) << (p/2 + 11)
+}
+
+func crc32Range(d *decode.D, start int64, stop int64) []byte {
+	h := crc32.NewIEEE()
+	d.CopyBits(h, d.BitBufRange(start, stop-start))
+	return h.Sum(nil)
+}
+
+func xzFieldPadding(d *decode.D, name string, start int64) {
+	if n := (4 - ((d.Pos()-start)/8)%4) % 4; n > 0 {
+		d.FieldRawLen(name, n*8, d.BitBufIsZero())
+	}
+}
+
+// returns total uncompressed size of chunks
+func xzDecodeLZMA2Chunks(d *decode.D) uint64 {
+	var uncompressedSize uint64
+	d.FieldArray("chunks", func(d *decode.D) {
+		for {
+			var control uint64
+			d.FieldStruct("chunk", func(d *decode.D) {
+				control = d.FieldU8("control", lzma2ControlNames, scalar.UintHex)
+				switch {
+				case control == lzma2ControlEnd:
+				case control == lzma2ControlUncompressedResetDictionary,
+					control == lzma2ControlUncompressedNoResetDictinary:
+					size := d.FieldU16BE("size", scalar.UintActualAdd(1))
+					uncompressedSize += size
+					d.FieldRawLen("data", int64(size)*8)
+				case control >= 0x80:
+					reset := (control >> 5) & 0b11
+					d.FieldValueUint("reset", reset, lzma2ResetNames)
+					uncompressedSize += d.FieldU16BE("uncompressed_size", scalar.UintActualFn(func(a uint64) uint64 {
+						return ((control&0b1_1111)<<16 | a) + 1
+					}))
+					compressedSize := d.FieldU16BE("compressed_size", scalar.UintActualAdd(1))
+					if reset >= 2 {
+						d.FieldStruct("properties", func(d *decode.D) {
+							p := d.FieldU8("value")
+							d.FieldValueUint("lc", p%9)
+							d.FieldValueUint("lp", (p/9)%5)
+							d.FieldValueUint("pb", p/45)
+						})
+					}
+					d.FieldRawLen("data", int64(compressedSize)*8)
+				default:
+					d.Fatalf("invalid LZMA2 control byte %d", control)
+				}
+			})
+			if control == lzma2ControlEnd {
+				break
+			}
+		}
+	})
+	return uncompressedSize
+}
+
+type xzBlock struct {
+	dictionarySize uint64
+	compressedSize int64 // -1 if not present in header
+	lzma2Last      bool  // chunks can be decoded
+	decompress     bool  // only LZMA2 filter, no other filters
+}
+
+// xzBlockRange is range in bytes of LZMA2 chunks relative to start of input
+type xzBlockRange struct {
+	r       ranges.Range
+	dictCap int
+}
+
+func newLZMA2Reader(r io.Reader, dictCap int) (io.Reader, error) {
+	return lzma.Reader2Config{DictCap: dictCap}.NewReader2(r)
+}
+
+// blocksReader reads uncompressed data of blocks from r, blocks are in order
+// and r is positioned at start of input
+type blocksReader struct {
+	r      io.Reader
+	blocks []xzBlockRange
+	pos    int64
+	lr     *io.LimitedReader
+	zr     io.Reader
+}
+
+func (br *blocksReader) Read(p []byte) (int, error) {
+	for {
+		if br.zr == nil {
+			if len(br.blocks) == 0 {
+				return 0, io.EOF
+			}
+			b := br.blocks[0]
+			br.blocks = br.blocks[1:]
+			if _, err := io.CopyN(io.Discard, br.r, b.r.Start-br.pos); err != nil {
+				return 0, err
+			}
+			br.pos = b.r.Stop()
+			br.lr = &io.LimitedReader{R: br.r, N: b.r.Len}
+			zr, err := newLZMA2Reader(br.lr, b.dictCap)
+			if err != nil {
+				return 0, err
+			}
+			br.zr = zr
+		}
+
+		n, err := br.zr.Read(p)
+		if errors.Is(err, io.EOF) {
+			// skip to end of block in case the reader did not read all of it
+			if _, err := io.Copy(io.Discard, br.lr); err != nil {
+				return n, err
+			}
+			br.zr = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func xzDecodeBlockHeader(d *decode.D) xzBlock {
+	var b xzBlock
+
+	b.compressedSize = -1
+
+	headerStart := d.Pos()
+	headerSize := d.FieldU8("size", scalar.UintActualFn(func(a uint64) uint64 { return (a + 1) * 4 }))
+	var numFilters uint64
+	var hasCompressedSize bool
+	var hasUncompressedSize bool
+	d.FieldStruct("flags", func(d *decode.D) {
+		hasUncompressedSize = d.FieldBool("uncompressed_size_present")
+		hasCompressedSize = d.FieldBool("compressed_size_present")
+		d.FieldU4("reserved")
+		numFilters = d.FieldU2("number_of_filters", scalar.UintActualAdd(1))
+	})
+	if hasCompressedSize {
+		b.compressedSize = int64(d.FieldULEB128("compressed_size"))
+	}
+	if hasUncompressedSize {
+		d.FieldULEB128("uncompressed_size")
+	}
+	var filterIDs []uint64
+	d.FieldArray("filters", func(d *decode.D) {
+		for i := uint64(0); i < numFilters; i++ {
+			d.FieldStruct("filter", func(d *decode.D) {
+				id := d.FieldULEB128("id", filterIDNames, scalar.UintHex)
+				propertiesSize := d.FieldULEB128("properties_size")
+				filterIDs = append(filterIDs, id)
+				switch {
+				case id == filterLZMA2 && propertiesSize == 1:
+					d.FieldStruct("properties", func(d *decode.D) {
+						d.FieldU2("reserved")
+						p := d.FieldU6("dictionary_size_bits")
+						d.FieldValueUint("dictionary_size", lzma2DictionarySize(p))
+						b.dictionarySize = lzma2DictionarySize(p)
+					})
+				default:
+					d.FieldRawLen("properties", int64(propertiesSize)*8)
+				}
+			})
+		}
+	})
+	b.lzma2Last = len(filterIDs) > 0 && filterIDs[len(filterIDs)-1] == filterLZMA2
+	b.decompress = len(filterIDs) == 1 && filterIDs[0] == filterLZMA2
+
+	paddingLen := headerStart + int64(headerSize-4)*8 - d.Pos()
+	if paddingLen < 0 {
+		d.Fatalf("block header size %d too small", headerSize)
+	}
+	if paddingLen > 0 {
+		d.FieldRawLen("padding", paddingLen, d.BitBufIsZero())
+	}
+	d.FieldChecksumU("crc32", 32, "crc32", crc32Range(d, headerStart, d.Pos()), scalar.UintHex)
+
+	return b
+}
+
+// r is nil if block could not be decompressed
+func xzFieldCheck(d *decode.D, checkType uint64, r io.Reader) {
+	var h hash.Hash
+	switch checkType {
+	case checkNone:
+		return
+	case checkCRC32:
+		h = crc32.NewIEEE()
+	case checkCRC64:
+		h = crc64.New(crc64.MakeTable(crc64.ECMA))
+	case checkSHA256:
+		h = sha256.New()
+	}
+
+	if h == nil || r == nil {
+		d.FieldRawLen("check", checkSize(checkType)*8, scalar.RawHex)
+		return
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		d.FieldRawLen("check", checkSize(checkType)*8, scalar.RawHex)
+		return
+	}
+
+	algorithm := checkTypeNames[checkType]
+	switch h.(type) {
+	case hash.Hash32:
+		d.FieldChecksumU("check", 32, algorithm, h.Sum(nil), scalar.UintHex)
+	case hash.Hash64:
+		d.FieldChecksumU("check", 64, algorithm, h.Sum(nil), scalar.UintHex)
+	default:
+		d.FieldChecksumRawLen("check", checkSize(checkType)*8, algorithm, h.Sum(nil), scalar.RawHex)
+	}
+}
+
+// returns nil if block can't be decompressed
+func xzDecodeBlock(d *decode.D, inputStart int64, streamStart int64, checkType uint64) *xzBlockRange {
+	var b xzBlock
+	d.FieldStruct("header", func(d *decode.D) {
+		b = xzDecodeBlockHeader(d)
+	})
+
+	if !b.lzma2Last {
+		// unknown filters, rest of stream is compressed data if size is unknown
+		compressedLen := d.BitsLeft()
+		if b.compressedSize != -1 {
+			compressedLen = b.compressedSize * 8
+		}
+		d.FieldRawLen("compressed", compressedLen)
+		if d.BitsLeft() > 0 {
+			xzFieldPadding(d, "padding", streamStart)
+			xzFieldCheck(d, checkType, nil)
+		}
+		return nil
+	}
+
+	compressedStart := d.Pos()
+	uncompressedSize := xzDecodeLZMA2Chunks(d)
+	compressedStop := d.Pos()
+
+	var block *xzBlockRange
+	var r io.Reader
+	if b.decompress {
+		// no need for a dictionary larger than the uncompressed data
+		block = &xzBlockRange{
+			r:       ranges.Range{Start: (compressedStart - inputStart) / 8, Len: (compressedStop - compressedStart) / 8},
+			dictCap: int(max(min(b.dictionarySize, uncompressedSize), lzma.MinDictCap)),
+		}
+		r = &blocksReader{
+			r:      bitio.NewIOReadSeeker(d.BitBufRange(compressedStart, compressedStop-compressedStart)),
+			blocks: []xzBlockRange{{r: ranges.Range{Len: block.r.Len}, dictCap: block.dictCap}},
+		}
+	}
+
+	xzFieldPadding(d, "padding", streamStart)
+	xzFieldCheck(d, checkType, r)
+
+	return block
+}
+
+func xzDecodeStreamFlags(d *decode.D) uint64 {
+	var checkType uint64
+	d.FieldStruct("flags", func(d *decode.D) {
+		d.FieldU8("reserved0")
+		d.FieldU4("reserved1")
+		checkType = d.FieldU4("check_type", checkTypeNames)
+	})
+	return checkType
+}
+
+// returns nil if not all blocks can be decompressed
+func xzDecodeStream(d *decode.D, inputStart int64) []xzBlockRange {
+	streamStart := d.Pos()
+
+	var checkType uint64
+	d.FieldStruct("header", func(d *decode.D) {
+		d.FieldRawLen("magic", int64(len(headerMagic))*8, d.AssertBitBuf(headerMagic))
+		flagsStart := d.Pos()
+		checkType = xzDecodeStreamFlags(d)
+		d.FieldChecksumU("crc32", 32, "crc32", crc32Range(d, flagsStart, d.Pos()), scalar.UintHex)
+	})
+
+	var blocks []xzBlockRange
+	decompressedAll := true
+	d.FieldArray("blocks", func(d *decode.D) {
+		// index indicator is zero, block header size is never zero
+		for d.BitsLeft() > 0 && d.PeekUintBits(8) != 0 {
+			d.FieldStruct("block", func(d *decode.D) {
+				b := xzDecodeBlock(d, inputStart, streamStart, checkType)
+				if b == nil {
+					decompressedAll = false
+					return
+				}
+				blocks = append(blocks, *b)
+			})
+		}
+	})
+	// block with unknown filters and size
+	if d.BitsLeft() == 0 {
+		return nil
+	}
+
+	d.FieldStruct("index", func(d *decode.D) {
+		indexStart := d.Pos()
+		d.FieldU8("indicator", d.UintAssert(0))
+		numRecords := d.FieldULEB128("number_of_records")
+		d.FieldArray("records", func(d *decode.D) {
+			for i := uint64(0); i < numRecords; i++ {
+				d.FieldStruct("record", func(d *decode.D) {
+					d.FieldULEB128("unpadded_size")
+					d.FieldULEB128("uncompressed_size")
+				})
+			}
+		})
+		xzFieldPadding(d, "padding", indexStart)
+		d.FieldChecksumU("crc32", 32, "crc32", crc32Range(d, indexStart, d.Pos()), scalar.UintHex)
+	})
+
+	d.FieldStruct("footer", func(d *decode.D) {
+		crcStart := d.Pos() + 32
+		crcStop := crcStart + 6*8
+		d.FieldChecksumU("crc32", 32, "crc32", crc32Range(d, crcStart, crcStop), scalar.UintHex)
+		d.FieldU32("backward_size", scalar.UintActualFn(func(a uint64) uint64 { return (a + 1) * 4 }))
+		xzDecodeStreamFlags(d)
+		d.FieldRawLen("magic", int64(len(footerMagic))*8, d.AssertBitBuf(footerMagic))
+	})
+
+	if !decompressedAll {
+		return nil
+	}
+
+	return blocks
+}
+
+func xzDecode(d *decode.D) any {
+	d.Endian = decode.LittleEndian
+
+	start := d.Pos()
+	streams := 0
+	decompressedAll := true
+	var blocks []xzBlockRange
+	d.FieldArray("streams", func(d *decode.D) {
+		for d.BitsLeft() >= int64(len(headerMagic))*8 {
+			if !bytes.Equal(d.PeekBytes(len(headerMagic)), headerMagic) {
+				if streams == 0 {
+					d.Fatalf("invalid stream magic")
+				}
+				break
+			}
+
+			d.FieldStruct("stream", func(d *decode.D) {
+				sblocks := xzDecodeStream(d, start)
+				if sblocks == nil {
+					decompressedAll = false
+				}
+				blocks = append(blocks, sblocks...)
+			})
+			streams++
+
+			// stream padding is multiple of four null bytes
+			var paddingLen int64
+			d.SeekRel(0, func(d *decode.D) {
+				for d.BitsLeft() >= 32 && d.U32() == 0 {
+					paddingLen += 32
+				}
+			})
+			if paddingLen > 0 {
+				d.FieldRawLen("stream_padding", paddingLen, d.BitBufIsZero())
+			}
+		}
+	})
+
+	if streams == 0 {
+		d.Fatalf("no streams found")
+	}
+	if !decompressedAll || len(blocks) == 0 {
+		return nil
+	}
+
+	_, uncompressedBR, dv, _, _ := d.TryFieldReaderRangeFormat("uncompressed", start, d.Pos()-start, func(r io.Reader) io.Reader {
+		return &blocksReader{r: r, blocks: blocks}
+	}, &probeGroup, format.Probe_In{})
+	if dv == nil && uncompressedBR != nil {
+		d.FieldRootBitBuf("uncompressed", uncompressedBR)
+	}
+
+	return nil
+}
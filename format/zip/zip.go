@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"embed"
+	"hash/crc32"
 	"io"
 	"time"
 
@@ -27,6 +28,7 @@ func init() {
 		&decode.Format{
 			Description: "ZIP archive",
 			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: localFileSignature}},
 			DecodeFn:    zipDecode,
 			DefaultInArg: format.Zip_In{
 				Uncompress: true,
@@ -412,6 +414,7 @@ func zipDecode(d *decode.D) any {
 			d.SeekAbs(int64(o) * 8)
 			d.FieldStruct("local_file", func(d *decode.D) {
 				var hasDataDescriptor bool
+				var encrypted bool
 				d.FieldRawLen("signature", 4*8, d.AssertBitBuf(localFileSignature))
 				d.FieldU16("version_needed")
 				d.FieldStruct("flags", func(d *decode.D) {
@@ -423,7 +426,7 @@ func zipDecode(d *decode.D) any {
 					hasDataDescriptor = d.FieldBool("data_descriptor")
 					d.FieldBool("compression0")
 					d.FieldBool("compression1")
-					d.FieldBool("encrypted")
+					encrypted = d.FieldBool("encrypted")
 
 					d.FieldU2("reserved0")
 					d.FieldBool("mask_header_values")
@@ -456,8 +459,16 @@ func zipDecode(d *decode.D) any {
 					compressedLimit = d.BitsLeft()
 				}
 
+				// crc32 of uncompressed data, nil if not known
+				var uncompressedCRC []byte
+
 				if compressionMethod == compressionMethodNone {
 					d.FieldFormatOrRawLen("uncompressed", compressedSize, &probeGroup, format.Probe_In{})
+					if !encrypted && (compressedSize != 0 || !hasDataDescriptor) {
+						crc32W := crc32.NewIEEE()
+						d.CopyBits(crc32W, d.BitBufRange(compressedStart, compressedSize))
+						uncompressedCRC = crc32W.Sum(nil)
+					}
 				} else {
 					var rFn func(r io.Reader) io.Reader
 					if zi.Uncompress {
@@ -475,6 +486,11 @@ func zipDecode(d *decode.D) any {
 						if dv == nil && uncompressedBR != nil {
 							d.FieldRootBitBuf("uncompressed", uncompressedBR)
 						}
+						if uncompressedBR != nil && !encrypted {
+							crc32W := crc32.NewIEEE()
+							d.CopyBits(crc32W, d.CloneReadSeeker(uncompressedBR))
+							uncompressedCRC = crc32W.Sum(nil)
+						}
 						if compressedSize == 0 {
 							compressedSize = readCompressedSize
 						}
@@ -495,9 +511,14 @@ func zipDecode(d *decode.D) any {
 							d.FieldRawLen("signature", 4*8, d.AssertBitBuf(dataIndicatorSignature))
 						}
 						d.FieldU32("crc32_uncompressed", scalar.UintHex)
+						if uncompressedCRC != nil {
+							d.ValidateChecksum(d.FieldMustGet("crc32_uncompressed"), "crc32", uncompressedCRC)
+						}
 						d.FieldU32("compressed_size")
 						d.FieldU32("uncompressed_size")
 					})
+				} else if uncompressedCRC != nil {
+					d.ValidateChecksum(d.FieldMustGet("crc32_uncompressed"), "crc32", uncompressedCRC)
 				}
 			})
 		}
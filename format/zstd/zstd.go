@@ -0,0 +1,224 @@
+package zstd
+
+// https://www.rfc-editor.org/rfc/rfc8878
+// TODO: decode literals and sequences sections of compressed blocks
+// TODO: dictionaries
+
+import (
+	"encoding/binary"
+	"io"
+
+	zstdlib "github.com/klauspost/compress/zstd"
+	"github.com/wader/fq/format"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/checksum"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/interp"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+var probeGroup decode.Group
+
+func init() {
+	interp.RegisterFormat(
+		format.Zstd,
+		&decode.Format{
+			Description: "Zstandard compression",
+			Groups:      []*decode.Group{format.Probe},
+			Signatures:  []decode.Signature{{Bytes: []byte{0x28, 0xb5, 0x2f, 0xfd}}},
+			DecodeFn:    zstdDecode,
+			Dependencies: []decode.Dependency{
+				{Groups: []*decode.Group{format.Probe}, Out: &probeGroup},
+			},
+			SkipFromFunction: true, // from_zstd is defined in compress and returns uncompressed binary
+		})
+}
+
+const frameMagic = 0xfd2f_b528
+const skippableFrameMagicFirst = 0x184d_2a50
+const skippableFrameMagicLast = 0x184d_2a5f
+
+const (
+	blockTypeRaw        = 0
+	blockTypeRLE        = 1
+	blockTypeCompressed = 2
+	blockTypeReserved   = 3
+)
+
+var blockTypeNames = scalar.UintMapSymStr{
+	blockTypeRaw:        "raw",
+	blockTypeRLE:        "rle",
+	blockTypeCompressed: "compressed",
+	blockTypeReserved:   "reserved",
+}
+
+func isSkippableFrameMagic(magic uint64) bool {
+	return magic >= skippableFrameMagicFirst && magic <= skippableFrameMagicLast
+}
+
+func zstdDecodeSkippableFrame(d *decode.D) {
+	d.FieldU32("magic", d.UintAssertRange(skippableFrameMagicFirst, skippableFrameMagicLast), scalar.UintHex)
+	size := d.FieldU32("size")
+	d.FieldRawLen("data", int64(size)*8)
+}
+
+// returns false if frame can't be decompressed
+func zstdDecodeFrame(d *decode.D, dec *zstdlib.Decoder) bool {
+	frameStart := d.Pos()
+
+	d.FieldU32("magic", d.UintAssert(frameMagic), scalar.UintHex)
+	var contentSizeFlag uint64
+	var singleSegment bool
+	var hasContentChecksum bool
+	var dictionaryIDFlag uint64
+	d.FieldStruct("header_descriptor", func(d *decode.D) {
+		contentSizeFlag = d.FieldU2("frame_content_size_flag")
+		singleSegment = d.FieldBool("single_segment")
+		d.FieldU1("unused")
+		d.FieldU1("reserved")
+		hasContentChecksum = d.FieldBool("content_checksum")
+		dictionaryIDFlag = d.FieldU2("dictionary_id_flag")
+	})
+	if !singleSegment {
+		d.FieldStruct("window_descriptor", func(d *decode.D) {
+			exponent := d.FieldU5("exponent")
+			mantissa := d.FieldU3("mantissa")
+			windowBase := uint64(1) << (10 + exponent)
+			d.FieldValueUint("window_size", windowBase+(windowBase/8)*mantissa)
+		})
+	}
+	switch dictionaryIDFlag {
+	case 1:
+		d.FieldU8("dictionary_id")
+	case 2:
+		d.FieldU16("dictionary_id")
+	case 3:
+		d.FieldU32("dictionary_id")
+	}
+	switch contentSizeFlag {
+	case 0:
+		if singleSegment {
+			d.FieldU8("frame_content_size")
+		}
+	case 1:
+		d.FieldU16("frame_content_size", scalar.UintActualAdd(256))
+	case 2:
+		d.FieldU32("frame_content_size")
+	case 3:
+		d.FieldU64("frame_content_size")
+	}
+
+	d.FieldArray("blocks", func(d *decode.D) {
+		lastBlock := false
+		for !lastBlock {
+			d.FieldStruct("block", func(d *decode.D) {
+				header := d.FieldU24("header", scalar.UintHex)
+				lastBlock = header&0b1 == 1
+				blockType := (header >> 1) & 0b11
+				blockSize := header >> 3
+				d.FieldValueBool("last_block", lastBlock)
+				d.FieldValueUint("block_type", blockType, blockTypeNames)
+				d.FieldValueUint("block_size", blockSize)
+
+				switch blockType {
+				case blockTypeRaw, blockTypeCompressed:
+					d.FieldRawLen("data", int64(blockSize)*8)
+				case blockTypeRLE:
+					d.FieldU8("byte", scalar.UintHex)
+				default:
+					d.Fatalf("reserved block type")
+				}
+			})
+		}
+	})
+
+	// dictionaries are not supported
+	canDecompress := dictionaryIDFlag == 0
+
+	if hasContentChecksum {
+		var sum []byte
+		if canDecompress {
+			// hash content by streaming the frame including the checksum
+			xxh64W := checksum.NewXXH64(0)
+			frameBR, err := d.TryBitBufRange(frameStart, d.Pos()+32-frameStart)
+			if err == nil {
+				err = dec.Reset(bitio.NewIOReadSeeker(frameBR))
+			}
+			if err == nil {
+				_, err = io.Copy(xxh64W, dec)
+			}
+			if err == nil {
+				sum = binary.BigEndian.AppendUint32(nil, uint32(xxh64W.Sum64()))
+			} else {
+				canDecompress = false
+			}
+		}
+		if sum != nil {
+			d.FieldChecksumU("content_checksum", 32, "xxh64", sum, scalar.UintHex)
+		} else {
+			d.FieldU32("content_checksum", scalar.UintHex)
+		}
+	}
+
+	return canDecompress
+}
+
+func zstdDecode(d *decode.D) any {
+	d.Endian = decode.LittleEndian
+
+	dec, err := zstdlib.NewReader(nil,
+		zstdlib.WithDecoderConcurrency(1),
+		// content checksum is validated when decoding the field instead
+		zstdlib.IgnoreChecksum(true),
+	)
+	if err != nil {
+		d.Fatalf("failed to create decoder: %s", err)
+	}
+	defer dec.Close()
+
+	start := d.Pos()
+	frames := 0
+	canDecompress := true
+	d.FieldArray("frames", func(d *decode.D) {
+		for d.BitsLeft() >= 32 {
+			magic := bitio.ReverseBytes64(32, d.PeekUintBits(32))
+			switch {
+			case magic == frameMagic:
+				d.FieldStruct("frame", func(d *decode.D) {
+					if !zstdDecodeFrame(d, dec) {
+						canDecompress = false
+					}
+				})
+				frames++
+			case isSkippableFrameMagic(magic):
+				d.FieldStruct("frame", zstdDecodeSkippableFrame)
+			default:
+				if frames == 0 {
+					d.Fatalf("invalid frame magic")
+				}
+				return
+			}
+		}
+	})
+
+	// skippable frames only is not enough, magic is shared between lz4 and zstd
+	if frames == 0 {
+		d.Fatalf("no frames found")
+	}
+	if !canDecompress {
+		return nil
+	}
+
+	// decoder handles multiple and skippable frames
+	_, uncompressedBR, dv, _, _ := d.TryFieldReaderRangeFormat("uncompressed", start, d.Pos()-start, func(r io.Reader) io.Reader {
+		if err := dec.Reset(r); err != nil {
+			d.IOPanic(err, "uncompressed", "Reset")
+		}
+		return dec
+	}, &probeGroup, format.Probe_In{})
+	if dv == nil && uncompressedBR != nil {
+		d.FieldRootBitBuf("uncompressed", uncompressedBR)
+	}
+
+	return nil
+}
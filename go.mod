@@ -13,6 +13,11 @@ require (
 	// bump: gomod-BurntSushi/toml link "Source diff $CURRENT..$LATEST" https://github.com/BurntSushi/toml/compare/v$CURRENT..v$LATEST
 	github.com/BurntSushi/toml v1.4.0
 
+	// bump: gomod-andybalholm-brotli /github\.com\/andybalholm\/brotli v(.*)/ https://github.com/andybalholm/brotli.git|^1
+	// bump: gomod-andybalholm-brotli command go get github.com/andybalholm/brotli@v$LATEST && go mod tidy
+	// bump: gomod-andybalholm-brotli link "Source diff $CURRENT..$LATEST" https://github.com/andybalholm/brotli/compare/v$CURRENT..v$LATEST
+	github.com/andybalholm/brotli v1.1.1
+
 	// bump: gomod-creasty-defaults /github\.com\/creasty\/defaults v(.*)/ https://github.com/creasty/defaults.git|^1
 	// bump: gomod-creasty-defaults command go get github.com/creasty/defaults@v$LATEST && go mod tidy
 	// bump: gomod-creasty-defaults link "Source diff $CURRENT..$LATEST" https://github.com/creasty/defaults/compare/v$CURRENT..v$LATEST
@@ -37,6 +42,11 @@ require (
 	// bump: gomod-gopacket link "Release notes" https://github.com/gopacket/gopacket/releases/tag/v$LATEST
 	github.com/gopacket/gopacket v1.3.1
 
+	// bump: gomod-klauspost-compress /github\.com\/klauspost\/compress v(.*)/ https://github.com/klauspost/compress.git|^1
+	// bump: gomod-klauspost-compress command go get github.com/klauspost/compress@v$LATEST && go mod tidy
+	// bump: gomod-klauspost-compress link "Release notes" https://github.com/klauspost/compress/releases/tag/v$LATEST
+	github.com/klauspost/compress v1.17.11
+
 	// bump: gomod-copystructure /github\.com\/mitchellh\/copystructure v(.*)/ https://github.com/mitchellh/copystructure.git|^1
 	// bump: gomod-copystructure command go get github.com/mitchellh/copystructure@v$LATEST && go mod tidy
 	// bump: gomod-copystructure link "CHANGELOG" https://github.com/mitchellh/copystructure/blob/master/CHANGELOG.md
@@ -47,6 +57,21 @@ require (
 	// bump: gomod-mapstructure link "CHANGELOG" https://github.com/mitchellh/mapstructure/blob/master/CHANGELOG.md
 	github.com/mitchellh/mapstructure v1.5.0
 
+	// bump: gomod-pierrec-lz4 /github\.com\/pierrec\/lz4\/v4 v(.*)/ https://github.com/pierrec/lz4.git|^4
+	// bump: gomod-pierrec-lz4 command go get github.com/pierrec/lz4/v4@v$LATEST && go mod tidy
+	// bump: gomod-pierrec-lz4 link "Release notes" https://github.com/pierrec/lz4/releases/tag/v$LATEST
+	github.com/pierrec/lz4/v4 v4.1.21
+
+	// bump: gomod-ulikunitz-xz /github\.com\/ulikunitz\/xz v(.*)/ https://github.com/ulikunitz/xz.git|^0
+	// bump: gomod-ulikunitz-xz command go get github.com/ulikunitz/xz@v$LATEST && go mod tidy
+	// bump: gomod-ulikunitz-xz link "Source diff $CURRENT..$LATEST" https://github.com/ulikunitz/xz/compare/v$CURRENT..v$LATEST
+	github.com/ulikunitz/xz v0.5.12
+
+	// bump: gomod-zeebo-xxh3 /github\.com\/zeebo\/xxh3 v(.*)/ https://github.com/zeebo/xxh3.git|^1
+	// bump: gomod-zeebo-xxh3 command go get github.com/zeebo/xxh3@v$LATEST && go mod tidy
+	// bump: gomod-zeebo-xxh3 link "Source diff $CURRENT..$LATEST" https://github.com/zeebo/xxh3/compare/v$CURRENT..v$LATEST
+	github.com/zeebo/xxh3 v1.1.0
+
 	// bump: gomod-golang-x-crypto /golang\.org\/x\/crypto v(.*)/ https://github.com/golang/crypto.git|^0
 	// bump: gomod-golang-x-crypto command go get golang.org/x/crypto@v$LATEST && go mod tidy
 	// bump: gomod-golang-x-crypto link "Tags" https://github.com/golang/crypto/tags
@@ -71,10 +96,16 @@ require (
 	// bump: gomod-gopkg.in/yaml.v3 command go get gopkg.in/yaml.v3@v$LATEST && go mod tidy
 	// bump: gomod-gopkg.in/yaml.v3 link "Source diff $CURRENT..$LATEST" https://github.com/go-yaml/yaml/compare/v$CURRENT..v$LATEST
 	gopkg.in/yaml.v3 v3.0.1
+
+	// bump: gomod-lukechampine-blake3 /lukechampine\.com\/blake3 v(.*)/ https://github.com/lukechampine/blake3.git|^1
+	// bump: gomod-lukechampine-blake3 command go get lukechampine.com/blake3@v$LATEST && go mod tidy
+	// bump: gomod-lukechampine-blake3 link "Source diff $CURRENT..$LATEST" https://github.com/lukechampine/blake3/compare/v$CURRENT..v$LATEST
+	lukechampine.com/blake3 v1.4.1
 )
 
 require (
 	github.com/itchyny/timefmt-go v0.1.6 // indirect
+	github.com/klauspost/cpuid/v2 v2.2.10 // indirect
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	golang.org/x/sys v0.30.0 // indirect
@@ -2,6 +2,7 @@ package ansi
 
 import (
 	"fmt"
+	"html"
 	"io"
 	"strconv"
 	"strings"
@@ -250,3 +251,164 @@ func Slice(s string, start, stop int) string {
 	}
 	return s
 }
+
+// Strip removes ANSI escape sequences from string
+func Strip(s string) string {
+	sb := &strings.Builder{}
+	inANSI := false
+	for _, c := range s {
+		if inANSI {
+			if c == 'm' {
+				inANSI = false
+			}
+		} else {
+			if c == '\x1b' {
+				inANSI = true
+			} else {
+				sb.WriteRune(c)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// same palette as xterm
+var htmlColors = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+type htmlStyle struct {
+	fg        int // -1 is default
+	bg        int
+	bold      bool
+	italic    bool
+	underline bool
+	inverse   bool
+}
+
+var htmlStyleDefault = htmlStyle{fg: -1, bg: -1}
+
+func (hs htmlStyle) css() string {
+	fg, bg := hs.fg, hs.bg
+	var parts []string
+	if hs.inverse {
+		fg, bg = bg, fg
+		if fg == -1 {
+			parts = append(parts, "color:#ffffff")
+		}
+		if bg == -1 {
+			parts = append(parts, "background-color:#000000")
+		}
+	}
+	if fg != -1 {
+		parts = append(parts, "color:"+htmlColors[fg])
+	}
+	if bg != -1 {
+		parts = append(parts, "background-color:"+htmlColors[bg])
+	}
+	if hs.bold {
+		parts = append(parts, "font-weight:bold")
+	}
+	if hs.italic {
+		parts = append(parts, "font-style:italic")
+	}
+	if hs.underline {
+		parts = append(parts, "text-decoration:underline")
+	}
+	return strings.Join(parts, ";")
+}
+
+func (hs *htmlStyle) apply(params string) {
+	for _, p := range strings.Split(params, ";") {
+		n := 0
+		if p != "" {
+			var err error
+			if n, err = strconv.Atoi(p); err != nil {
+				continue
+			}
+		}
+		switch {
+		case n == 0:
+			*hs = htmlStyleDefault
+		case n == 1:
+			hs.bold = true
+		case n == 3:
+			hs.italic = true
+		case n == 4:
+			hs.underline = true
+		case n == 7:
+			hs.inverse = true
+		case n == 22:
+			hs.bold = false
+		case n == 23:
+			hs.italic = false
+		case n == 24:
+			hs.underline = false
+		case n == 27:
+			hs.inverse = false
+		case n >= 30 && n <= 37:
+			hs.fg = n - 30
+		case n == 39:
+			hs.fg = -1
+		case n >= 40 && n <= 47:
+			hs.bg = n - 40
+		case n == 49:
+			hs.bg = -1
+		case n >= 90 && n <= 97:
+			hs.fg = n - 90 + 8
+		case n >= 100 && n <= 107:
+			hs.bg = n - 100 + 8
+		}
+	}
+}
+
+// ToHTML converts text with ANSI SGR sequences into HTML escaped text with
+// styled spans. Other escape sequences are removed.
+func ToHTML(s string) string {
+	sb := &strings.Builder{}
+	style := htmlStyleDefault
+	open := false
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\x1b')
+		if i == -1 {
+			i = len(s)
+		}
+		if i > 0 {
+			if !open {
+				if css := style.css(); css != "" {
+					fmt.Fprintf(sb, `<span style="%s">`, css)
+					open = true
+				}
+			}
+			sb.WriteString(html.EscapeString(s[:i]))
+		}
+		s = s[i:]
+		if len(s) == 0 {
+			break
+		}
+		// CSI is ESC [ parameters final byte
+		end := 1
+		if len(s) > 1 && s[1] == '[' {
+			end = 2
+			for end < len(s) && (s[end] < 0x40 || s[end] > 0x7e) {
+				end++
+			}
+			if end < len(s) {
+				if s[end] == 'm' {
+					if open {
+						sb.WriteString("</span>")
+						open = false
+					}
+					style.apply(s[2:end])
+				}
+				end++
+			}
+		}
+		s = s[end:]
+	}
+	if open {
+		sb.WriteString("</span>")
+	}
+	return sb.String()
+}
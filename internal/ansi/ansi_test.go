@@ -51,3 +51,35 @@ func TestLen(t *testing.T) {
 		})
 	}
 }
+
+func TestStrip(t *testing.T) {
+	actual := ansi.Strip("a" + ansi.Red.Wrap("b<") + "c")
+	expected := "ab<c"
+	if expected != actual {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestToHTML(t *testing.T) {
+	testCases := []struct {
+		s        string
+		expected string
+	}{
+		{"", ""},
+		{"a<b>&", "a&lt;b&gt;&amp;"},
+		{"a" + ansi.Red.Wrap("b") + "c", `a<span style="color:#cd0000">b</span>c`},
+		{ansi.FromString("brightblue+bgwhite+underline").Wrap("a"), `<span style="color:#5c5cff;background-color:#e5e5e5;text-decoration:underline">a</span>`},
+		{"\x1b[1ma\x1b[0mb", `<span style="font-weight:bold">a</span>b`},
+		{"\x1b[31ma\x1b[1mb\x1b[22;39mc", `<span style="color:#cd0000">a</span><span style="color:#cd0000;font-weight:bold">b</span>c`},
+		{"\x1b[7ma\x1b[27m", `<span style="color:#ffffff;background-color:#000000">a</span>`},
+		{"a\x1b[2Kb\x1b[", "ab"},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.s, func(t *testing.T) {
+			actual := ansi.ToHTML(tC.s)
+			if tC.expected != actual {
+				t.Errorf("expected %q, got %q", tC.expected, actual)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 // Stack is a context stack
 type Stack struct {
+	ctxs      []context.Context
 	cancelFns []func()
 	stopCh    chan struct{}
 }
@@ -49,11 +50,21 @@ func (s *Stack) Stop() {
 	close(s.stopCh)
 }
 
+// Top returns the most recently pushed context that has not been popped, nil
+// if there is none
+func (s *Stack) Top() context.Context {
+	if len(s.ctxs) == 0 {
+		return nil
+	}
+	return s.ctxs[len(s.ctxs)-1]
+}
+
 // Push creates, pushes and returns new context. Cancel pops it.
 func (s *Stack) Push(parent context.Context) (context.Context, func()) {
 	stackCtx, stackCtxCancel := context.WithCancel(parent)
 	stackIdx := len(s.cancelFns)
 
+	s.ctxs = append(s.ctxs, stackCtx)
 	s.cancelFns = append(s.cancelFns, stackCtxCancel)
 	cancelled := false
 
@@ -66,6 +77,7 @@ func (s *Stack) Push(parent context.Context) (context.Context, func()) {
 		for i := len(s.cancelFns) - 1; i >= stackIdx; i-- {
 			s.cancelFns[i]()
 		}
+		s.ctxs = s.ctxs[0:stackIdx]
 		s.cancelFns = s.cancelFns[0:stackIdx]
 
 		stackCtxCancel()
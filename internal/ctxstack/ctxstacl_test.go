@@ -1,6 +1,7 @@
 package ctxstack_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/wader/fq/internal/ctxstack"
@@ -33,3 +34,26 @@ func TestCancelBeforePush(t *testing.T) {
 
 	<-waitCh
 }
+
+func TestTop(t *testing.T) {
+	s := ctxstack.New(func(stopCh chan struct{}) { <-stopCh })
+	defer s.Stop()
+
+	if s.Top() != nil {
+		t.Fatal("expected nil top for empty stack")
+	}
+
+	ctx1, cancel1 := s.Push(context.Background())
+	ctx2, cancel2 := s.Push(ctx1)
+	if s.Top() != ctx2 {
+		t.Fatal("expected top to be last pushed context")
+	}
+	cancel2()
+	if s.Top() != ctx1 {
+		t.Fatal("expected top to be first pushed context after pop")
+	}
+	cancel1()
+	if s.Top() != nil {
+		t.Fatal("expected nil top after all popped")
+	}
+}
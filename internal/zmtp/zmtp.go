@@ -0,0 +1,568 @@
+// Package zmtp implements enough of ZMTP 3.0, the ZeroMQ wire protocol, to
+// talk to libzmq peers over TCP using the NULL security mechanism.
+//
+// Supported socket types are ROUTER, PUB and REP for listening and DEALER, SUB
+// and REQ for dialing. There is no reconnect, high water mark or queueing of
+// messages to peers not yet connected.
+//
+// https://rfc.zeromq.org/spec/23/
+package zmtp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+)
+
+const (
+	Router = "ROUTER"
+	Pub    = "PUB"
+	Rep    = "REP"
+	Dealer = "DEALER"
+	Sub    = "SUB"
+	Req    = "REQ"
+)
+
+const (
+	flagMore    = 0x01
+	flagLong    = 0x02
+	flagCommand = 0x04
+)
+
+// max frame size to accept, protects against bogus sizes
+const maxFrameSize = 256 * 1024 * 1024
+
+var ErrClosed = errors.New("socket closed")
+
+type peer struct {
+	conn       net.Conn
+	identity   []byte
+	socketType string
+	wmu        sync.Mutex
+	subs       [][]byte // subscription prefixes for PUB
+}
+
+func (p *peer) writeFrame(w *bytes.Buffer, flags byte, body []byte) {
+	if len(body) > 255 {
+		w.WriteByte(flags | flagLong)
+		var n [8]byte
+		binary.BigEndian.PutUint64(n[:], uint64(len(body)))
+		w.Write(n[:])
+	} else {
+		w.WriteByte(flags)
+		w.WriteByte(byte(len(body)))
+	}
+	w.Write(body)
+}
+
+func (p *peer) writeCommand(name string, data []byte) error {
+	body := append([]byte{byte(len(name))}, name...)
+	body = append(body, data...)
+	buf := &bytes.Buffer{}
+	p.writeFrame(buf, flagCommand, body)
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	_, err := p.conn.Write(buf.Bytes())
+	return err
+}
+
+func (p *peer) writeMessage(frames [][]byte) error {
+	buf := &bytes.Buffer{}
+	for i, f := range frames {
+		var flags byte
+		if i < len(frames)-1 {
+			flags = flagMore
+		}
+		p.writeFrame(buf, flags, f)
+	}
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	_, err := p.conn.Write(buf.Bytes())
+	return err
+}
+
+// readFrame reads one frame and returns its flags and body
+func readFrame(r io.Reader) (byte, []byte, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	flags := hdr[0]
+	size := uint64(hdr[1])
+	if flags&flagLong != 0 {
+		var n [8]byte
+		n[0] = hdr[1]
+		if _, err := io.ReadFull(r, n[1:]); err != nil {
+			return 0, nil, err
+		}
+		size = binary.BigEndian.Uint64(n[:])
+	}
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("frame size %d too large", size)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return flags, body, nil
+}
+
+func parseCommand(body []byte) (string, []byte, error) {
+	if len(body) < 1 || len(body) < 1+int(body[0]) {
+		return "", nil, errors.New("invalid command")
+	}
+	n := int(body[0])
+	return string(body[1 : 1+n]), body[1+n:], nil
+}
+
+func encodeProperties(props [][2]string) []byte {
+	buf := &bytes.Buffer{}
+	for _, p := range props {
+		buf.WriteByte(byte(len(p[0])))
+		buf.WriteString(p[0])
+		var n [4]byte
+		binary.BigEndian.PutUint32(n[:], uint32(len(p[1])))
+		buf.Write(n[:])
+		buf.WriteString(p[1])
+	}
+	return buf.Bytes()
+}
+
+func decodeProperties(b []byte) (map[string][]byte, error) {
+	props := map[string][]byte{}
+	for len(b) > 0 {
+		nl := int(b[0])
+		if len(b) < 1+nl+4 {
+			return nil, errors.New("invalid property")
+		}
+		name := string(b[1 : 1+nl])
+		b = b[1+nl:]
+		vl := int(binary.BigEndian.Uint32(b))
+		b = b[4:]
+		if len(b) < vl {
+			return nil, errors.New("invalid property value")
+		}
+		// property names are case-insensitive
+		props[strings.ToLower(name)] = b[:vl]
+		b = b[vl:]
+	}
+	return props, nil
+}
+
+func greeting() []byte {
+	g := make([]byte, 64)
+	g[0] = 0xff
+	g[9] = 0x7f
+	g[10] = 3 // major version
+	g[11] = 0 // minor version
+	copy(g[12:32], "NULL")
+	// as-server and filler are zero
+	return g
+}
+
+// handshake exchanges greeting and READY commands with a peer
+func handshake(conn net.Conn, socketType string, identity []byte) (*peer, error) {
+	p := &peer{conn: conn}
+
+	if _, err := conn.Write(greeting()); err != nil {
+		return nil, err
+	}
+	var g [64]byte
+	if _, err := io.ReadFull(conn, g[:]); err != nil {
+		return nil, err
+	}
+	if g[0] != 0xff || g[9]&0x01 != 0x01 {
+		return nil, errors.New("invalid greeting signature")
+	}
+	if g[10] < 3 {
+		return nil, fmt.Errorf("unsupported version %d.%d", g[10], g[11])
+	}
+	if mechanism := string(bytes.TrimRight(g[12:32], "\x00")); mechanism != "NULL" {
+		return nil, fmt.Errorf("unsupported mechanism %q", mechanism)
+	}
+
+	props := [][2]string{{"Socket-Type", socketType}}
+	if len(identity) > 0 {
+		props = append(props, [2]string{"Identity", string(identity)})
+	}
+	if err := p.writeCommand("READY", encodeProperties(props)); err != nil {
+		return nil, err
+	}
+
+	flags, body, err := readFrame(conn)
+	if err != nil {
+		return nil, err
+	}
+	if flags&flagCommand == 0 {
+		return nil, errors.New("expected READY command")
+	}
+	name, data, err := parseCommand(body)
+	if err != nil {
+		return nil, err
+	}
+	if name == "ERROR" {
+		return nil, fmt.Errorf("peer error: %s", data)
+	} else if name != "READY" {
+		return nil, fmt.Errorf("expected READY command got %s", name)
+	}
+	peerProps, err := decodeProperties(data)
+	if err != nil {
+		return nil, err
+	}
+	p.socketType = string(peerProps["socket-type"])
+	p.identity = peerProps["identity"]
+
+	return p, nil
+}
+
+type message struct {
+	p      *peer
+	frames [][]byte
+}
+
+// Socket is a listening or dialed socket
+type Socket struct {
+	typ      string
+	ln       net.Listener
+	identity []byte
+
+	mu     sync.Mutex
+	peers  map[*peer]struct{}
+	routes map[string]*peer
+	nextID uint32
+	// REP peer and envelope to reply to
+	repPeer     *peer
+	repEnvelope [][]byte
+
+	recvCh    chan message
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+func newSocket(typ string) *Socket {
+	return &Socket{
+		typ:     typ,
+		peers:   map[*peer]struct{}{},
+		routes:  map[string]*peer{},
+		recvCh:  make(chan message),
+		closeCh: make(chan struct{}),
+	}
+}
+
+func tcpAddr(endpoint string) (string, error) {
+	addr, ok := strings.CutPrefix(endpoint, "tcp://")
+	if !ok {
+		return "", fmt.Errorf("%s: only tcp transport is supported", endpoint)
+	}
+	return addr, nil
+}
+
+// Listen listens on a tcp://host:port endpoint
+func Listen(typ string, endpoint string) (*Socket, error) {
+	switch typ {
+	case Router, Pub, Rep:
+	default:
+		return nil, fmt.Errorf("%s: can't listen with socket type", typ)
+	}
+	addr, err := tcpAddr(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := newSocket(typ)
+	s.ln = ln
+	go s.accept()
+
+	return s, nil
+}
+
+// Dial connects to a tcp://host:port endpoint. Identity is used by ROUTER peers
+// to route messages and can be nil.
+func Dial(typ string, endpoint string, identity []byte) (*Socket, error) {
+	switch typ {
+	case Dealer, Sub, Req:
+	default:
+		return nil, fmt.Errorf("%s: can't dial with socket type", typ)
+	}
+	addr, err := tcpAddr(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := newSocket(typ)
+	s.identity = identity
+	p, err := handshake(conn, typ, identity)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	s.addPeer(p)
+	go s.read(p)
+
+	return s, nil
+}
+
+// Addr returns the listening address
+func (s *Socket) Addr() net.Addr {
+	if s.ln == nil {
+		return nil
+	}
+	return s.ln.Addr()
+}
+
+func (s *Socket) accept() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			p, err := handshake(conn, s.typ, nil)
+			if err != nil {
+				conn.Close()
+				return
+			}
+			s.addPeer(p)
+			s.read(p)
+		}()
+	}
+}
+
+func (s *Socket) addPeer(p *peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.typ == Router {
+		// generate identity for peers without one, leading zero byte is
+		// reserved for generated identities
+		if len(p.identity) == 0 || s.routes[string(p.identity)] != nil {
+			s.nextID++
+			var id [5]byte
+			binary.BigEndian.PutUint32(id[1:], s.nextID)
+			p.identity = id[:]
+		}
+		s.routes[string(p.identity)] = p
+	}
+	s.peers[p] = struct{}{}
+}
+
+func (s *Socket) removePeer(p *peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.peers, p)
+	if s.routes[string(p.identity)] == p {
+		delete(s.routes, string(p.identity))
+	}
+	p.conn.Close()
+}
+
+// handleCommand handles commands after the handshake
+func (s *Socket) handleCommand(p *peer, body []byte) error {
+	name, data, err := parseCommand(body)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case "PING":
+		// PING has 2 bytes TTL followed by context to echo back
+		if len(data) < 2 {
+			return errors.New("invalid PING")
+		}
+		return p.writeCommand("PONG", data[2:])
+	case "SUBSCRIBE":
+		s.subscribe(p, data, true)
+	case "CANCEL":
+		s.subscribe(p, data, false)
+	}
+	return nil
+}
+
+func (s *Socket) subscribe(p *peer, prefix []byte, add bool) {
+	if s.typ != Pub {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sub := range p.subs {
+		if bytes.Equal(sub, prefix) {
+			if !add {
+				p.subs = append(p.subs[:i], p.subs[i+1:]...)
+			}
+			return
+		}
+	}
+	if add {
+		p.subs = append(p.subs, bytes.Clone(prefix))
+	}
+}
+
+func (s *Socket) read(p *peer) {
+	defer s.removePeer(p)
+	var frames [][]byte
+	for {
+		flags, body, err := readFrame(p.conn)
+		if err != nil {
+			return
+		}
+		if flags&flagCommand != 0 {
+			if err := s.handleCommand(p, body); err != nil {
+				return
+			}
+			continue
+		}
+		frames = append(frames, body)
+		if flags&flagMore != 0 {
+			continue
+		}
+		msg := frames
+		frames = nil
+
+		if s.typ == Pub {
+			// ZMTP 3.0 subscriptions are messages starting with 1 or 0
+			if len(msg) == 1 && len(msg[0]) > 0 {
+				s.subscribe(p, msg[0][1:], msg[0][0] == 1)
+			}
+			continue
+		}
+
+		select {
+		case s.recvCh <- message{p: p, frames: msg}:
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// Recv blocks until a message is received. ROUTER sockets prepend the identity
+// of the sending peer. REQ and REP sockets strip the envelope.
+func (s *Socket) Recv() ([][]byte, error) {
+	for {
+		var m message
+		select {
+		case m = <-s.recvCh:
+		case <-s.closeCh:
+			return nil, ErrClosed
+		}
+
+		switch s.typ {
+		case Router:
+			return append([][]byte{m.p.identity}, m.frames...), nil
+		case Rep, Req:
+			i := 0
+			for i < len(m.frames) && len(m.frames[i]) > 0 {
+				i++
+			}
+			if i == len(m.frames) {
+				// no delimiter, drop invalid message
+				continue
+			}
+			if s.typ == Rep {
+				s.mu.Lock()
+				s.repPeer = m.p
+				s.repEnvelope = m.frames[:i+1]
+				s.mu.Unlock()
+			}
+			return m.frames[i+1:], nil
+		default:
+			return m.frames, nil
+		}
+	}
+}
+
+// Send sends a message. ROUTER sockets use the first frame as identity of the
+// peer to send to, messages to unknown peers are dropped. PUB sockets send to
+// all peers with a matching subscription. REP sockets reply to the peer of the
+// last received message.
+func (s *Socket) Send(frames [][]byte) error {
+	select {
+	case <-s.closeCh:
+		return ErrClosed
+	default:
+	}
+
+	s.mu.Lock()
+	var targets []*peer
+	switch s.typ {
+	case Router:
+		if len(frames) < 1 {
+			s.mu.Unlock()
+			return errors.New("message has no identity frame")
+		}
+		if p, ok := s.routes[string(frames[0])]; ok {
+			targets = append(targets, p)
+		}
+		frames = frames[1:]
+	case Pub:
+		for p := range s.peers {
+			for _, sub := range p.subs {
+				if len(frames) > 0 && bytes.HasPrefix(frames[0], sub) {
+					targets = append(targets, p)
+					break
+				}
+			}
+		}
+	case Rep:
+		if s.repPeer == nil {
+			s.mu.Unlock()
+			return errors.New("no request to reply to")
+		}
+		targets = append(targets, s.repPeer)
+		frames = append(append([][]byte{}, s.repEnvelope...), frames...)
+		s.repPeer = nil
+		s.repEnvelope = nil
+	case Req:
+		frames = append([][]byte{{}}, frames...)
+		fallthrough
+	default:
+		for p := range s.peers {
+			targets = append(targets, p)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, p := range targets {
+		if err := p.writeMessage(frames); err != nil {
+			// peer is gone, reader will remove it
+			p.conn.Close()
+			if s.typ != Pub && s.typ != Router {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Subscribe adds a subscription prefix on a SUB socket
+func (s *Socket) Subscribe(prefix []byte) error {
+	if s.typ != Sub {
+		return fmt.Errorf("%s: can't subscribe", s.typ)
+	}
+	return s.Send([][]byte{append([]byte{1}, prefix...)})
+}
+
+// Close closes the socket and all peer connections
+func (s *Socket) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closeCh)
+		if s.ln != nil {
+			s.ln.Close()
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for p := range s.peers {
+			p.conn.Close()
+		}
+	})
+	return nil
+}
@@ -0,0 +1,144 @@
+package zmtp_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wader/fq/internal/zmtp"
+)
+
+func frames(ss ...string) [][]byte {
+	var fs [][]byte
+	for _, s := range ss {
+		fs = append(fs, []byte(s))
+	}
+	return fs
+}
+
+func listen(t *testing.T, typ string) *zmtp.Socket {
+	t.Helper()
+	s, err := zmtp.Listen(typ, "tcp://127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func dial(t *testing.T, typ string, l *zmtp.Socket, identity []byte) *zmtp.Socket {
+	t.Helper()
+	s, err := zmtp.Dial(typ, "tcp://"+l.Addr().String(), identity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func recv(t *testing.T, s *zmtp.Socket) [][]byte {
+	t.Helper()
+	msg, err := s.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func TestRouterDealer(t *testing.T) {
+	r := listen(t, zmtp.Router)
+	d := dial(t, zmtp.Dealer, r, []byte("client"))
+
+	long := string(make([]byte, 1000))
+	if err := d.Send(frames("a", "", long)); err != nil {
+		t.Fatal(err)
+	}
+	if actual, expected := recv(t, r), frames("client", "a", "", long); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+
+	if err := r.Send(frames("client", "b", "c")); err != nil {
+		t.Fatal(err)
+	}
+	if actual, expected := recv(t, d), frames("b", "c"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestRouterGeneratedIdentity(t *testing.T) {
+	r := listen(t, zmtp.Router)
+	d := dial(t, zmtp.Dealer, r, nil)
+
+	if err := d.Send(frames("a")); err != nil {
+		t.Fatal(err)
+	}
+	msg := recv(t, r)
+	if len(msg) != 2 || len(msg[0]) != 5 || msg[0][0] != 0 {
+		t.Fatalf("expected generated identity, got %q", msg)
+	}
+	if err := r.Send([][]byte{msg[0], []byte("b")}); err != nil {
+		t.Fatal(err)
+	}
+	if actual, expected := recv(t, d), frames("b"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestReqRep(t *testing.T) {
+	rep := listen(t, zmtp.Rep)
+	req := dial(t, zmtp.Req, rep, nil)
+
+	if err := req.Send(frames("ping")); err != nil {
+		t.Fatal(err)
+	}
+	msg := recv(t, rep)
+	if expected := frames("ping"); !reflect.DeepEqual(expected, msg) {
+		t.Errorf("expected %q, got %q", expected, msg)
+	}
+	if err := rep.Send(msg); err != nil {
+		t.Fatal(err)
+	}
+	if actual, expected := recv(t, req), frames("ping"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestPubSub(t *testing.T) {
+	pub := listen(t, zmtp.Pub)
+	sub := dial(t, zmtp.Sub, pub, nil)
+	if err := sub.Subscribe([]byte("a")); err != nil {
+		t.Fatal(err)
+	}
+
+	// subscription is async so publish until received
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				_ = pub.Send(frames("b", "skipped"))
+				_ = pub.Send(frames("a", "x"))
+			}
+		}
+	}()
+	defer close(done)
+
+	if actual, expected := recv(t, sub), frames("a", "x"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestClose(t *testing.T) {
+	r := listen(t, zmtp.Router)
+	errCh := make(chan error)
+	go func() {
+		_, err := r.Recv()
+		errCh <- err
+	}()
+	r.Close()
+	if err := <-errCh; err != zmtp.ErrClosed {
+		t.Errorf("expected %v, got %v", zmtp.ErrClosed, err)
+	}
+}
@@ -0,0 +1,145 @@
+package checksum_test
+
+import (
+	"encoding/hex"
+	"hash"
+	"testing"
+
+	"github.com/wader/fq/pkg/checksum"
+)
+
+func sumHex(h hash.Hash, s string) string {
+	// write in two parts to also test buffering
+	_, _ = h.Write([]byte(s[:len(s)/2]))
+	_, _ = h.Write([]byte(s[len(s)/2:]))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func TestCRCModels(t *testing.T) {
+	checks := map[string]string{
+		"crc5_usb":      "19",
+		"crc8":          "f4",
+		"crc8_maxim":    "a1",
+		"crc16_arc":     "bb3d",
+		"crc16_buypass": "fee8",
+		"crc16_ccitt":   "29b1",
+		"crc16_kermit":  "2189",
+		"crc16_modbus":  "4b37",
+		"crc16_usb":     "b4c8",
+		"crc16_x25":     "906e",
+		"crc16_xmodem":  "31c3",
+		"crc24_openpgp": "21cf02",
+		"crc32":         "cbf43926",
+		"crc32_bzip2":   "fc891918",
+		"crc32_mpeg2":   "0376e6e7",
+		"crc32_posix":   "765e7680",
+		"crc32c":        "e3069283",
+		"crc64_ecma":    "6c40df5f0b497347",
+		"crc64_iso":     "b90956c775a41001",
+		"crc64_xz":      "995dc9bbdf1939fa",
+	}
+	if len(checks) != len(checksum.CRCModels) {
+		t.Errorf("expected %d models, got %d", len(checks), len(checksum.CRCModels))
+	}
+	for name, m := range checksum.CRCModels {
+		t.Run(name, func(t *testing.T) {
+			c, err := checksum.NewCRCModel(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual, expected := sumHex(c, "123456789"), checks[name]; expected != actual {
+				t.Errorf("expected %s, got %s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCRCModelByName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"crc16_ccitt":        "crc16_ccitt",
+		"crc16-ccitt":        "crc16_ccitt",
+		"CRC-16/CCITT-FALSE": "crc16_ccitt",
+		"CRC-16/IBM-3740":    "crc16_ccitt",
+		"CRC-16/X-25":        "crc16_x25",
+		"CRC-32":             "crc32",
+		"CRC-32C":            "crc32c",
+		"CRC-32/ISCSI":       "crc32c",
+		"CRC-64/XZ":          "crc64_xz",
+		"XMODEM":             "crc16_xmodem",
+	} {
+		m, ok := checksum.CRCModelByName(name)
+		if !ok {
+			t.Errorf("%s: not found", name)
+			continue
+		}
+		if m != checksum.CRCModels[expected] {
+			t.Errorf("%s: expected %s model", name, expected)
+		}
+	}
+	for _, name := range []string{"", "crc", "crc-16/nope", "crc16__"} {
+		if _, ok := checksum.CRCModelByName(name); ok {
+			t.Errorf("%s: expected not found", name)
+		}
+	}
+}
+
+func TestCRCModelInvalid(t *testing.T) {
+	for _, m := range []checksum.CRCModel{
+		{Width: 0},
+		{Width: 65},
+		{Width: 8, Poly: 0x100},
+	} {
+		if _, err := checksum.NewCRCModel(m); err == nil {
+			t.Errorf("expected error for %#v", m)
+		}
+	}
+}
+
+func TestMurmur3(t *testing.T) {
+	testCases := []struct {
+		s        string
+		seed     uint32
+		expected string
+	}{
+		{"", 0, "00000000"},
+		{"", 1, "514e28b7"},
+		{"hello", 0, "248bfa47"},
+		{"The quick brown fox jumps over the lazy dog", 0, "2e4ff723"},
+	}
+	for _, tc := range testCases {
+		if actual := sumHex(checksum.NewMurmur3Hash32(tc.seed), tc.s); tc.expected != actual {
+			t.Errorf("%q %d: expected %s, got %s", tc.s, tc.seed, tc.expected, actual)
+		}
+	}
+
+	if actual, expected := sumHex(checksum.NewMurmur3Hash128(0), "The quick brown fox jumps over the lazy dog"), "e34bbc7bbc071b6c7a433ca9c49a9347"; expected != actual {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestSipHash(t *testing.T) {
+	key, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	msg, _ := hex.DecodeString("000102030405060708090a0b0c0d0e")
+	testCases := []struct {
+		n        int
+		expected string
+	}{
+		// reference vectors from the paper are little endian
+		{0, "726fdb47dd0e0e31"},
+		{8, "93f5f5799a932462"},
+		{15, "a129ca6149be45e5"},
+	}
+	for _, tc := range testCases {
+		s, err := checksum.NewSipHash(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := sumHex(s, string(msg[:tc.n])); tc.expected != actual {
+			t.Errorf("%d: expected %s, got %s", tc.n, tc.expected, actual)
+		}
+	}
+
+	if _, err := checksum.NewSipHash(nil); err == nil {
+		t.Error("expected error for short key")
+	}
+}
@@ -0,0 +1,204 @@
+package checksum
+
+// Parameterized CRC using the Rocksoft model as used by the CRC RevEng catalogue
+// https://reveng.sourceforge.io/crc-catalogue/all.htm
+
+import (
+	"fmt"
+	"math/bits"
+	"regexp"
+	"strings"
+)
+
+type CRCModel struct {
+	Width  int
+	Poly   uint64
+	Init   uint64
+	RefIn  bool
+	RefOut bool
+	XorOut uint64
+}
+
+// CRCModels are named presets from the catalogue
+var CRCModels = map[string]CRCModel{
+	"crc5_usb":      {Width: 5, Poly: 0x05, Init: 0x1f, RefIn: true, RefOut: true, XorOut: 0x1f},
+	"crc8":          {Width: 8, Poly: 0x07},
+	"crc8_maxim":    {Width: 8, Poly: 0x31, RefIn: true, RefOut: true},
+	"crc16_arc":     {Width: 16, Poly: 0x8005, RefIn: true, RefOut: true},
+	"crc16_buypass": {Width: 16, Poly: 0x8005},
+	"crc16_ccitt":   {Width: 16, Poly: 0x1021, Init: 0xffff}, // also known as CCITT-FALSE
+	"crc16_kermit":  {Width: 16, Poly: 0x1021, RefIn: true, RefOut: true},
+	"crc16_modbus":  {Width: 16, Poly: 0x8005, Init: 0xffff, RefIn: true, RefOut: true},
+	"crc16_usb":     {Width: 16, Poly: 0x8005, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0xffff},
+	"crc16_x25":     {Width: 16, Poly: 0x1021, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0xffff},
+	"crc16_xmodem":  {Width: 16, Poly: 0x1021},
+	"crc24_openpgp": {Width: 24, Poly: 0x864cfb, Init: 0xb704ce},
+	"crc32":         {Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"crc32_bzip2":   {Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, XorOut: 0xffffffff},
+	"crc32_mpeg2":   {Width: 32, Poly: 0x04c11db7, Init: 0xffffffff},
+	"crc32_posix":   {Width: 32, Poly: 0x04c11db7, XorOut: 0xffffffff},
+	"crc32c":        {Width: 32, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"crc64_ecma":    {Width: 64, Poly: 0x42f0e1eba9ea3693},
+	"crc64_iso":     {Width: 64, Poly: 0x000000000000001b, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff},
+	"crc64_xz":      {Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff},
+}
+
+// crcModelAliases are other catalogue names for CRCModels, normalized by CRCModelByName
+var crcModelAliases = map[string]string{
+	"crc8_smbus":        "crc8",
+	"crc8_maxim_dow":    "crc8_maxim",
+	"dow_crc":           "crc8_maxim",
+	"crc16":             "crc16_arc",
+	"arc":               "crc16_arc",
+	"crc16_ibm":         "crc16_arc",
+	"crc16_lha":         "crc16_arc",
+	"crc16_umts":        "crc16_buypass",
+	"crc16_verifone":    "crc16_buypass",
+	"crc16_ccitt_false": "crc16_ccitt",
+	"crc16_ibm_3740":    "crc16_ccitt",
+	"crc16_autosar":     "crc16_ccitt",
+	"crc16_ccitt_true":  "crc16_kermit",
+	"crc16_v_41_lsb":    "crc16_kermit",
+	"kermit":            "crc16_kermit",
+	"modbus":            "crc16_modbus",
+	"crc16_x_25":        "crc16_x25",
+	"crc16_ibm_sdlc":    "crc16_x25",
+	"crc16_iso_hdlc":    "crc16_x25",
+	"x_25":              "crc16_x25",
+	"crc16_acorn":       "crc16_xmodem",
+	"crc16_lte":         "crc16_xmodem",
+	"crc16_v_41_msb":    "crc16_xmodem",
+	"xmodem":            "crc16_xmodem",
+	"zmodem":            "crc16_xmodem",
+	"crc24":             "crc24_openpgp",
+	"crc32_iso_hdlc":    "crc32",
+	"crc32_adccp":       "crc32",
+	"crc32_v_42":        "crc32",
+	"crc32_xz":          "crc32",
+	"pkzip":             "crc32",
+	"crc32_aal5":        "crc32_bzip2",
+	"crc32_dect_b":      "crc32_bzip2",
+	"b_crc_32":          "crc32_bzip2",
+	"crc32_cksum":       "crc32_posix",
+	"cksum":             "crc32_posix",
+	"crc32_iscsi":       "crc32c",
+	"crc32_castagnoli":  "crc32c",
+	"crc32_interlaken":  "crc32c",
+	"crc32_base91_c":    "crc32c",
+	"crc64":             "crc64_ecma",
+	"crc64_ecma_182":    "crc64_ecma",
+	"crc64_go_iso":      "crc64_iso",
+}
+
+var crcNameSepRe = regexp.MustCompile(`[-/ _]+`)
+var crcNameWidthRe = regexp.MustCompile(`^crc_(\d)`)
+
+// CRCModelByName looks up a preset by name or alias. Case and separators are
+// ignored so "CRC-16/CCITT-FALSE", "crc16-ccitt" and "crc16_ccitt" are the same.
+func CRCModelByName(name string) (CRCModel, bool) {
+	n := crcNameSepRe.ReplaceAllString(strings.ToLower(name), "_")
+	n = crcNameWidthRe.ReplaceAllString(n, "crc$1")
+	if a, ok := crcModelAliases[n]; ok {
+		n = a
+	}
+	m, ok := CRCModels[n]
+	return m, ok
+}
+
+func reflect(v uint64, width int) uint64 {
+	return bits.Reverse64(v) >> (64 - width)
+}
+
+// CRCModelHash implements hash.Hash
+type CRCModelHash struct {
+	CRCModel
+	table   [256]uint64
+	current uint64
+}
+
+// NewCRCModel returns a CRC hash for model, width must be 1-64
+func NewCRCModel(m CRCModel) (*CRCModelHash, error) {
+	if m.Width < 1 || m.Width > 64 {
+		return nil, fmt.Errorf("crc width %d not in range 1-64", m.Width)
+	}
+	mask := ^uint64(0) >> (64 - m.Width)
+	if m.Poly&^mask != 0 || m.Init&^mask != 0 || m.XorOut&^mask != 0 {
+		return nil, fmt.Errorf("crc poly, init or xorout wider than %d bits", m.Width)
+	}
+
+	c := &CRCModelHash{CRCModel: m}
+	// reflected register is kept in the low bits and non-reflected in the high bits
+	if m.RefIn {
+		poly := reflect(m.Poly, m.Width)
+		for i := range 256 {
+			crc := uint64(i)
+			for range 8 {
+				if crc&1 != 0 {
+					crc = crc>>1 ^ poly
+				} else {
+					crc >>= 1
+				}
+			}
+			c.table[i] = crc
+		}
+	} else {
+		poly := m.Poly << (64 - m.Width)
+		for i := range 256 {
+			crc := uint64(i) << 56
+			for range 8 {
+				if crc&(1<<63) != 0 {
+					crc = crc<<1 ^ poly
+				} else {
+					crc <<= 1
+				}
+			}
+			c.table[i] = crc
+		}
+	}
+	c.Reset()
+
+	return c, nil
+}
+
+func (c *CRCModelHash) Write(p []byte) (n int, err error) {
+	if c.RefIn {
+		for _, b := range p {
+			c.current = c.table[byte(c.current)^b] ^ c.current>>8
+		}
+	} else {
+		for _, b := range p {
+			c.current = c.table[byte(c.current>>56)^b] ^ c.current<<8
+		}
+	}
+	return len(p), nil
+}
+
+func (c *CRCModelHash) Sum64() uint64 {
+	s := c.current
+	if !c.RefIn {
+		s >>= 64 - c.Width
+	}
+	if c.RefIn != c.RefOut {
+		s = reflect(s, c.Width)
+	}
+	return s ^ c.XorOut
+}
+
+// Sum appends big endian CRC using as few bytes as possible
+func (c *CRCModelHash) Sum(b []byte) []byte {
+	s := c.Sum64()
+	for i := c.Size() - 1; i >= 0; i-- {
+		b = append(b, byte(s>>(i*8)))
+	}
+	return b
+}
+
+func (c *CRCModelHash) Reset() {
+	if c.RefIn {
+		c.current = reflect(c.Init, c.Width)
+	} else {
+		c.current = c.Init << (64 - c.Width)
+	}
+}
+func (c *CRCModelHash) Size() int      { return (c.Width + 7) / 8 }
+func (c *CRCModelHash) BlockSize() int { return 1 }
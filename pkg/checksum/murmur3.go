@@ -0,0 +1,214 @@
+package checksum
+
+// https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	murmur3C1x32 uint32 = 0xcc9e2d51
+	murmur3C2x32 uint32 = 0x1b873593
+	murmur3C1x64 uint64 = 0x87c37b91114253d5
+	murmur3C2x64 uint64 = 0x4cf5ad432745937f
+)
+
+// Murmur3Hash32 implements hash.Hash32, x86 32 bit variant
+type Murmur3Hash32 struct {
+	Seed  uint32
+	h     uint32
+	total uint64
+	buf   [4]byte
+	n     int
+}
+
+func NewMurmur3Hash32(seed uint32) *Murmur3Hash32 {
+	m := &Murmur3Hash32{Seed: seed}
+	m.Reset()
+	return m
+}
+
+func murmur3K32(k uint32) uint32 {
+	k *= murmur3C1x32
+	k = bits.RotateLeft32(k, 15)
+	return k * murmur3C2x32
+}
+
+func (m *Murmur3Hash32) block(b []byte) {
+	m.h ^= murmur3K32(binary.LittleEndian.Uint32(b))
+	m.h = bits.RotateLeft32(m.h, 13)
+	m.h = m.h*5 + 0xe6546b64
+}
+
+func (m *Murmur3Hash32) Write(p []byte) (n int, err error) {
+	n = len(p)
+	m.total += uint64(n)
+	if m.n > 0 {
+		c := copy(m.buf[m.n:], p)
+		m.n += c
+		p = p[c:]
+		if m.n < len(m.buf) {
+			return n, nil
+		}
+		m.block(m.buf[:])
+		m.n = 0
+	}
+	for len(p) >= len(m.buf) {
+		m.block(p)
+		p = p[len(m.buf):]
+	}
+	m.n = copy(m.buf[:], p)
+
+	return n, nil
+}
+
+func (m *Murmur3Hash32) Sum32() uint32 {
+	h := m.h
+
+	var k uint32
+	tail := m.buf[:m.n]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		h ^= murmur3K32(k)
+	}
+
+	h ^= uint32(m.total)
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+
+	return h
+}
+
+func (m *Murmur3Hash32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, m.Sum32())
+}
+
+func (m *Murmur3Hash32) Reset() {
+	m.h = m.Seed
+	m.total = 0
+	m.n = 0
+}
+func (m *Murmur3Hash32) Size() int      { return 4 }
+func (m *Murmur3Hash32) BlockSize() int { return 4 }
+
+// Murmur3Hash128 implements hash.Hash, x64 128 bit variant
+type Murmur3Hash128 struct {
+	Seed   uint64
+	h1, h2 uint64
+	total  uint64
+	buf    [16]byte
+	n      int
+}
+
+func NewMurmur3Hash128(seed uint64) *Murmur3Hash128 {
+	m := &Murmur3Hash128{Seed: seed}
+	m.Reset()
+	return m
+}
+
+func murmur3K1x64(k uint64) uint64 {
+	k *= murmur3C1x64
+	k = bits.RotateLeft64(k, 31)
+	return k * murmur3C2x64
+}
+
+func murmur3K2x64(k uint64) uint64 {
+	k *= murmur3C2x64
+	k = bits.RotateLeft64(k, 33)
+	return k * murmur3C1x64
+}
+
+func murmur3Fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+func (m *Murmur3Hash128) block(b []byte) {
+	m.h1 ^= murmur3K1x64(binary.LittleEndian.Uint64(b[0:]))
+	m.h1 = bits.RotateLeft64(m.h1, 27)
+	m.h1 += m.h2
+	m.h1 = m.h1*5 + 0x52dce729
+
+	m.h2 ^= murmur3K2x64(binary.LittleEndian.Uint64(b[8:]))
+	m.h2 = bits.RotateLeft64(m.h2, 31)
+	m.h2 += m.h1
+	m.h2 = m.h2*5 + 0x38495ab5
+}
+
+func (m *Murmur3Hash128) Write(p []byte) (n int, err error) {
+	n = len(p)
+	m.total += uint64(n)
+	if m.n > 0 {
+		c := copy(m.buf[m.n:], p)
+		m.n += c
+		p = p[c:]
+		if m.n < len(m.buf) {
+			return n, nil
+		}
+		m.block(m.buf[:])
+		m.n = 0
+	}
+	for len(p) >= len(m.buf) {
+		m.block(p)
+		p = p[len(m.buf):]
+	}
+	m.n = copy(m.buf[:], p)
+
+	return n, nil
+}
+
+func (m *Murmur3Hash128) Sum128() (uint64, uint64) {
+	h1, h2 := m.h1, m.h2
+
+	// zero padded tail read as two little endian words
+	var tail [16]byte
+	copy(tail[:], m.buf[:m.n])
+	if m.n > 8 {
+		h2 ^= murmur3K2x64(binary.LittleEndian.Uint64(tail[8:]))
+	}
+	if m.n > 0 {
+		h1 ^= murmur3K1x64(binary.LittleEndian.Uint64(tail[0:]))
+	}
+
+	h1 ^= m.total
+	h2 ^= m.total
+	h1 += h2
+	h2 += h1
+	h1 = murmur3Fmix64(h1)
+	h2 = murmur3Fmix64(h2)
+	h1 += h2
+	h2 += h1
+
+	return h1, h2
+}
+
+// Sum appends h1 and h2 as big endian
+func (m *Murmur3Hash128) Sum(b []byte) []byte {
+	h1, h2 := m.Sum128()
+	b = binary.BigEndian.AppendUint64(b, h1)
+	return binary.BigEndian.AppendUint64(b, h2)
+}
+
+func (m *Murmur3Hash128) Reset() {
+	m.h1 = m.Seed
+	m.h2 = m.Seed
+	m.total = 0
+	m.n = 0
+}
+func (m *Murmur3Hash128) Size() int      { return 16 }
+func (m *Murmur3Hash128) BlockSize() int { return 16 }
@@ -0,0 +1,108 @@
+package checksum
+
+// https://www.aumasson.jp/siphash/siphash.pdf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// SipHash implements hash.Hash64, SipHash-2-4 with 64 bit output
+type SipHash struct {
+	k0, k1         uint64
+	v0, v1, v2, v3 uint64
+	total          uint64
+	buf            [8]byte
+	n              int
+}
+
+// NewSipHash returns SipHash-2-4 using a 16 byte key
+func NewSipHash(key []byte) (*SipHash, error) {
+	if len(key) != 16 {
+		return nil, fmt.Errorf("siphash key length %d, should be 16", len(key))
+	}
+	s := &SipHash{
+		k0: binary.LittleEndian.Uint64(key[0:]),
+		k1: binary.LittleEndian.Uint64(key[8:]),
+	}
+	s.Reset()
+	return s, nil
+}
+
+func sipRound(v0, v1, v2, v3 uint64) (uint64, uint64, uint64, uint64) {
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 13)
+	v1 ^= v0
+	v0 = bits.RotateLeft64(v0, 32)
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16)
+	v3 ^= v2
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 21)
+	v3 ^= v0
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 17)
+	v1 ^= v2
+	v2 = bits.RotateLeft64(v2, 32)
+	return v0, v1, v2, v3
+}
+
+func (s *SipHash) compress(m uint64) {
+	v0, v1, v2, v3 := s.v0, s.v1, s.v2, s.v3^m
+	v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	s.v0, s.v1, s.v2, s.v3 = v0^m, v1, v2, v3
+}
+
+func (s *SipHash) Write(p []byte) (n int, err error) {
+	n = len(p)
+	s.total += uint64(n)
+	if s.n > 0 {
+		c := copy(s.buf[s.n:], p)
+		s.n += c
+		p = p[c:]
+		if s.n < len(s.buf) {
+			return n, nil
+		}
+		s.compress(binary.LittleEndian.Uint64(s.buf[:]))
+		s.n = 0
+	}
+	for len(p) >= len(s.buf) {
+		s.compress(binary.LittleEndian.Uint64(p))
+		p = p[len(s.buf):]
+	}
+	s.n = copy(s.buf[:], p)
+
+	return n, nil
+}
+
+func (s *SipHash) Sum64() uint64 {
+	var last [8]byte
+	copy(last[:], s.buf[:s.n])
+	last[7] = byte(s.total)
+
+	c := *s
+	c.compress(binary.LittleEndian.Uint64(last[:]))
+	v0, v1, v2, v3 := c.v0, c.v1, c.v2^0xff, c.v3
+	for range 4 {
+		v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	}
+
+	return v0 ^ v1 ^ v2 ^ v3
+}
+
+func (s *SipHash) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, s.Sum64())
+}
+
+func (s *SipHash) Reset() {
+	s.v0 = s.k0 ^ 0x736f6d6570736575
+	s.v1 = s.k1 ^ 0x646f72616e646f6d
+	s.v2 = s.k0 ^ 0x6c7967656e657261
+	s.v3 = s.k1 ^ 0x7465646279746573
+	s.total = 0
+	s.n = 0
+}
+func (s *SipHash) Size() int      { return 8 }
+func (s *SipHash) BlockSize() int { return 8 }
@@ -0,0 +1,233 @@
+package checksum
+
+// https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	xxh32Prime1 uint32 = 2654435761
+	xxh32Prime2 uint32 = 2246822519
+	xxh32Prime3 uint32 = 3266489917
+	xxh32Prime4 uint32 = 668265263
+	xxh32Prime5 uint32 = 374761393
+)
+
+const (
+	xxh64Prime1 uint64 = 11400714785074694791
+	xxh64Prime2 uint64 = 14029467366897019727
+	xxh64Prime3 uint64 = 1609587929392839161
+	xxh64Prime4 uint64 = 9650029242287828579
+	xxh64Prime5 uint64 = 2870177450012600261
+)
+
+// XXH32 implements hash.Hash32
+type XXH32 struct {
+	Seed  uint32
+	v     [4]uint32
+	total uint64
+	buf   [16]byte
+	n     int
+}
+
+func NewXXH32(seed uint32) *XXH32 {
+	x := &XXH32{Seed: seed}
+	x.Reset()
+	return x
+}
+
+func xxh32Round(acc, lane uint32) uint32 {
+	acc += lane * xxh32Prime2
+	acc = bits.RotateLeft32(acc, 13)
+	return acc * xxh32Prime1
+}
+
+func (x *XXH32) stripe(b []byte) {
+	x.v[0] = xxh32Round(x.v[0], binary.LittleEndian.Uint32(b[0:]))
+	x.v[1] = xxh32Round(x.v[1], binary.LittleEndian.Uint32(b[4:]))
+	x.v[2] = xxh32Round(x.v[2], binary.LittleEndian.Uint32(b[8:]))
+	x.v[3] = xxh32Round(x.v[3], binary.LittleEndian.Uint32(b[12:]))
+}
+
+func (x *XXH32) Write(p []byte) (n int, err error) {
+	n = len(p)
+	x.total += uint64(n)
+	if x.n > 0 {
+		c := copy(x.buf[x.n:], p)
+		x.n += c
+		p = p[c:]
+		if x.n < len(x.buf) {
+			return n, nil
+		}
+		x.stripe(x.buf[:])
+		x.n = 0
+	}
+	for len(p) >= len(x.buf) {
+		x.stripe(p)
+		p = p[len(x.buf):]
+	}
+	x.n = copy(x.buf[:], p)
+
+	return n, nil
+}
+
+func (x *XXH32) Sum32() uint32 {
+	var h uint32
+	if x.total >= uint64(len(x.buf)) {
+		h = bits.RotateLeft32(x.v[0], 1) +
+			bits.RotateLeft32(x.v[1], 7) +
+			bits.RotateLeft32(x.v[2], 12) +
+			bits.RotateLeft32(x.v[3], 18)
+	} else {
+		h = x.Seed + xxh32Prime5
+	}
+	h += uint32(x.total)
+
+	p := x.buf[:x.n]
+	for ; len(p) >= 4; p = p[4:] {
+		h += binary.LittleEndian.Uint32(p) * xxh32Prime3
+		h = bits.RotateLeft32(h, 17) * xxh32Prime4
+	}
+	for _, b := range p {
+		h += uint32(b) * xxh32Prime5
+		h = bits.RotateLeft32(h, 11) * xxh32Prime1
+	}
+
+	h ^= h >> 15
+	h *= xxh32Prime2
+	h ^= h >> 13
+	h *= xxh32Prime3
+	h ^= h >> 16
+
+	return h
+}
+
+func (x *XXH32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, x.Sum32())
+}
+
+func (x *XXH32) Reset() {
+	x.v = [4]uint32{
+		x.Seed + xxh32Prime1 + xxh32Prime2,
+		x.Seed + xxh32Prime2,
+		x.Seed,
+		x.Seed - xxh32Prime1,
+	}
+	x.total = 0
+	x.n = 0
+}
+func (x *XXH32) Size() int      { return 4 }
+func (x *XXH32) BlockSize() int { return 16 }
+
+// XXH64 implements hash.Hash64
+type XXH64 struct {
+	Seed  uint64
+	v     [4]uint64
+	total uint64
+	buf   [32]byte
+	n     int
+}
+
+func NewXXH64(seed uint64) *XXH64 {
+	x := &XXH64{Seed: seed}
+	x.Reset()
+	return x
+}
+
+func xxh64Round(acc, lane uint64) uint64 {
+	acc += lane * xxh64Prime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxh64Prime1
+}
+
+func xxh64MergeRound(acc, v uint64) uint64 {
+	acc ^= xxh64Round(0, v)
+	return acc*xxh64Prime1 + xxh64Prime4
+}
+
+func (x *XXH64) stripe(b []byte) {
+	x.v[0] = xxh64Round(x.v[0], binary.LittleEndian.Uint64(b[0:]))
+	x.v[1] = xxh64Round(x.v[1], binary.LittleEndian.Uint64(b[8:]))
+	x.v[2] = xxh64Round(x.v[2], binary.LittleEndian.Uint64(b[16:]))
+	x.v[3] = xxh64Round(x.v[3], binary.LittleEndian.Uint64(b[24:]))
+}
+
+func (x *XXH64) Write(p []byte) (n int, err error) {
+	n = len(p)
+	x.total += uint64(n)
+	if x.n > 0 {
+		c := copy(x.buf[x.n:], p)
+		x.n += c
+		p = p[c:]
+		if x.n < len(x.buf) {
+			return n, nil
+		}
+		x.stripe(x.buf[:])
+		x.n = 0
+	}
+	for len(p) >= len(x.buf) {
+		x.stripe(p)
+		p = p[len(x.buf):]
+	}
+	x.n = copy(x.buf[:], p)
+
+	return n, nil
+}
+
+func (x *XXH64) Sum64() uint64 {
+	var h uint64
+	if x.total >= uint64(len(x.buf)) {
+		h = bits.RotateLeft64(x.v[0], 1) +
+			bits.RotateLeft64(x.v[1], 7) +
+			bits.RotateLeft64(x.v[2], 12) +
+			bits.RotateLeft64(x.v[3], 18)
+		for _, v := range x.v {
+			h = xxh64MergeRound(h, v)
+		}
+	} else {
+		h = x.Seed + xxh64Prime5
+	}
+	h += x.total
+
+	p := x.buf[:x.n]
+	for ; len(p) >= 8; p = p[8:] {
+		h ^= xxh64Round(0, binary.LittleEndian.Uint64(p))
+		h = bits.RotateLeft64(h, 27)*xxh64Prime1 + xxh64Prime4
+	}
+	if len(p) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(p)) * xxh64Prime1
+		h = bits.RotateLeft64(h, 23)*xxh64Prime2 + xxh64Prime3
+		p = p[4:]
+	}
+	for _, b := range p {
+		h ^= uint64(b) * xxh64Prime5
+		h = bits.RotateLeft64(h, 11) * xxh64Prime1
+	}
+
+	h ^= h >> 33
+	h *= xxh64Prime2
+	h ^= h >> 29
+	h *= xxh64Prime3
+	h ^= h >> 32
+
+	return h
+}
+
+func (x *XXH64) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, x.Sum64())
+}
+
+func (x *XXH64) Reset() {
+	x.v = [4]uint64{
+		x.Seed + xxh64Prime1 + xxh64Prime2,
+		x.Seed + xxh64Prime2,
+		x.Seed,
+		x.Seed - xxh64Prime1,
+	}
+	x.total = 0
+	x.n = 0
+}
+func (x *XXH64) Size() int      { return 8 }
+func (x *XXH64) BlockSize() int { return 32 }
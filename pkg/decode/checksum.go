@@ -0,0 +1,115 @@
+package decode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+// Checksum is a checksum field with the expected checksum read from the input
+// and the checksum calculated from the data it covers
+type Checksum struct {
+	Value      *Value
+	Algorithm  string
+	Expected   []byte // big endian
+	Calculated []byte // big endian
+}
+
+func (c Checksum) Valid() bool { return bytes.Equal(c.Expected, c.Calculated) }
+
+// checksumExpected returns the big endian bytes of a uint or raw checksum value
+func checksumExpected(v *Value) ([]byte, error) {
+	if s, ok := scalar.AsUint(v.V); ok {
+		n := int((v.Range.Len + 7) / 8)
+		bs := make([]byte, n)
+		for i := range bs {
+			bs[i] = byte(s.Actual >> ((n - 1 - i) * 8))
+		}
+		return bs, nil
+	}
+	if s, ok := scalar.AsBitBuf(v.V); ok {
+		bb := &bytes.Buffer{}
+		if _, err := bitiox.CopyBits(bb, s.Actual); err != nil {
+			return nil, err
+		}
+		return bb.Bytes(), nil
+	}
+	return nil, fmt.Errorf("%s: checksum must be a uint or raw value", v.Name)
+}
+
+// ValidateChecksum validates checksum field v against calculated, a big endian
+// checksum as returned by hash.Hash Sum. The field gets a valid or invalid
+// description, a mismatch is a warning, and the checksum is recorded in the
+// parent compound value.
+func (d *D) ValidateChecksum(v *Value, algorithm string, calculated []byte) {
+	expected, err := checksumExpected(v)
+	if err != nil {
+		d.Fatalf("%s", err)
+	}
+
+	c := Checksum{
+		Value:      v,
+		Algorithm:  algorithm,
+		Expected:   expected,
+		Calculated: calculated,
+	}
+	desc := "valid"
+	if !c.Valid() {
+		desc = "invalid"
+		v.addWarning(fmt.Sprintf("%s mismatch, calculated %s", algorithm, hex.EncodeToString(calculated)))
+	}
+	if s, ok := scalar.AsUint(v.V); ok {
+		s.Description = desc
+		v.V = &s
+	} else if s, ok := scalar.AsBitBuf(v.V); ok {
+		s.Description = desc
+		v.V = &s
+	}
+
+	if pc, ok := v.Parent.V.(*Compound); ok {
+		pc.Checksums = append(pc.Checksums, c)
+	}
+}
+
+// lastField returns the last added field of the current compound value
+func (d *D) lastField() *Value {
+	c, ok := d.Value.V.(*Compound)
+	if !ok || len(c.Children) == 0 {
+		panic("no last field")
+	}
+	return c.Children[len(c.Children)-1]
+}
+
+// FieldChecksumU reads a nBits unsigned integer checksum field using current
+// endian and validates it, see ValidateChecksum
+func (d *D) FieldChecksumU(name string, nBits int, algorithm string, calculated []byte, sms ...scalar.UintMapper) uint64 {
+	n := d.FieldU(name, nBits, sms...)
+	d.ValidateChecksum(d.lastField(), algorithm, calculated)
+	return n
+}
+
+// FieldChecksumRawLen reads nBits raw checksum field and validates it, see ValidateChecksum
+func (d *D) FieldChecksumRawLen(name string, nBits int64, algorithm string, calculated []byte, sms ...scalar.BitBufMapper) {
+	d.FieldRawLen(name, nBits, sms...)
+	d.ValidateChecksum(d.lastField(), algorithm, calculated)
+}
+
+// Checksum returns the checksum recorded for v if v is a checksum field
+func (v *Value) Checksum() (Checksum, bool) {
+	if v.Parent == nil {
+		return Checksum{}, false
+	}
+	c, ok := v.Parent.V.(*Compound)
+	if !ok {
+		return Checksum{}, false
+	}
+	for _, cs := range c.Checksums {
+		if cs.Value == v {
+			return cs, true
+		}
+	}
+	return Checksum{}, false
+}
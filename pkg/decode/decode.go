@@ -8,6 +8,8 @@ import (
 
 	"reflect"
 	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/wader/fq/internal/bitiox"
 	"github.com/wader/fq/internal/iox"
@@ -32,12 +34,30 @@ type Options struct {
 	Name        string
 	Description string
 	Force       bool
+	Lazy        bool                   // sub formats with known range are decoded on first access, see Value.Resolve
+	LazyCtxFn   func() context.Context // context for resolving lazy values, decode context is usually done by then
 	FillGaps    bool
+	FillGapsEnd bool // fill gaps only up to byte aligned end of decoded values instead of end of range
 	IsRoot      bool
 	Range       ranges.Range // if zero use whole buffer
 	InArg       any
 	ParseOptsFn func(init any) any
 	ReadBuf     *[]byte
+	AttemptFn   func(a Attempt) // called for each format tried, ex: to explain probe
+
+	Depth          int      // if > 0 sub formats nested deeper than depth are raw bits, 1 is only root format
+	IncludeFormats []string // if not empty only decode sub formats with these format or group names
+	ExcludeFormats []string // don't decode sub formats with these format or group names
+
+	depth int // sub format depth, 0 is root
+}
+
+// Attempt is the outcome of trying to decode a format
+type Attempt struct {
+	Format   *Format
+	Err      error // nil if decoded without error
+	Pos      int64 // bit position of the decoder when it failed or was done
+	Duration time.Duration
 }
 
 // Decode try decode group and return first success and all other decoder errors
@@ -109,6 +129,7 @@ func decode(ctx context.Context, br bitio.ReaderAtSeeker, group *Group, opts Opt
 		d.inArgs = inArgs
 
 		var decodeV any
+		start := time.Now()
 		r, rOk := recoverfn.Run(func() {
 			decodeV = f.DecodeFn(d)
 		})
@@ -117,6 +138,7 @@ func decode(ctx context.Context, br bitio.ReaderAtSeeker, group *Group, opts Opt
 			return nil, nil, ctx.Err()
 		}
 
+		var attemptErr error
 		if !rOk {
 			var panicErr error
 			if err, ok := r.RecoverV.(error); ok {
@@ -131,6 +153,7 @@ func decode(ctx context.Context, br bitio.ReaderAtSeeker, group *Group, opts Opt
 				Stacktrace: r,
 			}
 			formatsErr.Errs = append(formatsErr.Errs, formatErr)
+			attemptErr = formatErr
 
 			switch vv := d.Value.V.(type) {
 			case *Compound:
@@ -138,15 +161,29 @@ func decode(ctx context.Context, br bitio.ReaderAtSeeker, group *Group, opts Opt
 				d.Value.V = vv
 				d.Value.Err = formatErr
 			}
+		}
 
-			if len(group.Formats) != 1 {
-				continue
-			}
+		if opts.AttemptFn != nil {
+			pos, _ := d.TryPos()
+			opts.AttemptFn(Attempt{
+				Format:   f,
+				Err:      attemptErr,
+				Pos:      pos,
+				Duration: time.Since(start),
+			})
+		}
+
+		if !rOk && len(group.Formats) != 1 {
+			continue
 		}
 
 		// TODO: maybe move to Format* funcs?
 		if opts.FillGaps {
-			d.FillGaps(ranges.Range{Start: 0, Len: decodeRange.Len}, "gap")
+			gapsRange := ranges.Range{Start: 0, Len: decodeRange.Len}
+			if opts.FillGapsEnd {
+				gapsRange.Len = min(d.decodedEnd(), decodeRange.Len)
+			}
+			d.FillGaps(gapsRange, "gap")
 		}
 
 		var minMaxRange ranges.Range
@@ -186,6 +223,8 @@ type D struct {
 	readBuf *[]byte
 
 	inArgs []any
+
+	eager bool // out values of sub formats are needed, see EagerFn
 }
 
 // TODO: new struct decoder?
@@ -237,6 +276,22 @@ func (d *D) ArgAs(target any) bool {
 	return false
 }
 
+// NewReadDecoder returns a decoder for reading from br that is not part of a
+// decode, ex: used by decoders written in jq to read one value at a time.
+// Only Try* read functions should be used as the others panic on error.
+func NewReadDecoder(ctx context.Context, br bitio.ReaderAtSeeker) *D {
+	return &D{
+		Ctx:    ctx,
+		Endian: BigEndian,
+		Value: &Value{
+			V:          &Compound{},
+			RootReader: br,
+		},
+
+		bitBuf: br,
+	}
+}
+
 func (d *D) fieldDecoder(name string, bitBuf bitio.ReaderAtSeeker, v any) *D {
 	return &D{
 		Ctx:    d.Ctx,
@@ -323,6 +378,16 @@ func (d *D) SharedReadBuf(n int) []byte {
 	return (*d.readBuf)[:n]
 }
 
+// decodedEnd returns the end of decoded values rounded up to whole bytes
+func (d *D) decodedEnd() int64 {
+	var end int64
+	_ = d.Value.WalkRootPreOrder(func(iv *Value, _ *Value, _ int, _ int) error {
+		end = max(end, iv.Range.Stop())
+		return nil
+	})
+	return (end + 7) / 8 * 8
+}
+
 func (d *D) FillGaps(r ranges.Range, namePrefix string) {
 	makeWalkFn := func(fn func(iv *Value)) func(iv *Value, rootV *Value, depth int, rootDepth int) error {
 		return func(iv *Value, _ *Value, _ int, _ int) error {
@@ -354,13 +419,14 @@ func (d *D) FillGaps(r ranges.Range, namePrefix string) {
 		}
 
 		v := &Value{
-			Name: fmt.Sprintf("%s%d", namePrefix, i),
+			Name: gapName(namePrefix, i),
 			V: &scalar.BitBuf{
 				Actual: br,
 				Flags:  scalar.FlagGap,
 			},
 			RootReader: d.bitBuf,
 			Range:      gap,
+			Encoding:   encodingRaw,
 		}
 
 		// TODO: for arrays not great that we just append gap fields
@@ -368,6 +434,22 @@ func (d *D) FillGaps(r ranges.Range, namePrefix string) {
 	}
 }
 
+// preallocated gap names to not allocate the same name strings for each decode
+var gapNames = func() []string {
+	ns := make([]string, 32)
+	for i := range ns {
+		ns[i] = "gap" + strconv.Itoa(i)
+	}
+	return ns
+}()
+
+func gapName(prefix string, i int) string {
+	if prefix == "gap" && i < len(gapNames) {
+		return gapNames[i]
+	}
+	return prefix + strconv.Itoa(i)
+}
+
 // Errorf stops decode with a reason unless forced
 func (d *D) Errorf(format string, a ...any) {
 	if !d.Options.Force {
@@ -796,15 +878,11 @@ func (d *D) AddChild(v *Value) {
 	switch fv := d.Value.V.(type) {
 	case *Compound:
 		if !fv.IsArray {
-			if fv.ByName == nil {
-				fv.ByName = make(map[string]*Value)
-			}
 			if _, ok := fv.ByName[v.Name]; ok {
 				d.Fatalf("%q already exist in struct %s", v.Name, d.Value.Name)
 			}
-			fv.ByName[v.Name] = v
 		}
-		fv.Children = append(fv.Children, v)
+		fv.add(v)
 	}
 }
 
@@ -818,10 +896,8 @@ func (d *D) FieldGet(name string) *Value {
 				}
 			}
 		} else {
-			if fv.ByName != nil {
-				if ff, ok := fv.ByName[name]; ok {
-					return ff
-				}
+			if ff, ok := fv.ByName[name]; ok {
+				return ff
 			}
 			return nil
 		}
@@ -969,16 +1045,12 @@ func (d *D) RangeFn(firstBit int64, nBits int64, fn func(d *D)) int64 {
 }
 
 func (d *D) Format(group *Group, inArg any) any {
-	dv, v, err := decode(d.Ctx, d.bitBuf, group, Options{
-		Force:       d.Options.Force,
-		FillGaps:    false,
-		IsRoot:      false,
-		Range:       ranges.Range{Start: d.Pos(), Len: d.BitsLeft()},
-		InArg:       inArg,
-		ParseOptsFn: d.Options.ParseOptsFn,
-		ReadBuf:     d.readBuf,
-	})
-	if dv == nil || dv.Errors() != nil {
+	// decoded as part of current format so same depth and no include/exclude
+	opts := d.subFormatOptions("", ranges.Range{Start: d.Pos(), Len: d.BitsLeft()}, inArg)
+	opts.FillGaps = false
+	opts.depth = d.Options.depth
+	dv, v, err := decode(d.Ctx, d.bitBuf, group, opts)
+	if dv == nil || dv.errors(false) != nil {
 		d.IOPanic(err, "", "Format: decode")
 	}
 
@@ -999,17 +1071,24 @@ func (d *D) Format(group *Group, inArg any) any {
 }
 
 func (d *D) TryFieldFormat(name string, group *Group, inArg any) (*Value, any, error) {
-	dv, v, err := decode(d.Ctx, d.bitBuf, group, Options{
-		Name:        name,
-		Force:       d.Options.Force,
-		FillGaps:    false,
-		IsRoot:      false,
-		Range:       ranges.Range{Start: d.Pos(), Len: d.BitsLeft()},
-		InArg:       inArg,
-		ParseOptsFn: d.Options.ParseOptsFn,
-		ReadBuf:     d.readBuf,
-	})
-	if dv == nil || dv.Errors() != nil {
+	subGroup := d.subFormatGroup(group)
+	if subGroup == nil {
+		// length is not known so have to decode even if skipped
+		dv, v, err := d.decodeSkippedFormat(name, ranges.Range{Start: d.Pos(), Len: d.BitsLeft()}, group, inArg)
+		if dv == nil {
+			return nil, nil, err
+		}
+		d.AddChild(dv)
+		if _, err := d.bitBuf.SeekBits(dv.Range.Len, io.SeekCurrent); err != nil {
+			d.IOPanic(err, name, "TryFieldFormat: SeekRel")
+		}
+		return dv, v, nil
+	}
+
+	opts := d.subFormatOptions(name, ranges.Range{Start: d.Pos(), Len: d.BitsLeft()}, inArg)
+	opts.FillGaps = false
+	dv, v, err := decode(d.Ctx, d.bitBuf, subGroup, opts)
+	if dv == nil || dv.errors(false) != nil {
 		return nil, nil, err
 	}
 
@@ -1023,7 +1102,7 @@ func (d *D) TryFieldFormat(name string, group *Group, inArg any) (*Value, any, e
 
 func (d *D) FieldFormat(name string, group *Group, inArg any) (*Value, any) {
 	dv, v, err := d.TryFieldFormat(name, group, inArg)
-	if dv == nil || dv.Errors() != nil {
+	if dv == nil || dv.errors(false) != nil {
 		d.IOPanic(err, name, "FieldFormat: TryFieldFormat")
 	}
 	return dv, v
@@ -1037,18 +1116,26 @@ func (d *D) FieldFormatOrRaw(name string, group *Group, inArg any) (*Value, any)
 	return dv, v
 }
 
+// TryFieldFormatLen decodes a sub format with known length. The out value is nil
+// if the sub format is lazy or skipped, use EagerFn if the out value is needed.
 func (d *D) TryFieldFormatLen(name string, nBits int64, group *Group, inArg any) (*Value, any, error) {
-	dv, v, err := decode(d.Ctx, d.bitBuf, group, Options{
-		Name:        name,
-		Force:       d.Options.Force,
-		FillGaps:    true,
-		IsRoot:      false,
-		Range:       ranges.Range{Start: d.Pos(), Len: nBits},
-		InArg:       inArg,
-		ParseOptsFn: d.Options.ParseOptsFn,
-		ReadBuf:     d.readBuf,
-	})
-	if dv == nil || dv.Errors() != nil {
+	subGroup := d.subFormatGroup(group)
+	if subGroup == nil {
+		v, err := d.skippedFormatOut(name, ranges.Range{Start: d.Pos(), Len: nBits}, group, inArg)
+		if err != nil {
+			return nil, nil, err
+		}
+		dv := d.fieldSkippedFormat(name, d.Pos(), nBits)
+		d.SeekRel(nBits)
+		return dv, v, nil
+	}
+	if dv := d.tryFieldFormatLazy(name, d.Pos(), nBits, subGroup, inArg, false); dv != nil {
+		d.SeekRel(nBits)
+		return dv, nil, nil
+	}
+
+	dv, v, err := decode(d.Ctx, d.bitBuf, subGroup, d.subFormatOptions(name, ranges.Range{Start: d.Pos(), Len: nBits}, inArg))
+	if dv == nil || dv.errors(false) != nil {
 		return nil, nil, err
 	}
 
@@ -1062,13 +1149,19 @@ func (d *D) TryFieldFormatLen(name string, nBits int64, group *Group, inArg any)
 
 func (d *D) FieldFormatLen(name string, nBits int64, group *Group, inArg any) (*Value, any) {
 	dv, v, err := d.TryFieldFormatLen(name, nBits, group, inArg)
-	if dv == nil || dv.Errors() != nil {
+	if dv == nil || dv.errors(false) != nil {
 		d.IOPanic(err, name, "FieldFormatLen: TryFieldFormatLen")
 	}
 	return dv, v
 }
 
 func (d *D) FieldFormatOrRawLen(name string, nBits int64, group *Group, inArg any) (*Value, any) {
+	if subGroup := d.subFormatGroup(group); subGroup != nil {
+		if dv := d.tryFieldFormatLazy(name, d.Pos(), nBits, subGroup, inArg, true); dv != nil {
+			d.SeekRel(nBits)
+			return dv, nil
+		}
+	}
 	dv, v, _ := d.TryFieldFormatLen(name, nBits, group, inArg)
 	if dv == nil {
 		d.FieldRawLen(name, nBits)
@@ -1076,19 +1169,24 @@ func (d *D) FieldFormatOrRawLen(name string, nBits int64, group *Group, inArg an
 	return dv, v
 }
 
+// TryFieldFormatRange decodes a sub format with known range. The out value is nil
+// if the sub format is lazy or skipped, use EagerFn if the out value is needed.
 // TODO: return decooder?
 func (d *D) TryFieldFormatRange(name string, firstBit int64, nBits int64, group *Group, inArg any) (*Value, any, error) {
-	dv, v, err := decode(d.Ctx, d.bitBuf, group, Options{
-		Name:        name,
-		Force:       d.Options.Force,
-		FillGaps:    true,
-		IsRoot:      false,
-		Range:       ranges.Range{Start: firstBit, Len: nBits},
-		InArg:       inArg,
-		ParseOptsFn: d.Options.ParseOptsFn,
-		ReadBuf:     d.readBuf,
-	})
-	if dv == nil || dv.Errors() != nil {
+	subGroup := d.subFormatGroup(group)
+	if subGroup == nil {
+		v, err := d.skippedFormatOut(name, ranges.Range{Start: firstBit, Len: nBits}, group, inArg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return d.fieldSkippedFormat(name, firstBit, nBits), v, nil
+	}
+	if dv := d.tryFieldFormatLazy(name, firstBit, nBits, subGroup, inArg, false); dv != nil {
+		return dv, nil, nil
+	}
+
+	dv, v, err := decode(d.Ctx, d.bitBuf, subGroup, d.subFormatOptions(name, ranges.Range{Start: firstBit, Len: nBits}, inArg))
+	if dv == nil || dv.errors(false) != nil {
 		return nil, nil, err
 	}
 
@@ -1099,7 +1197,7 @@ func (d *D) TryFieldFormatRange(name string, firstBit int64, nBits int64, group
 
 func (d *D) FieldFormatRange(name string, firstBit int64, nBits int64, group *Group, inArg any) (*Value, any) {
 	dv, v, err := d.TryFieldFormatRange(name, firstBit, nBits, group, inArg)
-	if dv == nil || dv.Errors() != nil {
+	if dv == nil || dv.errors(false) != nil {
 		d.IOPanic(err, name, "FieldFormatRange: TryFieldFormatRange")
 	}
 
@@ -1107,16 +1205,17 @@ func (d *D) FieldFormatRange(name string, firstBit int64, nBits int64, group *Gr
 }
 
 func (d *D) TryFieldFormatBitBuf(name string, br bitio.ReaderAtSeeker, group *Group, inArg any) (*Value, any, error) {
-	dv, v, err := decode(d.Ctx, br, group, Options{
-		Name:        name,
-		Force:       d.Options.Force,
-		FillGaps:    true,
-		IsRoot:      true,
-		InArg:       inArg,
-		ParseOptsFn: d.Options.ParseOptsFn,
-		ReadBuf:     d.readBuf,
-	})
-	if dv == nil || dv.Errors() != nil {
+	subGroup := d.subFormatGroup(group)
+	if subGroup == nil {
+		dv := d.FieldRootBitBuf(name, br)
+		dv.V = &scalar.BitBuf{Actual: br, Description: skippedDescription}
+		return dv, nil, nil
+	}
+
+	opts := d.subFormatOptions(name, ranges.Range{}, inArg)
+	opts.IsRoot = true
+	dv, v, err := decode(d.Ctx, br, subGroup, opts)
+	if dv == nil || dv.errors(false) != nil {
 		return nil, nil, err
 	}
 
@@ -1129,7 +1228,7 @@ func (d *D) TryFieldFormatBitBuf(name string, br bitio.ReaderAtSeeker, group *Gr
 
 func (d *D) FieldFormatBitBuf(name string, br bitio.ReaderAtSeeker, group *Group, inArg any) (*Value, any) {
 	dv, v, err := d.TryFieldFormatBitBuf(name, br, group, inArg)
-	if dv == nil || dv.Errors() != nil {
+	if dv == nil || dv.errors(false) != nil {
 		d.IOPanic(err, name, "FieldFormatBitBuf: TryFieldFormatBitBuf")
 	}
 
@@ -1184,6 +1283,115 @@ func (d *D) FieldStructRootBitBufFn(name string, br bitio.ReaderAtSeeker, fn fun
 	return cd.Value
 }
 
+// tryFn runs fn and returns decode errors instead of panicking
+func tryFn(fn func()) error {
+	r, ok := recoverfn.Run(fn)
+	if !ok {
+		if err, ok := r.RecoverV.(error); ok {
+			return err
+		}
+		return fmt.Errorf("recoverable non-panic error :%v", r.RecoverV)
+	}
+	return nil
+}
+
+// TryFieldStructRootBitBufFn same as FieldStructRootBitBufFn but on decode error
+// nothing is added, br is seeked back to where it was and the error is returned
+func (d *D) TryFieldStructRootBitBufFn(name string, br bitio.ReaderAtSeeker, fn func(d *D)) (*Value, error) {
+	brPos, err := br.SeekBits(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	c := &Compound{IsArray: false}
+	cd := d.fieldDecoder(name, br, c)
+	cd.Value.IsRoot = true
+	if err := tryFn(func() { fn(cd) }); err != nil {
+		if _, seekErr := br.SeekBits(brPos, io.SeekStart); seekErr != nil {
+			return nil, seekErr
+		}
+		return nil, err
+	}
+	d.AddChild(cd.Value)
+
+	cd.Value.postProcess()
+
+	return cd.Value, nil
+}
+
+// TryFieldStructLenFn decodes a struct using fn limited to nBits from current
+// position. When done position will be nBits forward. On decode error nothing
+// is added, position is not changed and the error is returned.
+func (d *D) TryFieldStructLenFn(name string, nBits int64, fn func(d *D)) (*Value, error) {
+	start := d.Pos()
+	br, err := d.TryBitBufRange(0, start+nBits)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := br.SeekBits(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	c := &Compound{IsArray: false}
+	cd := d.fieldDecoder(name, br, c)
+	if err := tryFn(func() { fn(cd) }); err != nil {
+		return nil, err
+	}
+	d.AddChild(cd.Value)
+	d.SeekRel(nBits)
+
+	return cd.Value, nil
+}
+
+// TryFn same as calling fn with d but on decode error nothing is added,
+// position is not changed and the error is returned
+func (d *D) TryFn(fn func(d *D)) error {
+	start := d.Pos()
+	// decode into a detached struct and only add the fields if successful
+	c := &Compound{IsArray: false}
+	cd := d.fieldDecoder(d.Value.Name, d.bitBuf, c)
+	if err := tryFn(func() { fn(cd) }); err != nil {
+		d.SeekAbs(start)
+		return err
+	}
+	for _, v := range c.Children {
+		d.AddChild(v)
+	}
+
+	return nil
+}
+
+// TryFramedFn same as FramedFn but on decode error nothing is added, position
+// is not changed and the error is returned
+func (d *D) TryFramedFn(nBits int64, fn func(d *D)) error {
+	return d.TryFn(func(d *D) { d.FramedFn(nBits, fn) })
+}
+
+// FieldStructOrRawLenFn decodes a struct using fn limited to nBits from current
+// position. On decode error the struct is replaced by raw bits with the error
+// set. When done position will be nBits forward.
+func (d *D) FieldStructOrRawLenFn(name string, nBits int64, fn func(d *D)) *Value {
+	v, err := d.TryFieldStructLenFn(name, nBits, fn)
+	if err == nil {
+		return v
+	}
+
+	br, brErr := d.TryBitBufRange(d.Pos(), nBits)
+	if brErr != nil {
+		d.IOPanic(brErr, name, "FieldStructOrRawLenFn: BitBufRange")
+	}
+	v = &Value{
+		Name:       name,
+		V:          &scalar.BitBuf{Actual: br},
+		RootReader: d.bitBuf,
+		Range:      ranges.Range{Start: d.Pos(), Len: nBits},
+		Encoding:   encodingRaw,
+		Err:        err,
+	}
+	d.AddChild(v)
+	d.SeekRel(nBits)
+
+	return v
+}
+
 // TODO: range?
 func (d *D) FieldFormatReaderLen(name string, nBits int64, fn func(r io.Reader) (io.ReadCloser, error), group *Group) (*Value, any) {
 	br, err := d.TryBitBufLen(nBits)
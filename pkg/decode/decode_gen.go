@@ -14,7 +14,7 @@ import (
 
 // TryFieldAnyScalarFn tries to add a field, calls scalar functions and returns actual value as a Any
 func (d *D) TryFieldAnyScalarFn(name string, fn func(d *D) (scalar.Any, error), sms ...scalar.AnyMapper) (any, error) {
-	v, err := d.TryFieldScalarAnyFn(name, func(d *D) (scalar.Any, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarAnyFn(name, nil, fn, sms...)
 	if err != nil {
 		return nil, err
 	}
@@ -23,7 +23,7 @@ func (d *D) TryFieldAnyScalarFn(name string, fn func(d *D) (scalar.Any, error),
 
 // FieldAnyScalarFn adds a field, calls scalar functions and returns actual value as a Any
 func (d *D) FieldAnyScalarFn(name string, fn func(d *D) scalar.Any, sms ...scalar.AnyMapper) any {
-	v, err := d.TryFieldScalarAnyFn(name, func(d *D) (scalar.Any, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarAnyFn(name, nil, func(d *D) (scalar.Any, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Any")
 	}
@@ -58,34 +58,56 @@ func (d *D) FieldValueAny(name string, a any, sms ...scalar.AnyMapper) {
 
 // TryFieldScalarAnyFn tries to add a field, calls any decode function and returns scalar
 func (d *D) TryFieldScalarAnyFn(name string, fn func(d *D) (scalar.Any, error), sms ...scalar.AnyMapper) (*scalar.Any, error) {
+	s, err := d.tryFieldScalarAnyFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Any{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarAnyFn same as TryFieldScalarAnyFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarAnyFn(name string, e *Encoding, fn func(d *D) (scalar.Any, error), sms ...scalar.AnyMapper) (scalar.Any, error) {
+	var s scalar.Any
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
 		for _, sm := range sms {
 			s, err = sm.MapAny(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if len(sms) > 0 {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Any{}, err
+		return scalar.Any{}, err
 	}
-	sr, ok := v.V.(*scalar.Any)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type BigInt
 
 // TryFieldBigIntScalarFn tries to add a field, calls scalar functions and returns actual value as a BigInt
 func (d *D) TryFieldBigIntScalarFn(name string, fn func(d *D) (scalar.BigInt, error), sms ...scalar.BigIntMapper) (*big.Int, error) {
-	v, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarBigIntFn(name, nil, fn, sms...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +116,7 @@ func (d *D) TryFieldBigIntScalarFn(name string, fn func(d *D) (scalar.BigInt, er
 
 // FieldBigIntScalarFn adds a field, calls scalar functions and returns actual value as a BigInt
 func (d *D) FieldBigIntScalarFn(name string, fn func(d *D) scalar.BigInt, sms ...scalar.BigIntMapper) *big.Int {
-	v, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "BigInt")
 	}
@@ -129,34 +151,57 @@ func (d *D) FieldValueBigInt(name string, a *big.Int, sms ...scalar.BigIntMapper
 
 // TryFieldScalarBigIntFn tries to add a field, calls *big.Int decode function and returns scalar
 func (d *D) TryFieldScalarBigIntFn(name string, fn func(d *D) (scalar.BigInt, error), sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.BigInt{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarBigIntFn same as TryFieldScalarBigIntFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarBigIntFn(name string, e *Encoding, fn func(d *D) (scalar.BigInt, error), sms ...scalar.BigIntMapper) (scalar.BigInt, error) {
+	var s scalar.BigInt
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapBigInt(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a.Cmp(b) == 0) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.BigInt{}, err
+		return scalar.BigInt{}, err
 	}
-	sr, ok := v.V.(*scalar.BigInt)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type BitBuf
 
 // TryFieldBitBufScalarFn tries to add a field, calls scalar functions and returns actual value as a BitBuf
 func (d *D) TryFieldBitBufScalarFn(name string, fn func(d *D) (scalar.BitBuf, error), sms ...scalar.BitBufMapper) (bitio.ReaderAtSeeker, error) {
-	v, err := d.TryFieldScalarBitBufFn(name, func(d *D) (scalar.BitBuf, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarBitBufFn(name, nil, fn, sms...)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +210,7 @@ func (d *D) TryFieldBitBufScalarFn(name string, fn func(d *D) (scalar.BitBuf, er
 
 // FieldBitBufScalarFn adds a field, calls scalar functions and returns actual value as a BitBuf
 func (d *D) FieldBitBufScalarFn(name string, fn func(d *D) scalar.BitBuf, sms ...scalar.BitBufMapper) bitio.ReaderAtSeeker {
-	v, err := d.TryFieldScalarBitBufFn(name, func(d *D) (scalar.BitBuf, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarBitBufFn(name, nil, func(d *D) (scalar.BitBuf, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "BitBuf")
 	}
@@ -200,34 +245,57 @@ func (d *D) FieldValueBitBuf(name string, a bitio.ReaderAtSeeker, sms ...scalar.
 
 // TryFieldScalarBitBufFn tries to add a field, calls bitio.ReaderAtSeeker decode function and returns scalar
 func (d *D) TryFieldScalarBitBufFn(name string, fn func(d *D) (scalar.BitBuf, error), sms ...scalar.BitBufMapper) (*scalar.BitBuf, error) {
+	s, err := d.tryFieldScalarBitBufFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.BitBuf{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarBitBufFn same as TryFieldScalarBitBufFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarBitBufFn(name string, e *Encoding, fn func(d *D) (scalar.BitBuf, error), sms ...scalar.BitBufMapper) (scalar.BitBuf, error) {
+	var s scalar.BitBuf
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapBitBuf(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.BitBuf{}, err
+		return scalar.BitBuf{}, err
 	}
-	sr, ok := v.V.(*scalar.BitBuf)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type Bool
 
 // TryFieldBoolScalarFn tries to add a field, calls scalar functions and returns actual value as a Bool
 func (d *D) TryFieldBoolScalarFn(name string, fn func(d *D) (scalar.Bool, error), sms ...scalar.BoolMapper) (bool, error) {
-	v, err := d.TryFieldScalarBoolFn(name, func(d *D) (scalar.Bool, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarBoolFn(name, nil, fn, sms...)
 	if err != nil {
 		return false, err
 	}
@@ -236,7 +304,7 @@ func (d *D) TryFieldBoolScalarFn(name string, fn func(d *D) (scalar.Bool, error)
 
 // FieldBoolScalarFn adds a field, calls scalar functions and returns actual value as a Bool
 func (d *D) FieldBoolScalarFn(name string, fn func(d *D) scalar.Bool, sms ...scalar.BoolMapper) bool {
-	v, err := d.TryFieldScalarBoolFn(name, func(d *D) (scalar.Bool, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarBoolFn(name, nil, func(d *D) (scalar.Bool, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Bool")
 	}
@@ -271,34 +339,57 @@ func (d *D) FieldValueBool(name string, a bool, sms ...scalar.BoolMapper) {
 
 // TryFieldScalarBoolFn tries to add a field, calls bool decode function and returns scalar
 func (d *D) TryFieldScalarBoolFn(name string, fn func(d *D) (scalar.Bool, error), sms ...scalar.BoolMapper) (*scalar.Bool, error) {
+	s, err := d.tryFieldScalarBoolFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Bool{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarBoolFn same as TryFieldScalarBoolFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarBoolFn(name string, e *Encoding, fn func(d *D) (scalar.Bool, error), sms ...scalar.BoolMapper) (scalar.Bool, error) {
+	var s scalar.Bool
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapBool(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Bool{}, err
+		return scalar.Bool{}, err
 	}
-	sr, ok := v.V.(*scalar.Bool)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type Flt
 
 // TryFieldFltScalarFn tries to add a field, calls scalar functions and returns actual value as a Flt
 func (d *D) TryFieldFltScalarFn(name string, fn func(d *D) (scalar.Flt, error), sms ...scalar.FltMapper) (float64, error) {
-	v, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarFltFn(name, nil, fn, sms...)
 	if err != nil {
 		return 0, err
 	}
@@ -307,7 +398,7 @@ func (d *D) TryFieldFltScalarFn(name string, fn func(d *D) (scalar.Flt, error),
 
 // FieldFltScalarFn adds a field, calls scalar functions and returns actual value as a Flt
 func (d *D) FieldFltScalarFn(name string, fn func(d *D) scalar.Flt, sms ...scalar.FltMapper) float64 {
-	v, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Flt")
 	}
@@ -342,34 +433,57 @@ func (d *D) FieldValueFlt(name string, a float64, sms ...scalar.FltMapper) {
 
 // TryFieldScalarFltFn tries to add a field, calls float64 decode function and returns scalar
 func (d *D) TryFieldScalarFltFn(name string, fn func(d *D) (scalar.Flt, error), sms ...scalar.FltMapper) (*scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Flt{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarFltFn same as TryFieldScalarFltFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarFltFn(name string, e *Encoding, fn func(d *D) (scalar.Flt, error), sms ...scalar.FltMapper) (scalar.Flt, error) {
+	var s scalar.Flt
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapFlt(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Flt{}, err
+		return scalar.Flt{}, err
 	}
-	sr, ok := v.V.(*scalar.Flt)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type Sint
 
 // TryFieldSintScalarFn tries to add a field, calls scalar functions and returns actual value as a Sint
 func (d *D) TryFieldSintScalarFn(name string, fn func(d *D) (scalar.Sint, error), sms ...scalar.SintMapper) (int64, error) {
-	v, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarSintFn(name, nil, fn, sms...)
 	if err != nil {
 		return 0, err
 	}
@@ -378,7 +492,7 @@ func (d *D) TryFieldSintScalarFn(name string, fn func(d *D) (scalar.Sint, error)
 
 // FieldSintScalarFn adds a field, calls scalar functions and returns actual value as a Sint
 func (d *D) FieldSintScalarFn(name string, fn func(d *D) scalar.Sint, sms ...scalar.SintMapper) int64 {
-	v, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarSintFn(name, nil, func(d *D) (scalar.Sint, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Sint")
 	}
@@ -413,34 +527,57 @@ func (d *D) FieldValueSint(name string, a int64, sms ...scalar.SintMapper) {
 
 // TryFieldScalarSintFn tries to add a field, calls int64 decode function and returns scalar
 func (d *D) TryFieldScalarSintFn(name string, fn func(d *D) (scalar.Sint, error), sms ...scalar.SintMapper) (*scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Sint{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarSintFn same as TryFieldScalarSintFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarSintFn(name string, e *Encoding, fn func(d *D) (scalar.Sint, error), sms ...scalar.SintMapper) (scalar.Sint, error) {
+	var s scalar.Sint
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapSint(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Sint{}, err
+		return scalar.Sint{}, err
 	}
-	sr, ok := v.V.(*scalar.Sint)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type Str
 
 // TryFieldStrScalarFn tries to add a field, calls scalar functions and returns actual value as a Str
 func (d *D) TryFieldStrScalarFn(name string, fn func(d *D) (scalar.Str, error), sms ...scalar.StrMapper) (string, error) {
-	v, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarStrFn(name, nil, fn, sms...)
 	if err != nil {
 		return "", err
 	}
@@ -449,7 +586,7 @@ func (d *D) TryFieldStrScalarFn(name string, fn func(d *D) (scalar.Str, error),
 
 // FieldStrScalarFn adds a field, calls scalar functions and returns actual value as a Str
 func (d *D) FieldStrScalarFn(name string, fn func(d *D) scalar.Str, sms ...scalar.StrMapper) string {
-	v, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Str")
 	}
@@ -484,34 +621,57 @@ func (d *D) FieldValueStr(name string, a string, sms ...scalar.StrMapper) {
 
 // TryFieldScalarStrFn tries to add a field, calls string decode function and returns scalar
 func (d *D) TryFieldScalarStrFn(name string, fn func(d *D) (scalar.Str, error), sms ...scalar.StrMapper) (*scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Str{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarStrFn same as TryFieldScalarStrFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarStrFn(name string, e *Encoding, fn func(d *D) (scalar.Str, error), sms ...scalar.StrMapper) (scalar.Str, error) {
+	var s scalar.Str
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapStr(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Str{}, err
+		return scalar.Str{}, err
 	}
-	sr, ok := v.V.(*scalar.Str)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Type Uint
 
 // TryFieldUintScalarFn tries to add a field, calls scalar functions and returns actual value as a Uint
 func (d *D) TryFieldUintScalarFn(name string, fn func(d *D) (scalar.Uint, error), sms ...scalar.UintMapper) (uint64, error) {
-	v, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) { return fn(d) }, sms...)
+	v, err := d.tryFieldScalarUintFn(name, nil, fn, sms...)
 	if err != nil {
 		return 0, err
 	}
@@ -520,7 +680,7 @@ func (d *D) TryFieldUintScalarFn(name string, fn func(d *D) (scalar.Uint, error)
 
 // FieldUintScalarFn adds a field, calls scalar functions and returns actual value as a Uint
 func (d *D) FieldUintScalarFn(name string, fn func(d *D) scalar.Uint, sms ...scalar.UintMapper) uint64 {
-	v, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) { return fn(d), nil }, sms...)
+	v, err := d.tryFieldScalarUintFn(name, nil, func(d *D) (scalar.Uint, error) { return fn(d), nil }, sms...)
 	if err != nil {
 		d.IOPanic(err, name, "Uint")
 	}
@@ -555,27 +715,50 @@ func (d *D) FieldValueUint(name string, a uint64, sms ...scalar.UintMapper) {
 
 // TryFieldScalarUintFn tries to add a field, calls uint64 decode function and returns scalar
 func (d *D) TryFieldScalarUintFn(name string, fn func(d *D) (scalar.Uint, error), sms ...scalar.UintMapper) (*scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, nil, fn, sms...)
+	if err != nil {
+		return &scalar.Uint{}, err
+	}
+	return &s, nil
+}
+
+// tryFieldScalarUintFn same as TryFieldScalarUintFn but also records how the value was encoded
+// and returns the scalar by value. Values with only an actual value are stored compact.
+func (d *D) tryFieldScalarUintFn(name string, e *Encoding, fn func(d *D) (scalar.Uint, error), sms ...scalar.UintMapper) (scalar.Uint, error) {
+	var s scalar.Uint
+	var warnings []string
 	v, err := d.TryFieldValue(name, func() (*Value, error) {
-		s, err := fn(d)
+		var err error
+		s, err = fn(d)
 		if err != nil {
-			return &Value{V: &s}, err
+			es := s
+			return &Value{V: &es}, err
 		}
+		a := s.Actual
 		for _, sm := range sms {
 			s, err = sm.MapUint(s)
+			if ve, ok := err.(ValidateError); ok {
+				warnings = append(warnings, ve.Reason)
+				continue
+			}
 			if err != nil {
-				return &Value{V: &s}, err
+				es := s
+				return &Value{V: &es}, err
 			}
 		}
-		return &Value{V: &s}, nil
+		// actual value changed by a mapper, ex: trimmed or converted, can't be encoded back
+		if b := s.Actual; len(sms) > 0 && !(a == b) {
+			e = nil
+		}
+		return &Value{V: s.Compact(), Encoding: e}, nil
 	})
 	if err != nil {
-		return &scalar.Uint{}, err
+		return scalar.Uint{}, err
 	}
-	sr, ok := v.V.(*scalar.Uint)
-	if !ok {
-		panic("not a scalar value")
+	for _, w := range warnings {
+		v.addWarning(w)
 	}
-	return sr, nil
+	return s, nil
 }
 
 // Require/Assert/Validate BigInt
@@ -596,7 +779,7 @@ func requireBigInt(name string, s scalar.BigInt, desc bool, fail bool, vs ...*bi
 	if fail {
 		return s, fmt.Errorf("failed to %s BigInt", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s BigInt", name)}
 }
 
 // BigIntRequire that actual value is one of given *big.Int values
@@ -632,7 +815,7 @@ func requireRangeBigInt(name string, s scalar.BigInt, desc bool, fail bool, star
 	if fail {
 		return s, fmt.Errorf("failed to %s BigInt range %v-%v", name, start, end)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s BigInt range %v-%v", name, start, end)}
 }
 
 // BigIntRequireRange require that actual value is in range
@@ -674,7 +857,7 @@ func requireBool(name string, s scalar.Bool, desc bool, fail bool, vs ...bool) (
 	if fail {
 		return s, fmt.Errorf("failed to %s Bool", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Bool", name)}
 }
 
 // BoolRequire that actual value is one of given bool values
@@ -712,7 +895,7 @@ func requireFlt(name string, s scalar.Flt, desc bool, fail bool, vs ...float64)
 	if fail {
 		return s, fmt.Errorf("failed to %s Flt", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Flt", name)}
 }
 
 // FltRequire that actual value is one of given float64 values
@@ -746,7 +929,7 @@ func requireRangeFlt(name string, s scalar.Flt, desc bool, fail bool, start, end
 	if fail {
 		return s, fmt.Errorf("failed to %s Flt range %v-%v", name, start, end)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Flt range %v-%v", name, start, end)}
 }
 
 // FltRequireRange require that actual value is in range
@@ -784,7 +967,7 @@ func requireSint(name string, s scalar.Sint, desc bool, fail bool, vs ...int64)
 	if fail {
 		return s, fmt.Errorf("failed to %s Sint", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Sint", name)}
 }
 
 // SintRequire that actual value is one of given int64 values
@@ -820,7 +1003,7 @@ func requireRangeSint(name string, s scalar.Sint, desc bool, fail bool, start, e
 	if fail {
 		return s, fmt.Errorf("failed to %s Sint range %v-%v", name, start, end)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Sint range %v-%v", name, start, end)}
 }
 
 // SintRequireRange require that actual value is in range
@@ -862,7 +1045,7 @@ func requireStr(name string, s scalar.Str, desc bool, fail bool, vs ...string) (
 	if fail {
 		return s, fmt.Errorf("failed to %s Str", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Str", name)}
 }
 
 // StrRequire that actual value is one of given string values
@@ -896,7 +1079,7 @@ func requireRangeStr(name string, s scalar.Str, desc bool, fail bool, start, end
 	if fail {
 		return s, fmt.Errorf("failed to %s Str range %v-%v", name, start, end)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Str range %v-%v", name, start, end)}
 }
 
 // StrRequireRange require that actual value is in range
@@ -934,7 +1117,7 @@ func requireUint(name string, s scalar.Uint, desc bool, fail bool, vs ...uint64)
 	if fail {
 		return s, fmt.Errorf("failed to %s Uint", name)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Uint", name)}
 }
 
 // UintRequire that actual value is one of given uint64 values
@@ -970,7 +1153,7 @@ func requireRangeUint(name string, s scalar.Uint, desc bool, fail bool, start, e
 	if fail {
 		return s, fmt.Errorf("failed to %s Uint range %v-%v", name, start, end)
 	}
-	return s, nil
+	return s, ValidateError{Reason: fmt.Sprintf("failed to %s Uint range %v-%v", name, start, end)}
 }
 
 // UintRequireRange require that actual value is in range
@@ -1010,14 +1193,14 @@ func (d *D) RawLen(nBits int64) bitio.ReaderAtSeeker {
 
 // TryFieldScalarRawLen tries to add a field and read nBits raw bits
 func (d *D) TryFieldScalarRawLen(name string, nBits int64, sms ...scalar.BitBufMapper) (*scalar.BitBuf, error) {
-	s, err := d.TryFieldScalarBitBufFn(name, func(d *D) (scalar.BitBuf, error) {
+	s, err := d.tryFieldScalarBitBufFn(name, encodingRaw, func(d *D) (scalar.BitBuf, error) {
 		v, err := d.tryBitBuf(nBits)
 		return scalar.BitBuf{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarRawLen adds a field and reads nBits raw bits
@@ -1031,13 +1214,20 @@ func (d *D) FieldScalarRawLen(name string, nBits int64, sms ...scalar.BitBufMapp
 
 // TryFieldRawLen tries to add a field and read nBits raw bits
 func (d *D) TryFieldRawLen(name string, nBits int64, sms ...scalar.BitBufMapper) (bitio.ReaderAtSeeker, error) {
-	s, err := d.TryFieldScalarRawLen(name, nBits, sms...)
+	s, err := d.tryFieldScalarBitBufFn(name, encodingRaw, func(d *D) (scalar.BitBuf, error) {
+		v, err := d.tryBitBuf(nBits)
+		return scalar.BitBuf{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldRawLen adds a field and reads nBits raw bits
 func (d *D) FieldRawLen(name string, nBits int64, sms ...scalar.BitBufMapper) bitio.ReaderAtSeeker {
-	return d.FieldScalarRawLen(name, nBits, sms...).Actual
+	s, err := d.TryFieldRawLen(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "RawLen")
+	}
+	return s
 }
 
 // Reader Bool
@@ -1056,14 +1246,14 @@ func (d *D) Bool() bool {
 
 // TryFieldScalarBool tries to add a field and read 1 bit boolean
 func (d *D) TryFieldScalarBool(name string, sms ...scalar.BoolMapper) (*scalar.Bool, error) {
-	s, err := d.TryFieldScalarBoolFn(name, func(d *D) (scalar.Bool, error) {
+	s, err := d.tryFieldScalarBoolFn(name, encodingBool, func(d *D) (scalar.Bool, error) {
 		v, err := d.tryBool()
 		return scalar.Bool{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarBool adds a field and reads 1 bit boolean
@@ -1077,13 +1267,20 @@ func (d *D) FieldScalarBool(name string, sms ...scalar.BoolMapper) *scalar.Bool
 
 // TryFieldBool tries to add a field and read 1 bit boolean
 func (d *D) TryFieldBool(name string, sms ...scalar.BoolMapper) (bool, error) {
-	s, err := d.TryFieldScalarBool(name, sms...)
+	s, err := d.tryFieldScalarBoolFn(name, encodingBool, func(d *D) (scalar.Bool, error) {
+		v, err := d.tryBool()
+		return scalar.Bool{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldBool adds a field and reads 1 bit boolean
 func (d *D) FieldBool(name string, sms ...scalar.BoolMapper) bool {
-	return d.FieldScalarBool(name, sms...).Actual
+	s, err := d.TryFieldBool(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "Bool")
+	}
+	return s
 }
 
 // Reader U
@@ -1102,14 +1299,14 @@ func (d *D) U(nBits int) uint64 {
 
 // TryFieldScalarU tries to add a field and read nBits bits unsigned integer in current endian
 func (d *D) TryFieldScalarU(name string, nBits int, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(nBits, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU adds a field and reads nBits bits unsigned integer in current endian
@@ -1123,13 +1320,20 @@ func (d *D) FieldScalarU(name string, nBits int, sms ...scalar.UintMapper) *scal
 
 // TryFieldU tries to add a field and read nBits bits unsigned integer in current endian
 func (d *D) TryFieldU(name string, nBits int, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU(name, nBits, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(nBits, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU adds a field and reads nBits bits unsigned integer in current endian
 func (d *D) FieldU(name string, nBits int, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU(name, nBits, sms...).Actual
+	s, err := d.TryFieldU(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U")
+	}
+	return s
 }
 
 // Reader UE
@@ -1148,14 +1352,14 @@ func (d *D) UE(nBits int, endian Endian) uint64 {
 
 // TryFieldScalarUE tries to add a field and read nBits unsigned integer in specified endian
 func (d *D) TryFieldScalarUE(name string, nBits int, endian Endian, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(nBits, endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUE adds a field and reads nBits unsigned integer in specified endian
@@ -1169,13 +1373,20 @@ func (d *D) FieldScalarUE(name string, nBits int, endian Endian, sms ...scalar.U
 
 // TryFieldUE tries to add a field and read nBits unsigned integer in specified endian
 func (d *D) TryFieldUE(name string, nBits int, endian Endian, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarUE(name, nBits, endian, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(nBits, endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUE adds a field and reads nBits unsigned integer in specified endian
 func (d *D) FieldUE(name string, nBits int, endian Endian, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarUE(name, nBits, endian, sms...).Actual
+	s, err := d.TryFieldUE(name, nBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UE")
+	}
+	return s
 }
 
 // Reader U1
@@ -1194,14 +1405,14 @@ func (d *D) U1() uint64 {
 
 // TryFieldScalarU1 tries to add a field and read 1 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU1(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(1, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU1 adds a field and reads 1 bit unsigned integer in current endian
@@ -1215,13 +1426,20 @@ func (d *D) FieldScalarU1(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU1 tries to add a field and read 1 bit unsigned integer in current endian
 func (d *D) TryFieldU1(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU1(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(1, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU1 adds a field and reads 1 bit unsigned integer in current endian
 func (d *D) FieldU1(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU1(name, sms...).Actual
+	s, err := d.TryFieldU1(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U1")
+	}
+	return s
 }
 
 // Reader U2
@@ -1240,14 +1458,14 @@ func (d *D) U2() uint64 {
 
 // TryFieldScalarU2 tries to add a field and read 2 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU2(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(2, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU2 adds a field and reads 2 bit unsigned integer in current endian
@@ -1261,13 +1479,20 @@ func (d *D) FieldScalarU2(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU2 tries to add a field and read 2 bit unsigned integer in current endian
 func (d *D) TryFieldU2(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU2(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(2, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU2 adds a field and reads 2 bit unsigned integer in current endian
 func (d *D) FieldU2(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU2(name, sms...).Actual
+	s, err := d.TryFieldU2(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U2")
+	}
+	return s
 }
 
 // Reader U3
@@ -1286,14 +1511,14 @@ func (d *D) U3() uint64 {
 
 // TryFieldScalarU3 tries to add a field and read 3 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU3(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(3, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU3 adds a field and reads 3 bit unsigned integer in current endian
@@ -1307,13 +1532,20 @@ func (d *D) FieldScalarU3(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU3 tries to add a field and read 3 bit unsigned integer in current endian
 func (d *D) TryFieldU3(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU3(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(3, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU3 adds a field and reads 3 bit unsigned integer in current endian
 func (d *D) FieldU3(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU3(name, sms...).Actual
+	s, err := d.TryFieldU3(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U3")
+	}
+	return s
 }
 
 // Reader U4
@@ -1332,14 +1564,14 @@ func (d *D) U4() uint64 {
 
 // TryFieldScalarU4 tries to add a field and read 4 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU4(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(4, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU4 adds a field and reads 4 bit unsigned integer in current endian
@@ -1353,13 +1585,20 @@ func (d *D) FieldScalarU4(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU4 tries to add a field and read 4 bit unsigned integer in current endian
 func (d *D) TryFieldU4(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU4(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(4, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU4 adds a field and reads 4 bit unsigned integer in current endian
 func (d *D) FieldU4(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU4(name, sms...).Actual
+	s, err := d.TryFieldU4(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U4")
+	}
+	return s
 }
 
 // Reader U5
@@ -1378,14 +1617,14 @@ func (d *D) U5() uint64 {
 
 // TryFieldScalarU5 tries to add a field and read 5 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU5(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(5, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU5 adds a field and reads 5 bit unsigned integer in current endian
@@ -1399,13 +1638,20 @@ func (d *D) FieldScalarU5(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU5 tries to add a field and read 5 bit unsigned integer in current endian
 func (d *D) TryFieldU5(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU5(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(5, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU5 adds a field and reads 5 bit unsigned integer in current endian
 func (d *D) FieldU5(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU5(name, sms...).Actual
+	s, err := d.TryFieldU5(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U5")
+	}
+	return s
 }
 
 // Reader U6
@@ -1424,14 +1670,14 @@ func (d *D) U6() uint64 {
 
 // TryFieldScalarU6 tries to add a field and read 6 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU6(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(6, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU6 adds a field and reads 6 bit unsigned integer in current endian
@@ -1445,13 +1691,20 @@ func (d *D) FieldScalarU6(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU6 tries to add a field and read 6 bit unsigned integer in current endian
 func (d *D) TryFieldU6(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU6(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(6, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU6 adds a field and reads 6 bit unsigned integer in current endian
 func (d *D) FieldU6(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU6(name, sms...).Actual
+	s, err := d.TryFieldU6(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U6")
+	}
+	return s
 }
 
 // Reader U7
@@ -1470,14 +1723,14 @@ func (d *D) U7() uint64 {
 
 // TryFieldScalarU7 tries to add a field and read 7 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU7(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(7, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU7 adds a field and reads 7 bit unsigned integer in current endian
@@ -1491,13 +1744,20 @@ func (d *D) FieldScalarU7(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU7 tries to add a field and read 7 bit unsigned integer in current endian
 func (d *D) TryFieldU7(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU7(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(7, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU7 adds a field and reads 7 bit unsigned integer in current endian
 func (d *D) FieldU7(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU7(name, sms...).Actual
+	s, err := d.TryFieldU7(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U7")
+	}
+	return s
 }
 
 // Reader U8
@@ -1516,14 +1776,14 @@ func (d *D) U8() uint64 {
 
 // TryFieldScalarU8 tries to add a field and read 8 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU8(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(8, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU8 adds a field and reads 8 bit unsigned integer in current endian
@@ -1537,13 +1797,20 @@ func (d *D) FieldScalarU8(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU8 tries to add a field and read 8 bit unsigned integer in current endian
 func (d *D) TryFieldU8(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU8(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(8, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU8 adds a field and reads 8 bit unsigned integer in current endian
 func (d *D) FieldU8(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU8(name, sms...).Actual
+	s, err := d.TryFieldU8(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U8")
+	}
+	return s
 }
 
 // Reader U9
@@ -1562,14 +1829,14 @@ func (d *D) U9() uint64 {
 
 // TryFieldScalarU9 tries to add a field and read 9 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU9(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(9, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU9 adds a field and reads 9 bit unsigned integer in current endian
@@ -1583,13 +1850,20 @@ func (d *D) FieldScalarU9(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU9 tries to add a field and read 9 bit unsigned integer in current endian
 func (d *D) TryFieldU9(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU9(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(9, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU9 adds a field and reads 9 bit unsigned integer in current endian
 func (d *D) FieldU9(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU9(name, sms...).Actual
+	s, err := d.TryFieldU9(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U9")
+	}
+	return s
 }
 
 // Reader U10
@@ -1608,14 +1882,14 @@ func (d *D) U10() uint64 {
 
 // TryFieldScalarU10 tries to add a field and read 10 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU10(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(10, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU10 adds a field and reads 10 bit unsigned integer in current endian
@@ -1629,13 +1903,20 @@ func (d *D) FieldScalarU10(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU10 tries to add a field and read 10 bit unsigned integer in current endian
 func (d *D) TryFieldU10(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU10(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(10, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU10 adds a field and reads 10 bit unsigned integer in current endian
 func (d *D) FieldU10(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU10(name, sms...).Actual
+	s, err := d.TryFieldU10(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U10")
+	}
+	return s
 }
 
 // Reader U11
@@ -1654,14 +1935,14 @@ func (d *D) U11() uint64 {
 
 // TryFieldScalarU11 tries to add a field and read 11 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU11(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(11, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU11 adds a field and reads 11 bit unsigned integer in current endian
@@ -1675,13 +1956,20 @@ func (d *D) FieldScalarU11(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU11 tries to add a field and read 11 bit unsigned integer in current endian
 func (d *D) TryFieldU11(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU11(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(11, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU11 adds a field and reads 11 bit unsigned integer in current endian
 func (d *D) FieldU11(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU11(name, sms...).Actual
+	s, err := d.TryFieldU11(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U11")
+	}
+	return s
 }
 
 // Reader U12
@@ -1700,14 +1988,14 @@ func (d *D) U12() uint64 {
 
 // TryFieldScalarU12 tries to add a field and read 12 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU12(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(12, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU12 adds a field and reads 12 bit unsigned integer in current endian
@@ -1721,13 +2009,20 @@ func (d *D) FieldScalarU12(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU12 tries to add a field and read 12 bit unsigned integer in current endian
 func (d *D) TryFieldU12(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU12(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(12, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU12 adds a field and reads 12 bit unsigned integer in current endian
 func (d *D) FieldU12(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU12(name, sms...).Actual
+	s, err := d.TryFieldU12(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U12")
+	}
+	return s
 }
 
 // Reader U13
@@ -1746,14 +2041,14 @@ func (d *D) U13() uint64 {
 
 // TryFieldScalarU13 tries to add a field and read 13 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU13(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(13, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU13 adds a field and reads 13 bit unsigned integer in current endian
@@ -1767,13 +2062,20 @@ func (d *D) FieldScalarU13(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU13 tries to add a field and read 13 bit unsigned integer in current endian
 func (d *D) TryFieldU13(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU13(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(13, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU13 adds a field and reads 13 bit unsigned integer in current endian
 func (d *D) FieldU13(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU13(name, sms...).Actual
+	s, err := d.TryFieldU13(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U13")
+	}
+	return s
 }
 
 // Reader U14
@@ -1792,14 +2094,14 @@ func (d *D) U14() uint64 {
 
 // TryFieldScalarU14 tries to add a field and read 14 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU14(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(14, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU14 adds a field and reads 14 bit unsigned integer in current endian
@@ -1813,13 +2115,20 @@ func (d *D) FieldScalarU14(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU14 tries to add a field and read 14 bit unsigned integer in current endian
 func (d *D) TryFieldU14(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU14(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(14, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU14 adds a field and reads 14 bit unsigned integer in current endian
 func (d *D) FieldU14(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU14(name, sms...).Actual
+	s, err := d.TryFieldU14(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U14")
+	}
+	return s
 }
 
 // Reader U15
@@ -1838,14 +2147,14 @@ func (d *D) U15() uint64 {
 
 // TryFieldScalarU15 tries to add a field and read 15 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU15(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(15, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU15 adds a field and reads 15 bit unsigned integer in current endian
@@ -1859,13 +2168,20 @@ func (d *D) FieldScalarU15(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU15 tries to add a field and read 15 bit unsigned integer in current endian
 func (d *D) TryFieldU15(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU15(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(15, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU15 adds a field and reads 15 bit unsigned integer in current endian
 func (d *D) FieldU15(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU15(name, sms...).Actual
+	s, err := d.TryFieldU15(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U15")
+	}
+	return s
 }
 
 // Reader U16
@@ -1884,14 +2200,14 @@ func (d *D) U16() uint64 {
 
 // TryFieldScalarU16 tries to add a field and read 16 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU16(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(16, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU16 adds a field and reads 16 bit unsigned integer in current endian
@@ -1905,13 +2221,20 @@ func (d *D) FieldScalarU16(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU16 tries to add a field and read 16 bit unsigned integer in current endian
 func (d *D) TryFieldU16(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU16(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(16, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU16 adds a field and reads 16 bit unsigned integer in current endian
 func (d *D) FieldU16(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU16(name, sms...).Actual
+	s, err := d.TryFieldU16(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U16")
+	}
+	return s
 }
 
 // Reader U17
@@ -1930,14 +2253,14 @@ func (d *D) U17() uint64 {
 
 // TryFieldScalarU17 tries to add a field and read 17 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU17(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(17, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU17 adds a field and reads 17 bit unsigned integer in current endian
@@ -1951,14 +2274,21 @@ func (d *D) FieldScalarU17(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU17 tries to add a field and read 17 bit unsigned integer in current endian
 func (d *D) TryFieldU17(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU17(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(17, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU17 adds a field and reads 17 bit unsigned integer in current endian
 func (d *D) FieldU17(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU17(name, sms...).Actual
-}
+	s, err := d.TryFieldU17(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U17")
+	}
+	return s
+}
 
 // Reader U18
 
@@ -1976,14 +2306,14 @@ func (d *D) U18() uint64 {
 
 // TryFieldScalarU18 tries to add a field and read 18 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU18(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(18, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU18 adds a field and reads 18 bit unsigned integer in current endian
@@ -1997,13 +2327,20 @@ func (d *D) FieldScalarU18(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU18 tries to add a field and read 18 bit unsigned integer in current endian
 func (d *D) TryFieldU18(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU18(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(18, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU18 adds a field and reads 18 bit unsigned integer in current endian
 func (d *D) FieldU18(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU18(name, sms...).Actual
+	s, err := d.TryFieldU18(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U18")
+	}
+	return s
 }
 
 // Reader U19
@@ -2022,14 +2359,14 @@ func (d *D) U19() uint64 {
 
 // TryFieldScalarU19 tries to add a field and read 19 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU19(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(19, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU19 adds a field and reads 19 bit unsigned integer in current endian
@@ -2043,13 +2380,20 @@ func (d *D) FieldScalarU19(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU19 tries to add a field and read 19 bit unsigned integer in current endian
 func (d *D) TryFieldU19(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU19(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(19, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU19 adds a field and reads 19 bit unsigned integer in current endian
 func (d *D) FieldU19(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU19(name, sms...).Actual
+	s, err := d.TryFieldU19(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U19")
+	}
+	return s
 }
 
 // Reader U20
@@ -2068,14 +2412,14 @@ func (d *D) U20() uint64 {
 
 // TryFieldScalarU20 tries to add a field and read 20 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU20(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(20, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU20 adds a field and reads 20 bit unsigned integer in current endian
@@ -2089,13 +2433,20 @@ func (d *D) FieldScalarU20(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU20 tries to add a field and read 20 bit unsigned integer in current endian
 func (d *D) TryFieldU20(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU20(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(20, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU20 adds a field and reads 20 bit unsigned integer in current endian
 func (d *D) FieldU20(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU20(name, sms...).Actual
+	s, err := d.TryFieldU20(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U20")
+	}
+	return s
 }
 
 // Reader U21
@@ -2114,14 +2465,14 @@ func (d *D) U21() uint64 {
 
 // TryFieldScalarU21 tries to add a field and read 21 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU21(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(21, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU21 adds a field and reads 21 bit unsigned integer in current endian
@@ -2135,13 +2486,20 @@ func (d *D) FieldScalarU21(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU21 tries to add a field and read 21 bit unsigned integer in current endian
 func (d *D) TryFieldU21(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU21(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(21, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU21 adds a field and reads 21 bit unsigned integer in current endian
 func (d *D) FieldU21(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU21(name, sms...).Actual
+	s, err := d.TryFieldU21(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U21")
+	}
+	return s
 }
 
 // Reader U22
@@ -2160,14 +2518,14 @@ func (d *D) U22() uint64 {
 
 // TryFieldScalarU22 tries to add a field and read 22 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU22(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(22, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU22 adds a field and reads 22 bit unsigned integer in current endian
@@ -2181,13 +2539,20 @@ func (d *D) FieldScalarU22(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU22 tries to add a field and read 22 bit unsigned integer in current endian
 func (d *D) TryFieldU22(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU22(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(22, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU22 adds a field and reads 22 bit unsigned integer in current endian
 func (d *D) FieldU22(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU22(name, sms...).Actual
+	s, err := d.TryFieldU22(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U22")
+	}
+	return s
 }
 
 // Reader U23
@@ -2206,14 +2571,14 @@ func (d *D) U23() uint64 {
 
 // TryFieldScalarU23 tries to add a field and read 23 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU23(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(23, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU23 adds a field and reads 23 bit unsigned integer in current endian
@@ -2227,13 +2592,20 @@ func (d *D) FieldScalarU23(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU23 tries to add a field and read 23 bit unsigned integer in current endian
 func (d *D) TryFieldU23(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU23(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(23, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU23 adds a field and reads 23 bit unsigned integer in current endian
 func (d *D) FieldU23(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU23(name, sms...).Actual
+	s, err := d.TryFieldU23(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U23")
+	}
+	return s
 }
 
 // Reader U24
@@ -2252,14 +2624,14 @@ func (d *D) U24() uint64 {
 
 // TryFieldScalarU24 tries to add a field and read 24 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU24(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(24, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU24 adds a field and reads 24 bit unsigned integer in current endian
@@ -2273,13 +2645,20 @@ func (d *D) FieldScalarU24(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU24 tries to add a field and read 24 bit unsigned integer in current endian
 func (d *D) TryFieldU24(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU24(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(24, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU24 adds a field and reads 24 bit unsigned integer in current endian
 func (d *D) FieldU24(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU24(name, sms...).Actual
+	s, err := d.TryFieldU24(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U24")
+	}
+	return s
 }
 
 // Reader U25
@@ -2298,14 +2677,14 @@ func (d *D) U25() uint64 {
 
 // TryFieldScalarU25 tries to add a field and read 25 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU25(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(25, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU25 adds a field and reads 25 bit unsigned integer in current endian
@@ -2319,13 +2698,20 @@ func (d *D) FieldScalarU25(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU25 tries to add a field and read 25 bit unsigned integer in current endian
 func (d *D) TryFieldU25(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU25(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(25, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU25 adds a field and reads 25 bit unsigned integer in current endian
 func (d *D) FieldU25(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU25(name, sms...).Actual
+	s, err := d.TryFieldU25(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U25")
+	}
+	return s
 }
 
 // Reader U26
@@ -2344,14 +2730,14 @@ func (d *D) U26() uint64 {
 
 // TryFieldScalarU26 tries to add a field and read 26 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU26(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(26, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU26 adds a field and reads 26 bit unsigned integer in current endian
@@ -2365,13 +2751,20 @@ func (d *D) FieldScalarU26(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU26 tries to add a field and read 26 bit unsigned integer in current endian
 func (d *D) TryFieldU26(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU26(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(26, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU26 adds a field and reads 26 bit unsigned integer in current endian
 func (d *D) FieldU26(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU26(name, sms...).Actual
+	s, err := d.TryFieldU26(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U26")
+	}
+	return s
 }
 
 // Reader U27
@@ -2390,14 +2783,14 @@ func (d *D) U27() uint64 {
 
 // TryFieldScalarU27 tries to add a field and read 27 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU27(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(27, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU27 adds a field and reads 27 bit unsigned integer in current endian
@@ -2411,13 +2804,20 @@ func (d *D) FieldScalarU27(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU27 tries to add a field and read 27 bit unsigned integer in current endian
 func (d *D) TryFieldU27(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU27(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(27, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU27 adds a field and reads 27 bit unsigned integer in current endian
 func (d *D) FieldU27(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU27(name, sms...).Actual
+	s, err := d.TryFieldU27(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U27")
+	}
+	return s
 }
 
 // Reader U28
@@ -2436,14 +2836,14 @@ func (d *D) U28() uint64 {
 
 // TryFieldScalarU28 tries to add a field and read 28 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU28(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(28, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU28 adds a field and reads 28 bit unsigned integer in current endian
@@ -2457,13 +2857,20 @@ func (d *D) FieldScalarU28(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU28 tries to add a field and read 28 bit unsigned integer in current endian
 func (d *D) TryFieldU28(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU28(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(28, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU28 adds a field and reads 28 bit unsigned integer in current endian
 func (d *D) FieldU28(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU28(name, sms...).Actual
+	s, err := d.TryFieldU28(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U28")
+	}
+	return s
 }
 
 // Reader U29
@@ -2482,14 +2889,14 @@ func (d *D) U29() uint64 {
 
 // TryFieldScalarU29 tries to add a field and read 29 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU29(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(29, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU29 adds a field and reads 29 bit unsigned integer in current endian
@@ -2503,13 +2910,20 @@ func (d *D) FieldScalarU29(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU29 tries to add a field and read 29 bit unsigned integer in current endian
 func (d *D) TryFieldU29(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU29(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(29, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU29 adds a field and reads 29 bit unsigned integer in current endian
 func (d *D) FieldU29(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU29(name, sms...).Actual
+	s, err := d.TryFieldU29(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U29")
+	}
+	return s
 }
 
 // Reader U30
@@ -2528,14 +2942,14 @@ func (d *D) U30() uint64 {
 
 // TryFieldScalarU30 tries to add a field and read 30 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU30(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(30, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU30 adds a field and reads 30 bit unsigned integer in current endian
@@ -2549,13 +2963,20 @@ func (d *D) FieldScalarU30(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU30 tries to add a field and read 30 bit unsigned integer in current endian
 func (d *D) TryFieldU30(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU30(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(30, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU30 adds a field and reads 30 bit unsigned integer in current endian
 func (d *D) FieldU30(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU30(name, sms...).Actual
+	s, err := d.TryFieldU30(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U30")
+	}
+	return s
 }
 
 // Reader U31
@@ -2574,14 +2995,14 @@ func (d *D) U31() uint64 {
 
 // TryFieldScalarU31 tries to add a field and read 31 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU31(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(31, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU31 adds a field and reads 31 bit unsigned integer in current endian
@@ -2595,13 +3016,20 @@ func (d *D) FieldScalarU31(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU31 tries to add a field and read 31 bit unsigned integer in current endian
 func (d *D) TryFieldU31(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU31(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(31, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU31 adds a field and reads 31 bit unsigned integer in current endian
 func (d *D) FieldU31(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU31(name, sms...).Actual
+	s, err := d.TryFieldU31(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U31")
+	}
+	return s
 }
 
 // Reader U32
@@ -2620,14 +3048,14 @@ func (d *D) U32() uint64 {
 
 // TryFieldScalarU32 tries to add a field and read 32 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU32(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(32, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU32 adds a field and reads 32 bit unsigned integer in current endian
@@ -2641,13 +3069,20 @@ func (d *D) FieldScalarU32(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU32 tries to add a field and read 32 bit unsigned integer in current endian
 func (d *D) TryFieldU32(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU32(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(32, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU32 adds a field and reads 32 bit unsigned integer in current endian
 func (d *D) FieldU32(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU32(name, sms...).Actual
+	s, err := d.TryFieldU32(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U32")
+	}
+	return s
 }
 
 // Reader U33
@@ -2666,14 +3101,14 @@ func (d *D) U33() uint64 {
 
 // TryFieldScalarU33 tries to add a field and read 33 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU33(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(33, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU33 adds a field and reads 33 bit unsigned integer in current endian
@@ -2687,13 +3122,20 @@ func (d *D) FieldScalarU33(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU33 tries to add a field and read 33 bit unsigned integer in current endian
 func (d *D) TryFieldU33(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU33(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(33, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU33 adds a field and reads 33 bit unsigned integer in current endian
 func (d *D) FieldU33(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU33(name, sms...).Actual
+	s, err := d.TryFieldU33(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U33")
+	}
+	return s
 }
 
 // Reader U34
@@ -2712,14 +3154,14 @@ func (d *D) U34() uint64 {
 
 // TryFieldScalarU34 tries to add a field and read 34 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU34(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(34, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU34 adds a field and reads 34 bit unsigned integer in current endian
@@ -2733,13 +3175,20 @@ func (d *D) FieldScalarU34(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU34 tries to add a field and read 34 bit unsigned integer in current endian
 func (d *D) TryFieldU34(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU34(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(34, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU34 adds a field and reads 34 bit unsigned integer in current endian
 func (d *D) FieldU34(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU34(name, sms...).Actual
+	s, err := d.TryFieldU34(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U34")
+	}
+	return s
 }
 
 // Reader U35
@@ -2758,14 +3207,14 @@ func (d *D) U35() uint64 {
 
 // TryFieldScalarU35 tries to add a field and read 35 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU35(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(35, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU35 adds a field and reads 35 bit unsigned integer in current endian
@@ -2779,13 +3228,20 @@ func (d *D) FieldScalarU35(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU35 tries to add a field and read 35 bit unsigned integer in current endian
 func (d *D) TryFieldU35(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU35(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(35, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU35 adds a field and reads 35 bit unsigned integer in current endian
 func (d *D) FieldU35(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU35(name, sms...).Actual
+	s, err := d.TryFieldU35(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U35")
+	}
+	return s
 }
 
 // Reader U36
@@ -2804,14 +3260,14 @@ func (d *D) U36() uint64 {
 
 // TryFieldScalarU36 tries to add a field and read 36 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU36(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(36, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU36 adds a field and reads 36 bit unsigned integer in current endian
@@ -2825,13 +3281,20 @@ func (d *D) FieldScalarU36(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU36 tries to add a field and read 36 bit unsigned integer in current endian
 func (d *D) TryFieldU36(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU36(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(36, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU36 adds a field and reads 36 bit unsigned integer in current endian
 func (d *D) FieldU36(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU36(name, sms...).Actual
+	s, err := d.TryFieldU36(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U36")
+	}
+	return s
 }
 
 // Reader U37
@@ -2850,14 +3313,14 @@ func (d *D) U37() uint64 {
 
 // TryFieldScalarU37 tries to add a field and read 37 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU37(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(37, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU37 adds a field and reads 37 bit unsigned integer in current endian
@@ -2871,13 +3334,20 @@ func (d *D) FieldScalarU37(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU37 tries to add a field and read 37 bit unsigned integer in current endian
 func (d *D) TryFieldU37(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU37(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(37, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU37 adds a field and reads 37 bit unsigned integer in current endian
 func (d *D) FieldU37(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU37(name, sms...).Actual
+	s, err := d.TryFieldU37(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U37")
+	}
+	return s
 }
 
 // Reader U38
@@ -2896,14 +3366,14 @@ func (d *D) U38() uint64 {
 
 // TryFieldScalarU38 tries to add a field and read 38 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU38(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(38, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU38 adds a field and reads 38 bit unsigned integer in current endian
@@ -2917,13 +3387,20 @@ func (d *D) FieldScalarU38(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU38 tries to add a field and read 38 bit unsigned integer in current endian
 func (d *D) TryFieldU38(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU38(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(38, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU38 adds a field and reads 38 bit unsigned integer in current endian
 func (d *D) FieldU38(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU38(name, sms...).Actual
+	s, err := d.TryFieldU38(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U38")
+	}
+	return s
 }
 
 // Reader U39
@@ -2942,14 +3419,14 @@ func (d *D) U39() uint64 {
 
 // TryFieldScalarU39 tries to add a field and read 39 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU39(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(39, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU39 adds a field and reads 39 bit unsigned integer in current endian
@@ -2963,13 +3440,20 @@ func (d *D) FieldScalarU39(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU39 tries to add a field and read 39 bit unsigned integer in current endian
 func (d *D) TryFieldU39(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU39(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(39, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU39 adds a field and reads 39 bit unsigned integer in current endian
 func (d *D) FieldU39(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU39(name, sms...).Actual
+	s, err := d.TryFieldU39(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U39")
+	}
+	return s
 }
 
 // Reader U40
@@ -2988,14 +3472,14 @@ func (d *D) U40() uint64 {
 
 // TryFieldScalarU40 tries to add a field and read 40 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU40(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(40, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU40 adds a field and reads 40 bit unsigned integer in current endian
@@ -3009,13 +3493,20 @@ func (d *D) FieldScalarU40(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU40 tries to add a field and read 40 bit unsigned integer in current endian
 func (d *D) TryFieldU40(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU40(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(40, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU40 adds a field and reads 40 bit unsigned integer in current endian
 func (d *D) FieldU40(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU40(name, sms...).Actual
+	s, err := d.TryFieldU40(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U40")
+	}
+	return s
 }
 
 // Reader U41
@@ -3034,14 +3525,14 @@ func (d *D) U41() uint64 {
 
 // TryFieldScalarU41 tries to add a field and read 41 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU41(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(41, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU41 adds a field and reads 41 bit unsigned integer in current endian
@@ -3055,13 +3546,20 @@ func (d *D) FieldScalarU41(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU41 tries to add a field and read 41 bit unsigned integer in current endian
 func (d *D) TryFieldU41(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU41(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(41, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU41 adds a field and reads 41 bit unsigned integer in current endian
 func (d *D) FieldU41(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU41(name, sms...).Actual
+	s, err := d.TryFieldU41(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U41")
+	}
+	return s
 }
 
 // Reader U42
@@ -3080,14 +3578,14 @@ func (d *D) U42() uint64 {
 
 // TryFieldScalarU42 tries to add a field and read 42 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU42(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(42, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU42 adds a field and reads 42 bit unsigned integer in current endian
@@ -3101,13 +3599,20 @@ func (d *D) FieldScalarU42(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU42 tries to add a field and read 42 bit unsigned integer in current endian
 func (d *D) TryFieldU42(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU42(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(42, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU42 adds a field and reads 42 bit unsigned integer in current endian
 func (d *D) FieldU42(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU42(name, sms...).Actual
+	s, err := d.TryFieldU42(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U42")
+	}
+	return s
 }
 
 // Reader U43
@@ -3126,14 +3631,14 @@ func (d *D) U43() uint64 {
 
 // TryFieldScalarU43 tries to add a field and read 43 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU43(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(43, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU43 adds a field and reads 43 bit unsigned integer in current endian
@@ -3147,13 +3652,20 @@ func (d *D) FieldScalarU43(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU43 tries to add a field and read 43 bit unsigned integer in current endian
 func (d *D) TryFieldU43(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU43(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(43, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU43 adds a field and reads 43 bit unsigned integer in current endian
 func (d *D) FieldU43(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU43(name, sms...).Actual
+	s, err := d.TryFieldU43(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U43")
+	}
+	return s
 }
 
 // Reader U44
@@ -3172,14 +3684,14 @@ func (d *D) U44() uint64 {
 
 // TryFieldScalarU44 tries to add a field and read 44 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU44(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(44, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU44 adds a field and reads 44 bit unsigned integer in current endian
@@ -3193,13 +3705,20 @@ func (d *D) FieldScalarU44(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU44 tries to add a field and read 44 bit unsigned integer in current endian
 func (d *D) TryFieldU44(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU44(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(44, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU44 adds a field and reads 44 bit unsigned integer in current endian
 func (d *D) FieldU44(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU44(name, sms...).Actual
+	s, err := d.TryFieldU44(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U44")
+	}
+	return s
 }
 
 // Reader U45
@@ -3218,14 +3737,14 @@ func (d *D) U45() uint64 {
 
 // TryFieldScalarU45 tries to add a field and read 45 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU45(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(45, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU45 adds a field and reads 45 bit unsigned integer in current endian
@@ -3239,13 +3758,20 @@ func (d *D) FieldScalarU45(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU45 tries to add a field and read 45 bit unsigned integer in current endian
 func (d *D) TryFieldU45(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU45(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(45, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU45 adds a field and reads 45 bit unsigned integer in current endian
 func (d *D) FieldU45(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU45(name, sms...).Actual
+	s, err := d.TryFieldU45(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U45")
+	}
+	return s
 }
 
 // Reader U46
@@ -3264,14 +3790,14 @@ func (d *D) U46() uint64 {
 
 // TryFieldScalarU46 tries to add a field and read 46 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU46(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(46, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU46 adds a field and reads 46 bit unsigned integer in current endian
@@ -3285,13 +3811,20 @@ func (d *D) FieldScalarU46(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU46 tries to add a field and read 46 bit unsigned integer in current endian
 func (d *D) TryFieldU46(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU46(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(46, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU46 adds a field and reads 46 bit unsigned integer in current endian
 func (d *D) FieldU46(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU46(name, sms...).Actual
+	s, err := d.TryFieldU46(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U46")
+	}
+	return s
 }
 
 // Reader U47
@@ -3310,14 +3843,14 @@ func (d *D) U47() uint64 {
 
 // TryFieldScalarU47 tries to add a field and read 47 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU47(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(47, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU47 adds a field and reads 47 bit unsigned integer in current endian
@@ -3331,13 +3864,20 @@ func (d *D) FieldScalarU47(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU47 tries to add a field and read 47 bit unsigned integer in current endian
 func (d *D) TryFieldU47(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU47(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(47, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU47 adds a field and reads 47 bit unsigned integer in current endian
 func (d *D) FieldU47(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU47(name, sms...).Actual
+	s, err := d.TryFieldU47(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U47")
+	}
+	return s
 }
 
 // Reader U48
@@ -3356,14 +3896,14 @@ func (d *D) U48() uint64 {
 
 // TryFieldScalarU48 tries to add a field and read 48 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU48(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(48, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU48 adds a field and reads 48 bit unsigned integer in current endian
@@ -3377,13 +3917,20 @@ func (d *D) FieldScalarU48(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU48 tries to add a field and read 48 bit unsigned integer in current endian
 func (d *D) TryFieldU48(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU48(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(48, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU48 adds a field and reads 48 bit unsigned integer in current endian
 func (d *D) FieldU48(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU48(name, sms...).Actual
+	s, err := d.TryFieldU48(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U48")
+	}
+	return s
 }
 
 // Reader U49
@@ -3402,14 +3949,14 @@ func (d *D) U49() uint64 {
 
 // TryFieldScalarU49 tries to add a field and read 49 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU49(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(49, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU49 adds a field and reads 49 bit unsigned integer in current endian
@@ -3423,13 +3970,20 @@ func (d *D) FieldScalarU49(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU49 tries to add a field and read 49 bit unsigned integer in current endian
 func (d *D) TryFieldU49(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU49(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(49, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU49 adds a field and reads 49 bit unsigned integer in current endian
 func (d *D) FieldU49(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU49(name, sms...).Actual
+	s, err := d.TryFieldU49(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U49")
+	}
+	return s
 }
 
 // Reader U50
@@ -3448,14 +4002,14 @@ func (d *D) U50() uint64 {
 
 // TryFieldScalarU50 tries to add a field and read 50 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU50(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(50, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU50 adds a field and reads 50 bit unsigned integer in current endian
@@ -3469,13 +4023,20 @@ func (d *D) FieldScalarU50(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU50 tries to add a field and read 50 bit unsigned integer in current endian
 func (d *D) TryFieldU50(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU50(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(50, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU50 adds a field and reads 50 bit unsigned integer in current endian
 func (d *D) FieldU50(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU50(name, sms...).Actual
+	s, err := d.TryFieldU50(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U50")
+	}
+	return s
 }
 
 // Reader U51
@@ -3494,14 +4055,14 @@ func (d *D) U51() uint64 {
 
 // TryFieldScalarU51 tries to add a field and read 51 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU51(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(51, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU51 adds a field and reads 51 bit unsigned integer in current endian
@@ -3515,13 +4076,20 @@ func (d *D) FieldScalarU51(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU51 tries to add a field and read 51 bit unsigned integer in current endian
 func (d *D) TryFieldU51(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU51(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(51, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU51 adds a field and reads 51 bit unsigned integer in current endian
 func (d *D) FieldU51(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU51(name, sms...).Actual
+	s, err := d.TryFieldU51(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U51")
+	}
+	return s
 }
 
 // Reader U52
@@ -3540,14 +4108,14 @@ func (d *D) U52() uint64 {
 
 // TryFieldScalarU52 tries to add a field and read 52 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU52(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(52, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU52 adds a field and reads 52 bit unsigned integer in current endian
@@ -3561,13 +4129,20 @@ func (d *D) FieldScalarU52(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU52 tries to add a field and read 52 bit unsigned integer in current endian
 func (d *D) TryFieldU52(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU52(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(52, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU52 adds a field and reads 52 bit unsigned integer in current endian
 func (d *D) FieldU52(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU52(name, sms...).Actual
+	s, err := d.TryFieldU52(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U52")
+	}
+	return s
 }
 
 // Reader U53
@@ -3586,14 +4161,14 @@ func (d *D) U53() uint64 {
 
 // TryFieldScalarU53 tries to add a field and read 53 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU53(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(53, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU53 adds a field and reads 53 bit unsigned integer in current endian
@@ -3607,13 +4182,20 @@ func (d *D) FieldScalarU53(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU53 tries to add a field and read 53 bit unsigned integer in current endian
 func (d *D) TryFieldU53(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU53(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(53, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU53 adds a field and reads 53 bit unsigned integer in current endian
 func (d *D) FieldU53(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU53(name, sms...).Actual
+	s, err := d.TryFieldU53(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U53")
+	}
+	return s
 }
 
 // Reader U54
@@ -3632,14 +4214,14 @@ func (d *D) U54() uint64 {
 
 // TryFieldScalarU54 tries to add a field and read 54 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU54(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(54, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU54 adds a field and reads 54 bit unsigned integer in current endian
@@ -3653,13 +4235,20 @@ func (d *D) FieldScalarU54(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU54 tries to add a field and read 54 bit unsigned integer in current endian
 func (d *D) TryFieldU54(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU54(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(54, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU54 adds a field and reads 54 bit unsigned integer in current endian
 func (d *D) FieldU54(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU54(name, sms...).Actual
+	s, err := d.TryFieldU54(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U54")
+	}
+	return s
 }
 
 // Reader U55
@@ -3678,14 +4267,14 @@ func (d *D) U55() uint64 {
 
 // TryFieldScalarU55 tries to add a field and read 55 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU55(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(55, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU55 adds a field and reads 55 bit unsigned integer in current endian
@@ -3699,13 +4288,20 @@ func (d *D) FieldScalarU55(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU55 tries to add a field and read 55 bit unsigned integer in current endian
 func (d *D) TryFieldU55(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU55(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(55, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU55 adds a field and reads 55 bit unsigned integer in current endian
 func (d *D) FieldU55(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU55(name, sms...).Actual
+	s, err := d.TryFieldU55(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U55")
+	}
+	return s
 }
 
 // Reader U56
@@ -3724,14 +4320,14 @@ func (d *D) U56() uint64 {
 
 // TryFieldScalarU56 tries to add a field and read 56 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU56(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(56, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU56 adds a field and reads 56 bit unsigned integer in current endian
@@ -3745,13 +4341,20 @@ func (d *D) FieldScalarU56(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU56 tries to add a field and read 56 bit unsigned integer in current endian
 func (d *D) TryFieldU56(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU56(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(56, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU56 adds a field and reads 56 bit unsigned integer in current endian
 func (d *D) FieldU56(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU56(name, sms...).Actual
+	s, err := d.TryFieldU56(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U56")
+	}
+	return s
 }
 
 // Reader U57
@@ -3770,14 +4373,14 @@ func (d *D) U57() uint64 {
 
 // TryFieldScalarU57 tries to add a field and read 57 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU57(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(57, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU57 adds a field and reads 57 bit unsigned integer in current endian
@@ -3791,13 +4394,20 @@ func (d *D) FieldScalarU57(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU57 tries to add a field and read 57 bit unsigned integer in current endian
 func (d *D) TryFieldU57(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU57(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(57, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU57 adds a field and reads 57 bit unsigned integer in current endian
 func (d *D) FieldU57(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU57(name, sms...).Actual
+	s, err := d.TryFieldU57(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U57")
+	}
+	return s
 }
 
 // Reader U58
@@ -3816,14 +4426,14 @@ func (d *D) U58() uint64 {
 
 // TryFieldScalarU58 tries to add a field and read 58 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU58(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(58, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU58 adds a field and reads 58 bit unsigned integer in current endian
@@ -3837,13 +4447,20 @@ func (d *D) FieldScalarU58(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU58 tries to add a field and read 58 bit unsigned integer in current endian
 func (d *D) TryFieldU58(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU58(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(58, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU58 adds a field and reads 58 bit unsigned integer in current endian
 func (d *D) FieldU58(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU58(name, sms...).Actual
+	s, err := d.TryFieldU58(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U58")
+	}
+	return s
 }
 
 // Reader U59
@@ -3862,14 +4479,14 @@ func (d *D) U59() uint64 {
 
 // TryFieldScalarU59 tries to add a field and read 59 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU59(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(59, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU59 adds a field and reads 59 bit unsigned integer in current endian
@@ -3883,13 +4500,20 @@ func (d *D) FieldScalarU59(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU59 tries to add a field and read 59 bit unsigned integer in current endian
 func (d *D) TryFieldU59(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU59(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(59, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU59 adds a field and reads 59 bit unsigned integer in current endian
 func (d *D) FieldU59(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU59(name, sms...).Actual
+	s, err := d.TryFieldU59(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U59")
+	}
+	return s
 }
 
 // Reader U60
@@ -3908,14 +4532,14 @@ func (d *D) U60() uint64 {
 
 // TryFieldScalarU60 tries to add a field and read 60 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU60(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(60, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU60 adds a field and reads 60 bit unsigned integer in current endian
@@ -3929,13 +4553,20 @@ func (d *D) FieldScalarU60(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU60 tries to add a field and read 60 bit unsigned integer in current endian
 func (d *D) TryFieldU60(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU60(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(60, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU60 adds a field and reads 60 bit unsigned integer in current endian
 func (d *D) FieldU60(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU60(name, sms...).Actual
+	s, err := d.TryFieldU60(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U60")
+	}
+	return s
 }
 
 // Reader U61
@@ -3954,14 +4585,14 @@ func (d *D) U61() uint64 {
 
 // TryFieldScalarU61 tries to add a field and read 61 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU61(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(61, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU61 adds a field and reads 61 bit unsigned integer in current endian
@@ -3975,13 +4606,20 @@ func (d *D) FieldScalarU61(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU61 tries to add a field and read 61 bit unsigned integer in current endian
 func (d *D) TryFieldU61(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU61(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(61, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU61 adds a field and reads 61 bit unsigned integer in current endian
 func (d *D) FieldU61(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU61(name, sms...).Actual
+	s, err := d.TryFieldU61(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U61")
+	}
+	return s
 }
 
 // Reader U62
@@ -4000,14 +4638,14 @@ func (d *D) U62() uint64 {
 
 // TryFieldScalarU62 tries to add a field and read 62 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU62(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(62, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU62 adds a field and reads 62 bit unsigned integer in current endian
@@ -4021,13 +4659,20 @@ func (d *D) FieldScalarU62(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU62 tries to add a field and read 62 bit unsigned integer in current endian
 func (d *D) TryFieldU62(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU62(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(62, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU62 adds a field and reads 62 bit unsigned integer in current endian
 func (d *D) FieldU62(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU62(name, sms...).Actual
+	s, err := d.TryFieldU62(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U62")
+	}
+	return s
 }
 
 // Reader U63
@@ -4046,14 +4691,14 @@ func (d *D) U63() uint64 {
 
 // TryFieldScalarU63 tries to add a field and read 63 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU63(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(63, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU63 adds a field and reads 63 bit unsigned integer in current endian
@@ -4067,13 +4712,20 @@ func (d *D) FieldScalarU63(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU63 tries to add a field and read 63 bit unsigned integer in current endian
 func (d *D) TryFieldU63(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU63(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(63, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU63 adds a field and reads 63 bit unsigned integer in current endian
 func (d *D) FieldU63(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU63(name, sms...).Actual
+	s, err := d.TryFieldU63(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U63")
+	}
+	return s
 }
 
 // Reader U64
@@ -4092,14 +4744,14 @@ func (d *D) U64() uint64 {
 
 // TryFieldScalarU64 tries to add a field and read 64 bit unsigned integer in current endian
 func (d *D) TryFieldScalarU64(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(64, d.Endian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU64 adds a field and reads 64 bit unsigned integer in current endian
@@ -4113,13 +4765,20 @@ func (d *D) FieldScalarU64(name string, sms ...scalar.UintMapper) *scalar.Uint {
 
 // TryFieldU64 tries to add a field and read 64 bit unsigned integer in current endian
 func (d *D) TryFieldU64(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU64(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(d.Endian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(64, d.Endian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU64 adds a field and reads 64 bit unsigned integer in current endian
 func (d *D) FieldU64(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU64(name, sms...).Actual
+	s, err := d.TryFieldU64(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U64")
+	}
+	return s
 }
 
 // Reader U8LE
@@ -4138,14 +4797,14 @@ func (d *D) U8LE() uint64 {
 
 // TryFieldScalarU8LE tries to add a field and read 8 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU8LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(8, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU8LE adds a field and reads 8 bit unsigned integer in little-endian
@@ -4159,13 +4818,20 @@ func (d *D) FieldScalarU8LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU8LE tries to add a field and read 8 bit unsigned integer in little-endian
 func (d *D) TryFieldU8LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU8LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(8, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU8LE adds a field and reads 8 bit unsigned integer in little-endian
 func (d *D) FieldU8LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU8LE(name, sms...).Actual
+	s, err := d.TryFieldU8LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U8LE")
+	}
+	return s
 }
 
 // Reader U9LE
@@ -4184,14 +4850,14 @@ func (d *D) U9LE() uint64 {
 
 // TryFieldScalarU9LE tries to add a field and read 9 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU9LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(9, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU9LE adds a field and reads 9 bit unsigned integer in little-endian
@@ -4205,13 +4871,20 @@ func (d *D) FieldScalarU9LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU9LE tries to add a field and read 9 bit unsigned integer in little-endian
 func (d *D) TryFieldU9LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU9LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(9, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU9LE adds a field and reads 9 bit unsigned integer in little-endian
 func (d *D) FieldU9LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU9LE(name, sms...).Actual
+	s, err := d.TryFieldU9LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U9LE")
+	}
+	return s
 }
 
 // Reader U10LE
@@ -4230,14 +4903,14 @@ func (d *D) U10LE() uint64 {
 
 // TryFieldScalarU10LE tries to add a field and read 10 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU10LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(10, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU10LE adds a field and reads 10 bit unsigned integer in little-endian
@@ -4251,13 +4924,20 @@ func (d *D) FieldScalarU10LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU10LE tries to add a field and read 10 bit unsigned integer in little-endian
 func (d *D) TryFieldU10LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU10LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(10, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU10LE adds a field and reads 10 bit unsigned integer in little-endian
 func (d *D) FieldU10LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU10LE(name, sms...).Actual
+	s, err := d.TryFieldU10LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U10LE")
+	}
+	return s
 }
 
 // Reader U11LE
@@ -4276,14 +4956,14 @@ func (d *D) U11LE() uint64 {
 
 // TryFieldScalarU11LE tries to add a field and read 11 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU11LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(11, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU11LE adds a field and reads 11 bit unsigned integer in little-endian
@@ -4297,13 +4977,20 @@ func (d *D) FieldScalarU11LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU11LE tries to add a field and read 11 bit unsigned integer in little-endian
 func (d *D) TryFieldU11LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU11LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(11, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU11LE adds a field and reads 11 bit unsigned integer in little-endian
 func (d *D) FieldU11LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU11LE(name, sms...).Actual
+	s, err := d.TryFieldU11LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U11LE")
+	}
+	return s
 }
 
 // Reader U12LE
@@ -4322,14 +5009,14 @@ func (d *D) U12LE() uint64 {
 
 // TryFieldScalarU12LE tries to add a field and read 12 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU12LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(12, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU12LE adds a field and reads 12 bit unsigned integer in little-endian
@@ -4343,13 +5030,20 @@ func (d *D) FieldScalarU12LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU12LE tries to add a field and read 12 bit unsigned integer in little-endian
 func (d *D) TryFieldU12LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU12LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(12, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU12LE adds a field and reads 12 bit unsigned integer in little-endian
 func (d *D) FieldU12LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU12LE(name, sms...).Actual
+	s, err := d.TryFieldU12LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U12LE")
+	}
+	return s
 }
 
 // Reader U13LE
@@ -4368,14 +5062,14 @@ func (d *D) U13LE() uint64 {
 
 // TryFieldScalarU13LE tries to add a field and read 13 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU13LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(13, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU13LE adds a field and reads 13 bit unsigned integer in little-endian
@@ -4389,13 +5083,20 @@ func (d *D) FieldScalarU13LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU13LE tries to add a field and read 13 bit unsigned integer in little-endian
 func (d *D) TryFieldU13LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU13LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(13, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU13LE adds a field and reads 13 bit unsigned integer in little-endian
 func (d *D) FieldU13LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU13LE(name, sms...).Actual
+	s, err := d.TryFieldU13LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U13LE")
+	}
+	return s
 }
 
 // Reader U14LE
@@ -4414,14 +5115,14 @@ func (d *D) U14LE() uint64 {
 
 // TryFieldScalarU14LE tries to add a field and read 14 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU14LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(14, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU14LE adds a field and reads 14 bit unsigned integer in little-endian
@@ -4435,13 +5136,20 @@ func (d *D) FieldScalarU14LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU14LE tries to add a field and read 14 bit unsigned integer in little-endian
 func (d *D) TryFieldU14LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU14LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(14, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU14LE adds a field and reads 14 bit unsigned integer in little-endian
 func (d *D) FieldU14LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU14LE(name, sms...).Actual
+	s, err := d.TryFieldU14LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U14LE")
+	}
+	return s
 }
 
 // Reader U15LE
@@ -4460,14 +5168,14 @@ func (d *D) U15LE() uint64 {
 
 // TryFieldScalarU15LE tries to add a field and read 15 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU15LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(15, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU15LE adds a field and reads 15 bit unsigned integer in little-endian
@@ -4481,13 +5189,20 @@ func (d *D) FieldScalarU15LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU15LE tries to add a field and read 15 bit unsigned integer in little-endian
 func (d *D) TryFieldU15LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU15LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(15, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU15LE adds a field and reads 15 bit unsigned integer in little-endian
 func (d *D) FieldU15LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU15LE(name, sms...).Actual
+	s, err := d.TryFieldU15LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U15LE")
+	}
+	return s
 }
 
 // Reader U16LE
@@ -4506,14 +5221,14 @@ func (d *D) U16LE() uint64 {
 
 // TryFieldScalarU16LE tries to add a field and read 16 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU16LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(16, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU16LE adds a field and reads 16 bit unsigned integer in little-endian
@@ -4527,13 +5242,20 @@ func (d *D) FieldScalarU16LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU16LE tries to add a field and read 16 bit unsigned integer in little-endian
 func (d *D) TryFieldU16LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU16LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(16, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU16LE adds a field and reads 16 bit unsigned integer in little-endian
 func (d *D) FieldU16LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU16LE(name, sms...).Actual
+	s, err := d.TryFieldU16LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U16LE")
+	}
+	return s
 }
 
 // Reader U17LE
@@ -4552,14 +5274,14 @@ func (d *D) U17LE() uint64 {
 
 // TryFieldScalarU17LE tries to add a field and read 17 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU17LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(17, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU17LE adds a field and reads 17 bit unsigned integer in little-endian
@@ -4573,13 +5295,20 @@ func (d *D) FieldScalarU17LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU17LE tries to add a field and read 17 bit unsigned integer in little-endian
 func (d *D) TryFieldU17LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU17LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(17, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU17LE adds a field and reads 17 bit unsigned integer in little-endian
 func (d *D) FieldU17LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU17LE(name, sms...).Actual
+	s, err := d.TryFieldU17LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U17LE")
+	}
+	return s
 }
 
 // Reader U18LE
@@ -4598,14 +5327,14 @@ func (d *D) U18LE() uint64 {
 
 // TryFieldScalarU18LE tries to add a field and read 18 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU18LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(18, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU18LE adds a field and reads 18 bit unsigned integer in little-endian
@@ -4619,13 +5348,20 @@ func (d *D) FieldScalarU18LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU18LE tries to add a field and read 18 bit unsigned integer in little-endian
 func (d *D) TryFieldU18LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU18LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(18, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU18LE adds a field and reads 18 bit unsigned integer in little-endian
 func (d *D) FieldU18LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU18LE(name, sms...).Actual
+	s, err := d.TryFieldU18LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U18LE")
+	}
+	return s
 }
 
 // Reader U19LE
@@ -4644,14 +5380,14 @@ func (d *D) U19LE() uint64 {
 
 // TryFieldScalarU19LE tries to add a field and read 19 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU19LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(19, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU19LE adds a field and reads 19 bit unsigned integer in little-endian
@@ -4665,13 +5401,20 @@ func (d *D) FieldScalarU19LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU19LE tries to add a field and read 19 bit unsigned integer in little-endian
 func (d *D) TryFieldU19LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU19LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(19, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU19LE adds a field and reads 19 bit unsigned integer in little-endian
 func (d *D) FieldU19LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU19LE(name, sms...).Actual
+	s, err := d.TryFieldU19LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U19LE")
+	}
+	return s
 }
 
 // Reader U20LE
@@ -4690,14 +5433,14 @@ func (d *D) U20LE() uint64 {
 
 // TryFieldScalarU20LE tries to add a field and read 20 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU20LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(20, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU20LE adds a field and reads 20 bit unsigned integer in little-endian
@@ -4711,13 +5454,20 @@ func (d *D) FieldScalarU20LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU20LE tries to add a field and read 20 bit unsigned integer in little-endian
 func (d *D) TryFieldU20LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU20LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(20, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU20LE adds a field and reads 20 bit unsigned integer in little-endian
 func (d *D) FieldU20LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU20LE(name, sms...).Actual
+	s, err := d.TryFieldU20LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U20LE")
+	}
+	return s
 }
 
 // Reader U21LE
@@ -4736,14 +5486,14 @@ func (d *D) U21LE() uint64 {
 
 // TryFieldScalarU21LE tries to add a field and read 21 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU21LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(21, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU21LE adds a field and reads 21 bit unsigned integer in little-endian
@@ -4757,13 +5507,20 @@ func (d *D) FieldScalarU21LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU21LE tries to add a field and read 21 bit unsigned integer in little-endian
 func (d *D) TryFieldU21LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU21LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(21, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU21LE adds a field and reads 21 bit unsigned integer in little-endian
 func (d *D) FieldU21LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU21LE(name, sms...).Actual
+	s, err := d.TryFieldU21LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U21LE")
+	}
+	return s
 }
 
 // Reader U22LE
@@ -4782,14 +5539,14 @@ func (d *D) U22LE() uint64 {
 
 // TryFieldScalarU22LE tries to add a field and read 22 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU22LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(22, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU22LE adds a field and reads 22 bit unsigned integer in little-endian
@@ -4803,13 +5560,20 @@ func (d *D) FieldScalarU22LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU22LE tries to add a field and read 22 bit unsigned integer in little-endian
 func (d *D) TryFieldU22LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU22LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(22, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU22LE adds a field and reads 22 bit unsigned integer in little-endian
 func (d *D) FieldU22LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU22LE(name, sms...).Actual
+	s, err := d.TryFieldU22LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U22LE")
+	}
+	return s
 }
 
 // Reader U23LE
@@ -4828,14 +5592,14 @@ func (d *D) U23LE() uint64 {
 
 // TryFieldScalarU23LE tries to add a field and read 23 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU23LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(23, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU23LE adds a field and reads 23 bit unsigned integer in little-endian
@@ -4849,13 +5613,20 @@ func (d *D) FieldScalarU23LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU23LE tries to add a field and read 23 bit unsigned integer in little-endian
 func (d *D) TryFieldU23LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU23LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(23, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU23LE adds a field and reads 23 bit unsigned integer in little-endian
 func (d *D) FieldU23LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU23LE(name, sms...).Actual
+	s, err := d.TryFieldU23LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U23LE")
+	}
+	return s
 }
 
 // Reader U24LE
@@ -4874,14 +5645,14 @@ func (d *D) U24LE() uint64 {
 
 // TryFieldScalarU24LE tries to add a field and read 24 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU24LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(24, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU24LE adds a field and reads 24 bit unsigned integer in little-endian
@@ -4895,13 +5666,20 @@ func (d *D) FieldScalarU24LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU24LE tries to add a field and read 24 bit unsigned integer in little-endian
 func (d *D) TryFieldU24LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU24LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(24, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU24LE adds a field and reads 24 bit unsigned integer in little-endian
 func (d *D) FieldU24LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU24LE(name, sms...).Actual
+	s, err := d.TryFieldU24LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U24LE")
+	}
+	return s
 }
 
 // Reader U25LE
@@ -4920,14 +5698,14 @@ func (d *D) U25LE() uint64 {
 
 // TryFieldScalarU25LE tries to add a field and read 25 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU25LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(25, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU25LE adds a field and reads 25 bit unsigned integer in little-endian
@@ -4941,13 +5719,20 @@ func (d *D) FieldScalarU25LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU25LE tries to add a field and read 25 bit unsigned integer in little-endian
 func (d *D) TryFieldU25LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU25LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(25, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU25LE adds a field and reads 25 bit unsigned integer in little-endian
 func (d *D) FieldU25LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU25LE(name, sms...).Actual
+	s, err := d.TryFieldU25LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U25LE")
+	}
+	return s
 }
 
 // Reader U26LE
@@ -4966,14 +5751,14 @@ func (d *D) U26LE() uint64 {
 
 // TryFieldScalarU26LE tries to add a field and read 26 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU26LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(26, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU26LE adds a field and reads 26 bit unsigned integer in little-endian
@@ -4987,13 +5772,20 @@ func (d *D) FieldScalarU26LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU26LE tries to add a field and read 26 bit unsigned integer in little-endian
 func (d *D) TryFieldU26LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU26LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(26, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU26LE adds a field and reads 26 bit unsigned integer in little-endian
 func (d *D) FieldU26LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU26LE(name, sms...).Actual
+	s, err := d.TryFieldU26LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U26LE")
+	}
+	return s
 }
 
 // Reader U27LE
@@ -5012,14 +5804,14 @@ func (d *D) U27LE() uint64 {
 
 // TryFieldScalarU27LE tries to add a field and read 27 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU27LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(27, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU27LE adds a field and reads 27 bit unsigned integer in little-endian
@@ -5033,13 +5825,20 @@ func (d *D) FieldScalarU27LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU27LE tries to add a field and read 27 bit unsigned integer in little-endian
 func (d *D) TryFieldU27LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU27LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(27, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU27LE adds a field and reads 27 bit unsigned integer in little-endian
 func (d *D) FieldU27LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU27LE(name, sms...).Actual
+	s, err := d.TryFieldU27LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U27LE")
+	}
+	return s
 }
 
 // Reader U28LE
@@ -5058,14 +5857,14 @@ func (d *D) U28LE() uint64 {
 
 // TryFieldScalarU28LE tries to add a field and read 28 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU28LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(28, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU28LE adds a field and reads 28 bit unsigned integer in little-endian
@@ -5079,13 +5878,20 @@ func (d *D) FieldScalarU28LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU28LE tries to add a field and read 28 bit unsigned integer in little-endian
 func (d *D) TryFieldU28LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU28LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(28, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU28LE adds a field and reads 28 bit unsigned integer in little-endian
 func (d *D) FieldU28LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU28LE(name, sms...).Actual
+	s, err := d.TryFieldU28LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U28LE")
+	}
+	return s
 }
 
 // Reader U29LE
@@ -5104,14 +5910,14 @@ func (d *D) U29LE() uint64 {
 
 // TryFieldScalarU29LE tries to add a field and read 29 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU29LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(29, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU29LE adds a field and reads 29 bit unsigned integer in little-endian
@@ -5125,13 +5931,20 @@ func (d *D) FieldScalarU29LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU29LE tries to add a field and read 29 bit unsigned integer in little-endian
 func (d *D) TryFieldU29LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU29LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(29, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU29LE adds a field and reads 29 bit unsigned integer in little-endian
 func (d *D) FieldU29LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU29LE(name, sms...).Actual
+	s, err := d.TryFieldU29LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U29LE")
+	}
+	return s
 }
 
 // Reader U30LE
@@ -5150,14 +5963,14 @@ func (d *D) U30LE() uint64 {
 
 // TryFieldScalarU30LE tries to add a field and read 30 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU30LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(30, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU30LE adds a field and reads 30 bit unsigned integer in little-endian
@@ -5171,13 +5984,20 @@ func (d *D) FieldScalarU30LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU30LE tries to add a field and read 30 bit unsigned integer in little-endian
 func (d *D) TryFieldU30LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU30LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(30, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU30LE adds a field and reads 30 bit unsigned integer in little-endian
 func (d *D) FieldU30LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU30LE(name, sms...).Actual
+	s, err := d.TryFieldU30LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U30LE")
+	}
+	return s
 }
 
 // Reader U31LE
@@ -5196,14 +6016,14 @@ func (d *D) U31LE() uint64 {
 
 // TryFieldScalarU31LE tries to add a field and read 31 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU31LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(31, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU31LE adds a field and reads 31 bit unsigned integer in little-endian
@@ -5217,13 +6037,20 @@ func (d *D) FieldScalarU31LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU31LE tries to add a field and read 31 bit unsigned integer in little-endian
 func (d *D) TryFieldU31LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU31LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(31, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU31LE adds a field and reads 31 bit unsigned integer in little-endian
 func (d *D) FieldU31LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU31LE(name, sms...).Actual
+	s, err := d.TryFieldU31LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U31LE")
+	}
+	return s
 }
 
 // Reader U32LE
@@ -5242,14 +6069,14 @@ func (d *D) U32LE() uint64 {
 
 // TryFieldScalarU32LE tries to add a field and read 32 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU32LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(32, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU32LE adds a field and reads 32 bit unsigned integer in little-endian
@@ -5263,13 +6090,20 @@ func (d *D) FieldScalarU32LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU32LE tries to add a field and read 32 bit unsigned integer in little-endian
 func (d *D) TryFieldU32LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU32LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(32, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU32LE adds a field and reads 32 bit unsigned integer in little-endian
 func (d *D) FieldU32LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU32LE(name, sms...).Actual
+	s, err := d.TryFieldU32LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U32LE")
+	}
+	return s
 }
 
 // Reader U33LE
@@ -5288,14 +6122,14 @@ func (d *D) U33LE() uint64 {
 
 // TryFieldScalarU33LE tries to add a field and read 33 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU33LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(33, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU33LE adds a field and reads 33 bit unsigned integer in little-endian
@@ -5309,13 +6143,20 @@ func (d *D) FieldScalarU33LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU33LE tries to add a field and read 33 bit unsigned integer in little-endian
 func (d *D) TryFieldU33LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU33LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(33, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU33LE adds a field and reads 33 bit unsigned integer in little-endian
 func (d *D) FieldU33LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU33LE(name, sms...).Actual
+	s, err := d.TryFieldU33LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U33LE")
+	}
+	return s
 }
 
 // Reader U34LE
@@ -5334,14 +6175,14 @@ func (d *D) U34LE() uint64 {
 
 // TryFieldScalarU34LE tries to add a field and read 34 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU34LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(34, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU34LE adds a field and reads 34 bit unsigned integer in little-endian
@@ -5355,13 +6196,20 @@ func (d *D) FieldScalarU34LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU34LE tries to add a field and read 34 bit unsigned integer in little-endian
 func (d *D) TryFieldU34LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU34LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(34, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU34LE adds a field and reads 34 bit unsigned integer in little-endian
 func (d *D) FieldU34LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU34LE(name, sms...).Actual
+	s, err := d.TryFieldU34LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U34LE")
+	}
+	return s
 }
 
 // Reader U35LE
@@ -5380,14 +6228,14 @@ func (d *D) U35LE() uint64 {
 
 // TryFieldScalarU35LE tries to add a field and read 35 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU35LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(35, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU35LE adds a field and reads 35 bit unsigned integer in little-endian
@@ -5401,13 +6249,20 @@ func (d *D) FieldScalarU35LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU35LE tries to add a field and read 35 bit unsigned integer in little-endian
 func (d *D) TryFieldU35LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU35LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(35, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU35LE adds a field and reads 35 bit unsigned integer in little-endian
 func (d *D) FieldU35LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU35LE(name, sms...).Actual
+	s, err := d.TryFieldU35LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U35LE")
+	}
+	return s
 }
 
 // Reader U36LE
@@ -5426,14 +6281,14 @@ func (d *D) U36LE() uint64 {
 
 // TryFieldScalarU36LE tries to add a field and read 36 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU36LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(36, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU36LE adds a field and reads 36 bit unsigned integer in little-endian
@@ -5447,13 +6302,20 @@ func (d *D) FieldScalarU36LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU36LE tries to add a field and read 36 bit unsigned integer in little-endian
 func (d *D) TryFieldU36LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU36LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(36, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU36LE adds a field and reads 36 bit unsigned integer in little-endian
 func (d *D) FieldU36LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU36LE(name, sms...).Actual
+	s, err := d.TryFieldU36LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U36LE")
+	}
+	return s
 }
 
 // Reader U37LE
@@ -5472,14 +6334,14 @@ func (d *D) U37LE() uint64 {
 
 // TryFieldScalarU37LE tries to add a field and read 37 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU37LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(37, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU37LE adds a field and reads 37 bit unsigned integer in little-endian
@@ -5493,13 +6355,20 @@ func (d *D) FieldScalarU37LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU37LE tries to add a field and read 37 bit unsigned integer in little-endian
 func (d *D) TryFieldU37LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU37LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(37, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU37LE adds a field and reads 37 bit unsigned integer in little-endian
 func (d *D) FieldU37LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU37LE(name, sms...).Actual
+	s, err := d.TryFieldU37LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U37LE")
+	}
+	return s
 }
 
 // Reader U38LE
@@ -5518,14 +6387,14 @@ func (d *D) U38LE() uint64 {
 
 // TryFieldScalarU38LE tries to add a field and read 38 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU38LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(38, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU38LE adds a field and reads 38 bit unsigned integer in little-endian
@@ -5539,13 +6408,20 @@ func (d *D) FieldScalarU38LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU38LE tries to add a field and read 38 bit unsigned integer in little-endian
 func (d *D) TryFieldU38LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU38LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(38, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU38LE adds a field and reads 38 bit unsigned integer in little-endian
 func (d *D) FieldU38LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU38LE(name, sms...).Actual
+	s, err := d.TryFieldU38LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U38LE")
+	}
+	return s
 }
 
 // Reader U39LE
@@ -5564,14 +6440,14 @@ func (d *D) U39LE() uint64 {
 
 // TryFieldScalarU39LE tries to add a field and read 39 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU39LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(39, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU39LE adds a field and reads 39 bit unsigned integer in little-endian
@@ -5585,13 +6461,20 @@ func (d *D) FieldScalarU39LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU39LE tries to add a field and read 39 bit unsigned integer in little-endian
 func (d *D) TryFieldU39LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU39LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(39, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU39LE adds a field and reads 39 bit unsigned integer in little-endian
 func (d *D) FieldU39LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU39LE(name, sms...).Actual
+	s, err := d.TryFieldU39LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U39LE")
+	}
+	return s
 }
 
 // Reader U40LE
@@ -5610,14 +6493,14 @@ func (d *D) U40LE() uint64 {
 
 // TryFieldScalarU40LE tries to add a field and read 40 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU40LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(40, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU40LE adds a field and reads 40 bit unsigned integer in little-endian
@@ -5631,13 +6514,20 @@ func (d *D) FieldScalarU40LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU40LE tries to add a field and read 40 bit unsigned integer in little-endian
 func (d *D) TryFieldU40LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU40LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(40, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU40LE adds a field and reads 40 bit unsigned integer in little-endian
 func (d *D) FieldU40LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU40LE(name, sms...).Actual
+	s, err := d.TryFieldU40LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U40LE")
+	}
+	return s
 }
 
 // Reader U41LE
@@ -5656,14 +6546,14 @@ func (d *D) U41LE() uint64 {
 
 // TryFieldScalarU41LE tries to add a field and read 41 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU41LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(41, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU41LE adds a field and reads 41 bit unsigned integer in little-endian
@@ -5677,13 +6567,20 @@ func (d *D) FieldScalarU41LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU41LE tries to add a field and read 41 bit unsigned integer in little-endian
 func (d *D) TryFieldU41LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU41LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(41, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU41LE adds a field and reads 41 bit unsigned integer in little-endian
 func (d *D) FieldU41LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU41LE(name, sms...).Actual
+	s, err := d.TryFieldU41LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U41LE")
+	}
+	return s
 }
 
 // Reader U42LE
@@ -5702,14 +6599,14 @@ func (d *D) U42LE() uint64 {
 
 // TryFieldScalarU42LE tries to add a field and read 42 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU42LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(42, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU42LE adds a field and reads 42 bit unsigned integer in little-endian
@@ -5723,13 +6620,20 @@ func (d *D) FieldScalarU42LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU42LE tries to add a field and read 42 bit unsigned integer in little-endian
 func (d *D) TryFieldU42LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU42LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(42, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU42LE adds a field and reads 42 bit unsigned integer in little-endian
 func (d *D) FieldU42LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU42LE(name, sms...).Actual
+	s, err := d.TryFieldU42LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U42LE")
+	}
+	return s
 }
 
 // Reader U43LE
@@ -5748,14 +6652,14 @@ func (d *D) U43LE() uint64 {
 
 // TryFieldScalarU43LE tries to add a field and read 43 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU43LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(43, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU43LE adds a field and reads 43 bit unsigned integer in little-endian
@@ -5769,13 +6673,20 @@ func (d *D) FieldScalarU43LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU43LE tries to add a field and read 43 bit unsigned integer in little-endian
 func (d *D) TryFieldU43LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU43LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(43, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU43LE adds a field and reads 43 bit unsigned integer in little-endian
 func (d *D) FieldU43LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU43LE(name, sms...).Actual
+	s, err := d.TryFieldU43LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U43LE")
+	}
+	return s
 }
 
 // Reader U44LE
@@ -5794,14 +6705,14 @@ func (d *D) U44LE() uint64 {
 
 // TryFieldScalarU44LE tries to add a field and read 44 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU44LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(44, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU44LE adds a field and reads 44 bit unsigned integer in little-endian
@@ -5815,13 +6726,20 @@ func (d *D) FieldScalarU44LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU44LE tries to add a field and read 44 bit unsigned integer in little-endian
 func (d *D) TryFieldU44LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU44LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(44, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU44LE adds a field and reads 44 bit unsigned integer in little-endian
 func (d *D) FieldU44LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU44LE(name, sms...).Actual
+	s, err := d.TryFieldU44LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U44LE")
+	}
+	return s
 }
 
 // Reader U45LE
@@ -5840,14 +6758,14 @@ func (d *D) U45LE() uint64 {
 
 // TryFieldScalarU45LE tries to add a field and read 45 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU45LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(45, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU45LE adds a field and reads 45 bit unsigned integer in little-endian
@@ -5861,13 +6779,20 @@ func (d *D) FieldScalarU45LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU45LE tries to add a field and read 45 bit unsigned integer in little-endian
 func (d *D) TryFieldU45LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU45LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(45, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU45LE adds a field and reads 45 bit unsigned integer in little-endian
 func (d *D) FieldU45LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU45LE(name, sms...).Actual
+	s, err := d.TryFieldU45LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U45LE")
+	}
+	return s
 }
 
 // Reader U46LE
@@ -5886,14 +6811,14 @@ func (d *D) U46LE() uint64 {
 
 // TryFieldScalarU46LE tries to add a field and read 46 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU46LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(46, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU46LE adds a field and reads 46 bit unsigned integer in little-endian
@@ -5907,13 +6832,20 @@ func (d *D) FieldScalarU46LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU46LE tries to add a field and read 46 bit unsigned integer in little-endian
 func (d *D) TryFieldU46LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU46LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(46, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU46LE adds a field and reads 46 bit unsigned integer in little-endian
 func (d *D) FieldU46LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU46LE(name, sms...).Actual
+	s, err := d.TryFieldU46LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U46LE")
+	}
+	return s
 }
 
 // Reader U47LE
@@ -5932,14 +6864,14 @@ func (d *D) U47LE() uint64 {
 
 // TryFieldScalarU47LE tries to add a field and read 47 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU47LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(47, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU47LE adds a field and reads 47 bit unsigned integer in little-endian
@@ -5953,13 +6885,20 @@ func (d *D) FieldScalarU47LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU47LE tries to add a field and read 47 bit unsigned integer in little-endian
 func (d *D) TryFieldU47LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU47LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(47, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU47LE adds a field and reads 47 bit unsigned integer in little-endian
 func (d *D) FieldU47LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU47LE(name, sms...).Actual
+	s, err := d.TryFieldU47LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U47LE")
+	}
+	return s
 }
 
 // Reader U48LE
@@ -5978,14 +6917,14 @@ func (d *D) U48LE() uint64 {
 
 // TryFieldScalarU48LE tries to add a field and read 48 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU48LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(48, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU48LE adds a field and reads 48 bit unsigned integer in little-endian
@@ -5999,13 +6938,20 @@ func (d *D) FieldScalarU48LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU48LE tries to add a field and read 48 bit unsigned integer in little-endian
 func (d *D) TryFieldU48LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU48LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(48, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU48LE adds a field and reads 48 bit unsigned integer in little-endian
 func (d *D) FieldU48LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU48LE(name, sms...).Actual
+	s, err := d.TryFieldU48LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U48LE")
+	}
+	return s
 }
 
 // Reader U49LE
@@ -6024,14 +6970,14 @@ func (d *D) U49LE() uint64 {
 
 // TryFieldScalarU49LE tries to add a field and read 49 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU49LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(49, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU49LE adds a field and reads 49 bit unsigned integer in little-endian
@@ -6045,13 +6991,20 @@ func (d *D) FieldScalarU49LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU49LE tries to add a field and read 49 bit unsigned integer in little-endian
 func (d *D) TryFieldU49LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU49LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(49, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU49LE adds a field and reads 49 bit unsigned integer in little-endian
 func (d *D) FieldU49LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU49LE(name, sms...).Actual
+	s, err := d.TryFieldU49LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U49LE")
+	}
+	return s
 }
 
 // Reader U50LE
@@ -6070,14 +7023,14 @@ func (d *D) U50LE() uint64 {
 
 // TryFieldScalarU50LE tries to add a field and read 50 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU50LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(50, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU50LE adds a field and reads 50 bit unsigned integer in little-endian
@@ -6091,13 +7044,20 @@ func (d *D) FieldScalarU50LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU50LE tries to add a field and read 50 bit unsigned integer in little-endian
 func (d *D) TryFieldU50LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU50LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(50, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU50LE adds a field and reads 50 bit unsigned integer in little-endian
 func (d *D) FieldU50LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU50LE(name, sms...).Actual
+	s, err := d.TryFieldU50LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U50LE")
+	}
+	return s
 }
 
 // Reader U51LE
@@ -6116,14 +7076,14 @@ func (d *D) U51LE() uint64 {
 
 // TryFieldScalarU51LE tries to add a field and read 51 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU51LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(51, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU51LE adds a field and reads 51 bit unsigned integer in little-endian
@@ -6137,13 +7097,20 @@ func (d *D) FieldScalarU51LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU51LE tries to add a field and read 51 bit unsigned integer in little-endian
 func (d *D) TryFieldU51LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU51LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(51, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU51LE adds a field and reads 51 bit unsigned integer in little-endian
 func (d *D) FieldU51LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU51LE(name, sms...).Actual
+	s, err := d.TryFieldU51LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U51LE")
+	}
+	return s
 }
 
 // Reader U52LE
@@ -6162,14 +7129,14 @@ func (d *D) U52LE() uint64 {
 
 // TryFieldScalarU52LE tries to add a field and read 52 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU52LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(52, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU52LE adds a field and reads 52 bit unsigned integer in little-endian
@@ -6183,13 +7150,20 @@ func (d *D) FieldScalarU52LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU52LE tries to add a field and read 52 bit unsigned integer in little-endian
 func (d *D) TryFieldU52LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU52LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(52, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU52LE adds a field and reads 52 bit unsigned integer in little-endian
 func (d *D) FieldU52LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU52LE(name, sms...).Actual
+	s, err := d.TryFieldU52LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U52LE")
+	}
+	return s
 }
 
 // Reader U53LE
@@ -6208,14 +7182,14 @@ func (d *D) U53LE() uint64 {
 
 // TryFieldScalarU53LE tries to add a field and read 53 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU53LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(53, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU53LE adds a field and reads 53 bit unsigned integer in little-endian
@@ -6229,13 +7203,20 @@ func (d *D) FieldScalarU53LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU53LE tries to add a field and read 53 bit unsigned integer in little-endian
 func (d *D) TryFieldU53LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU53LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(53, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU53LE adds a field and reads 53 bit unsigned integer in little-endian
 func (d *D) FieldU53LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU53LE(name, sms...).Actual
+	s, err := d.TryFieldU53LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U53LE")
+	}
+	return s
 }
 
 // Reader U54LE
@@ -6254,14 +7235,14 @@ func (d *D) U54LE() uint64 {
 
 // TryFieldScalarU54LE tries to add a field and read 54 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU54LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(54, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU54LE adds a field and reads 54 bit unsigned integer in little-endian
@@ -6275,13 +7256,20 @@ func (d *D) FieldScalarU54LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU54LE tries to add a field and read 54 bit unsigned integer in little-endian
 func (d *D) TryFieldU54LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU54LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(54, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU54LE adds a field and reads 54 bit unsigned integer in little-endian
 func (d *D) FieldU54LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU54LE(name, sms...).Actual
+	s, err := d.TryFieldU54LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U54LE")
+	}
+	return s
 }
 
 // Reader U55LE
@@ -6300,14 +7288,14 @@ func (d *D) U55LE() uint64 {
 
 // TryFieldScalarU55LE tries to add a field and read 55 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU55LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(55, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU55LE adds a field and reads 55 bit unsigned integer in little-endian
@@ -6321,13 +7309,20 @@ func (d *D) FieldScalarU55LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU55LE tries to add a field and read 55 bit unsigned integer in little-endian
 func (d *D) TryFieldU55LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU55LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(55, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU55LE adds a field and reads 55 bit unsigned integer in little-endian
 func (d *D) FieldU55LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU55LE(name, sms...).Actual
+	s, err := d.TryFieldU55LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U55LE")
+	}
+	return s
 }
 
 // Reader U56LE
@@ -6346,14 +7341,14 @@ func (d *D) U56LE() uint64 {
 
 // TryFieldScalarU56LE tries to add a field and read 56 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU56LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(56, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU56LE adds a field and reads 56 bit unsigned integer in little-endian
@@ -6367,13 +7362,20 @@ func (d *D) FieldScalarU56LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU56LE tries to add a field and read 56 bit unsigned integer in little-endian
 func (d *D) TryFieldU56LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU56LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(56, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU56LE adds a field and reads 56 bit unsigned integer in little-endian
 func (d *D) FieldU56LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU56LE(name, sms...).Actual
+	s, err := d.TryFieldU56LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U56LE")
+	}
+	return s
 }
 
 // Reader U57LE
@@ -6392,14 +7394,14 @@ func (d *D) U57LE() uint64 {
 
 // TryFieldScalarU57LE tries to add a field and read 57 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU57LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(57, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU57LE adds a field and reads 57 bit unsigned integer in little-endian
@@ -6413,13 +7415,20 @@ func (d *D) FieldScalarU57LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU57LE tries to add a field and read 57 bit unsigned integer in little-endian
 func (d *D) TryFieldU57LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU57LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(57, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU57LE adds a field and reads 57 bit unsigned integer in little-endian
 func (d *D) FieldU57LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU57LE(name, sms...).Actual
+	s, err := d.TryFieldU57LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U57LE")
+	}
+	return s
 }
 
 // Reader U58LE
@@ -6438,14 +7447,14 @@ func (d *D) U58LE() uint64 {
 
 // TryFieldScalarU58LE tries to add a field and read 58 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU58LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(58, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU58LE adds a field and reads 58 bit unsigned integer in little-endian
@@ -6459,13 +7468,20 @@ func (d *D) FieldScalarU58LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU58LE tries to add a field and read 58 bit unsigned integer in little-endian
 func (d *D) TryFieldU58LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU58LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(58, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU58LE adds a field and reads 58 bit unsigned integer in little-endian
 func (d *D) FieldU58LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU58LE(name, sms...).Actual
+	s, err := d.TryFieldU58LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U58LE")
+	}
+	return s
 }
 
 // Reader U59LE
@@ -6484,14 +7500,14 @@ func (d *D) U59LE() uint64 {
 
 // TryFieldScalarU59LE tries to add a field and read 59 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU59LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(59, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU59LE adds a field and reads 59 bit unsigned integer in little-endian
@@ -6505,13 +7521,20 @@ func (d *D) FieldScalarU59LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU59LE tries to add a field and read 59 bit unsigned integer in little-endian
 func (d *D) TryFieldU59LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU59LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(59, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU59LE adds a field and reads 59 bit unsigned integer in little-endian
 func (d *D) FieldU59LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU59LE(name, sms...).Actual
+	s, err := d.TryFieldU59LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U59LE")
+	}
+	return s
 }
 
 // Reader U60LE
@@ -6530,14 +7553,14 @@ func (d *D) U60LE() uint64 {
 
 // TryFieldScalarU60LE tries to add a field and read 60 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU60LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(60, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU60LE adds a field and reads 60 bit unsigned integer in little-endian
@@ -6551,13 +7574,20 @@ func (d *D) FieldScalarU60LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU60LE tries to add a field and read 60 bit unsigned integer in little-endian
 func (d *D) TryFieldU60LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU60LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(60, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU60LE adds a field and reads 60 bit unsigned integer in little-endian
 func (d *D) FieldU60LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU60LE(name, sms...).Actual
+	s, err := d.TryFieldU60LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U60LE")
+	}
+	return s
 }
 
 // Reader U61LE
@@ -6576,14 +7606,14 @@ func (d *D) U61LE() uint64 {
 
 // TryFieldScalarU61LE tries to add a field and read 61 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU61LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(61, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU61LE adds a field and reads 61 bit unsigned integer in little-endian
@@ -6597,13 +7627,20 @@ func (d *D) FieldScalarU61LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU61LE tries to add a field and read 61 bit unsigned integer in little-endian
 func (d *D) TryFieldU61LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU61LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(61, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU61LE adds a field and reads 61 bit unsigned integer in little-endian
 func (d *D) FieldU61LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU61LE(name, sms...).Actual
+	s, err := d.TryFieldU61LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U61LE")
+	}
+	return s
 }
 
 // Reader U62LE
@@ -6622,14 +7659,14 @@ func (d *D) U62LE() uint64 {
 
 // TryFieldScalarU62LE tries to add a field and read 62 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU62LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(62, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU62LE adds a field and reads 62 bit unsigned integer in little-endian
@@ -6643,13 +7680,20 @@ func (d *D) FieldScalarU62LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU62LE tries to add a field and read 62 bit unsigned integer in little-endian
 func (d *D) TryFieldU62LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU62LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(62, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU62LE adds a field and reads 62 bit unsigned integer in little-endian
 func (d *D) FieldU62LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU62LE(name, sms...).Actual
+	s, err := d.TryFieldU62LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U62LE")
+	}
+	return s
 }
 
 // Reader U63LE
@@ -6668,14 +7712,14 @@ func (d *D) U63LE() uint64 {
 
 // TryFieldScalarU63LE tries to add a field and read 63 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU63LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(63, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU63LE adds a field and reads 63 bit unsigned integer in little-endian
@@ -6689,13 +7733,20 @@ func (d *D) FieldScalarU63LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU63LE tries to add a field and read 63 bit unsigned integer in little-endian
 func (d *D) TryFieldU63LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU63LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(63, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU63LE adds a field and reads 63 bit unsigned integer in little-endian
 func (d *D) FieldU63LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU63LE(name, sms...).Actual
+	s, err := d.TryFieldU63LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U63LE")
+	}
+	return s
 }
 
 // Reader U64LE
@@ -6714,14 +7765,14 @@ func (d *D) U64LE() uint64 {
 
 // TryFieldScalarU64LE tries to add a field and read 64 bit unsigned integer in little-endian
 func (d *D) TryFieldScalarU64LE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(64, LittleEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU64LE adds a field and reads 64 bit unsigned integer in little-endian
@@ -6735,13 +7786,20 @@ func (d *D) FieldScalarU64LE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU64LE tries to add a field and read 64 bit unsigned integer in little-endian
 func (d *D) TryFieldU64LE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU64LE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(LittleEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(64, LittleEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU64LE adds a field and reads 64 bit unsigned integer in little-endian
 func (d *D) FieldU64LE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU64LE(name, sms...).Actual
+	s, err := d.TryFieldU64LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U64LE")
+	}
+	return s
 }
 
 // Reader U8BE
@@ -6760,14 +7818,14 @@ func (d *D) U8BE() uint64 {
 
 // TryFieldScalarU8BE tries to add a field and read 8 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU8BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(8, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU8BE adds a field and reads 8 bit unsigned integer in big-endian
@@ -6781,13 +7839,20 @@ func (d *D) FieldScalarU8BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU8BE tries to add a field and read 8 bit unsigned integer in big-endian
 func (d *D) TryFieldU8BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU8BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(8, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU8BE adds a field and reads 8 bit unsigned integer in big-endian
 func (d *D) FieldU8BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU8BE(name, sms...).Actual
+	s, err := d.TryFieldU8BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U8BE")
+	}
+	return s
 }
 
 // Reader U9BE
@@ -6806,14 +7871,14 @@ func (d *D) U9BE() uint64 {
 
 // TryFieldScalarU9BE tries to add a field and read 9 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU9BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(9, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU9BE adds a field and reads 9 bit unsigned integer in big-endian
@@ -6827,13 +7892,20 @@ func (d *D) FieldScalarU9BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU9BE tries to add a field and read 9 bit unsigned integer in big-endian
 func (d *D) TryFieldU9BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU9BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(9, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU9BE adds a field and reads 9 bit unsigned integer in big-endian
 func (d *D) FieldU9BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU9BE(name, sms...).Actual
+	s, err := d.TryFieldU9BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U9BE")
+	}
+	return s
 }
 
 // Reader U10BE
@@ -6852,14 +7924,14 @@ func (d *D) U10BE() uint64 {
 
 // TryFieldScalarU10BE tries to add a field and read 10 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU10BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(10, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU10BE adds a field and reads 10 bit unsigned integer in big-endian
@@ -6873,13 +7945,20 @@ func (d *D) FieldScalarU10BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU10BE tries to add a field and read 10 bit unsigned integer in big-endian
 func (d *D) TryFieldU10BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU10BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(10, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU10BE adds a field and reads 10 bit unsigned integer in big-endian
 func (d *D) FieldU10BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU10BE(name, sms...).Actual
+	s, err := d.TryFieldU10BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U10BE")
+	}
+	return s
 }
 
 // Reader U11BE
@@ -6898,14 +7977,14 @@ func (d *D) U11BE() uint64 {
 
 // TryFieldScalarU11BE tries to add a field and read 11 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU11BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(11, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU11BE adds a field and reads 11 bit unsigned integer in big-endian
@@ -6919,13 +7998,20 @@ func (d *D) FieldScalarU11BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU11BE tries to add a field and read 11 bit unsigned integer in big-endian
 func (d *D) TryFieldU11BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU11BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(11, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU11BE adds a field and reads 11 bit unsigned integer in big-endian
 func (d *D) FieldU11BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU11BE(name, sms...).Actual
+	s, err := d.TryFieldU11BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U11BE")
+	}
+	return s
 }
 
 // Reader U12BE
@@ -6944,14 +8030,14 @@ func (d *D) U12BE() uint64 {
 
 // TryFieldScalarU12BE tries to add a field and read 12 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU12BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(12, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU12BE adds a field and reads 12 bit unsigned integer in big-endian
@@ -6965,13 +8051,20 @@ func (d *D) FieldScalarU12BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU12BE tries to add a field and read 12 bit unsigned integer in big-endian
 func (d *D) TryFieldU12BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU12BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(12, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU12BE adds a field and reads 12 bit unsigned integer in big-endian
 func (d *D) FieldU12BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU12BE(name, sms...).Actual
+	s, err := d.TryFieldU12BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U12BE")
+	}
+	return s
 }
 
 // Reader U13BE
@@ -6990,14 +8083,14 @@ func (d *D) U13BE() uint64 {
 
 // TryFieldScalarU13BE tries to add a field and read 13 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU13BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(13, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU13BE adds a field and reads 13 bit unsigned integer in big-endian
@@ -7011,13 +8104,20 @@ func (d *D) FieldScalarU13BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU13BE tries to add a field and read 13 bit unsigned integer in big-endian
 func (d *D) TryFieldU13BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU13BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(13, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU13BE adds a field and reads 13 bit unsigned integer in big-endian
 func (d *D) FieldU13BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU13BE(name, sms...).Actual
+	s, err := d.TryFieldU13BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U13BE")
+	}
+	return s
 }
 
 // Reader U14BE
@@ -7036,14 +8136,14 @@ func (d *D) U14BE() uint64 {
 
 // TryFieldScalarU14BE tries to add a field and read 14 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU14BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(14, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU14BE adds a field and reads 14 bit unsigned integer in big-endian
@@ -7057,13 +8157,20 @@ func (d *D) FieldScalarU14BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU14BE tries to add a field and read 14 bit unsigned integer in big-endian
 func (d *D) TryFieldU14BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU14BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(14, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU14BE adds a field and reads 14 bit unsigned integer in big-endian
 func (d *D) FieldU14BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU14BE(name, sms...).Actual
+	s, err := d.TryFieldU14BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U14BE")
+	}
+	return s
 }
 
 // Reader U15BE
@@ -7082,14 +8189,14 @@ func (d *D) U15BE() uint64 {
 
 // TryFieldScalarU15BE tries to add a field and read 15 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU15BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(15, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU15BE adds a field and reads 15 bit unsigned integer in big-endian
@@ -7103,13 +8210,20 @@ func (d *D) FieldScalarU15BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU15BE tries to add a field and read 15 bit unsigned integer in big-endian
 func (d *D) TryFieldU15BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU15BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(15, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU15BE adds a field and reads 15 bit unsigned integer in big-endian
 func (d *D) FieldU15BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU15BE(name, sms...).Actual
+	s, err := d.TryFieldU15BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U15BE")
+	}
+	return s
 }
 
 // Reader U16BE
@@ -7128,14 +8242,14 @@ func (d *D) U16BE() uint64 {
 
 // TryFieldScalarU16BE tries to add a field and read 16 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU16BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(16, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU16BE adds a field and reads 16 bit unsigned integer in big-endian
@@ -7149,13 +8263,20 @@ func (d *D) FieldScalarU16BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU16BE tries to add a field and read 16 bit unsigned integer in big-endian
 func (d *D) TryFieldU16BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU16BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(16, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU16BE adds a field and reads 16 bit unsigned integer in big-endian
 func (d *D) FieldU16BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU16BE(name, sms...).Actual
+	s, err := d.TryFieldU16BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U16BE")
+	}
+	return s
 }
 
 // Reader U17BE
@@ -7174,14 +8295,14 @@ func (d *D) U17BE() uint64 {
 
 // TryFieldScalarU17BE tries to add a field and read 17 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU17BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(17, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU17BE adds a field and reads 17 bit unsigned integer in big-endian
@@ -7195,13 +8316,20 @@ func (d *D) FieldScalarU17BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU17BE tries to add a field and read 17 bit unsigned integer in big-endian
 func (d *D) TryFieldU17BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU17BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(17, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU17BE adds a field and reads 17 bit unsigned integer in big-endian
 func (d *D) FieldU17BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU17BE(name, sms...).Actual
+	s, err := d.TryFieldU17BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U17BE")
+	}
+	return s
 }
 
 // Reader U18BE
@@ -7220,14 +8348,14 @@ func (d *D) U18BE() uint64 {
 
 // TryFieldScalarU18BE tries to add a field and read 18 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU18BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(18, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU18BE adds a field and reads 18 bit unsigned integer in big-endian
@@ -7241,13 +8369,20 @@ func (d *D) FieldScalarU18BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU18BE tries to add a field and read 18 bit unsigned integer in big-endian
 func (d *D) TryFieldU18BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU18BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(18, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU18BE adds a field and reads 18 bit unsigned integer in big-endian
 func (d *D) FieldU18BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU18BE(name, sms...).Actual
+	s, err := d.TryFieldU18BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U18BE")
+	}
+	return s
 }
 
 // Reader U19BE
@@ -7266,14 +8401,14 @@ func (d *D) U19BE() uint64 {
 
 // TryFieldScalarU19BE tries to add a field and read 19 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU19BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(19, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU19BE adds a field and reads 19 bit unsigned integer in big-endian
@@ -7287,13 +8422,20 @@ func (d *D) FieldScalarU19BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU19BE tries to add a field and read 19 bit unsigned integer in big-endian
 func (d *D) TryFieldU19BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU19BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(19, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU19BE adds a field and reads 19 bit unsigned integer in big-endian
 func (d *D) FieldU19BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU19BE(name, sms...).Actual
+	s, err := d.TryFieldU19BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U19BE")
+	}
+	return s
 }
 
 // Reader U20BE
@@ -7312,14 +8454,14 @@ func (d *D) U20BE() uint64 {
 
 // TryFieldScalarU20BE tries to add a field and read 20 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU20BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(20, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU20BE adds a field and reads 20 bit unsigned integer in big-endian
@@ -7333,13 +8475,20 @@ func (d *D) FieldScalarU20BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU20BE tries to add a field and read 20 bit unsigned integer in big-endian
 func (d *D) TryFieldU20BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU20BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(20, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU20BE adds a field and reads 20 bit unsigned integer in big-endian
 func (d *D) FieldU20BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU20BE(name, sms...).Actual
+	s, err := d.TryFieldU20BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U20BE")
+	}
+	return s
 }
 
 // Reader U21BE
@@ -7358,14 +8507,14 @@ func (d *D) U21BE() uint64 {
 
 // TryFieldScalarU21BE tries to add a field and read 21 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU21BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(21, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU21BE adds a field and reads 21 bit unsigned integer in big-endian
@@ -7379,13 +8528,20 @@ func (d *D) FieldScalarU21BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU21BE tries to add a field and read 21 bit unsigned integer in big-endian
 func (d *D) TryFieldU21BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU21BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(21, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU21BE adds a field and reads 21 bit unsigned integer in big-endian
 func (d *D) FieldU21BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU21BE(name, sms...).Actual
+	s, err := d.TryFieldU21BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U21BE")
+	}
+	return s
 }
 
 // Reader U22BE
@@ -7404,14 +8560,14 @@ func (d *D) U22BE() uint64 {
 
 // TryFieldScalarU22BE tries to add a field and read 22 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU22BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(22, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU22BE adds a field and reads 22 bit unsigned integer in big-endian
@@ -7425,13 +8581,20 @@ func (d *D) FieldScalarU22BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU22BE tries to add a field and read 22 bit unsigned integer in big-endian
 func (d *D) TryFieldU22BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU22BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(22, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU22BE adds a field and reads 22 bit unsigned integer in big-endian
 func (d *D) FieldU22BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU22BE(name, sms...).Actual
+	s, err := d.TryFieldU22BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U22BE")
+	}
+	return s
 }
 
 // Reader U23BE
@@ -7450,14 +8613,14 @@ func (d *D) U23BE() uint64 {
 
 // TryFieldScalarU23BE tries to add a field and read 23 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU23BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(23, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU23BE adds a field and reads 23 bit unsigned integer in big-endian
@@ -7471,13 +8634,20 @@ func (d *D) FieldScalarU23BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU23BE tries to add a field and read 23 bit unsigned integer in big-endian
 func (d *D) TryFieldU23BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU23BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(23, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU23BE adds a field and reads 23 bit unsigned integer in big-endian
 func (d *D) FieldU23BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU23BE(name, sms...).Actual
+	s, err := d.TryFieldU23BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U23BE")
+	}
+	return s
 }
 
 // Reader U24BE
@@ -7496,14 +8666,14 @@ func (d *D) U24BE() uint64 {
 
 // TryFieldScalarU24BE tries to add a field and read 24 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU24BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(24, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU24BE adds a field and reads 24 bit unsigned integer in big-endian
@@ -7517,13 +8687,20 @@ func (d *D) FieldScalarU24BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU24BE tries to add a field and read 24 bit unsigned integer in big-endian
 func (d *D) TryFieldU24BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU24BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(24, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU24BE adds a field and reads 24 bit unsigned integer in big-endian
 func (d *D) FieldU24BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU24BE(name, sms...).Actual
+	s, err := d.TryFieldU24BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U24BE")
+	}
+	return s
 }
 
 // Reader U25BE
@@ -7542,14 +8719,14 @@ func (d *D) U25BE() uint64 {
 
 // TryFieldScalarU25BE tries to add a field and read 25 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU25BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(25, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU25BE adds a field and reads 25 bit unsigned integer in big-endian
@@ -7563,13 +8740,20 @@ func (d *D) FieldScalarU25BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU25BE tries to add a field and read 25 bit unsigned integer in big-endian
 func (d *D) TryFieldU25BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU25BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(25, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU25BE adds a field and reads 25 bit unsigned integer in big-endian
 func (d *D) FieldU25BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU25BE(name, sms...).Actual
+	s, err := d.TryFieldU25BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U25BE")
+	}
+	return s
 }
 
 // Reader U26BE
@@ -7588,14 +8772,14 @@ func (d *D) U26BE() uint64 {
 
 // TryFieldScalarU26BE tries to add a field and read 26 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU26BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(26, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU26BE adds a field and reads 26 bit unsigned integer in big-endian
@@ -7609,13 +8793,20 @@ func (d *D) FieldScalarU26BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU26BE tries to add a field and read 26 bit unsigned integer in big-endian
 func (d *D) TryFieldU26BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU26BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(26, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU26BE adds a field and reads 26 bit unsigned integer in big-endian
 func (d *D) FieldU26BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU26BE(name, sms...).Actual
+	s, err := d.TryFieldU26BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U26BE")
+	}
+	return s
 }
 
 // Reader U27BE
@@ -7634,14 +8825,14 @@ func (d *D) U27BE() uint64 {
 
 // TryFieldScalarU27BE tries to add a field and read 27 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU27BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(27, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU27BE adds a field and reads 27 bit unsigned integer in big-endian
@@ -7655,13 +8846,20 @@ func (d *D) FieldScalarU27BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU27BE tries to add a field and read 27 bit unsigned integer in big-endian
 func (d *D) TryFieldU27BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU27BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(27, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU27BE adds a field and reads 27 bit unsigned integer in big-endian
 func (d *D) FieldU27BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU27BE(name, sms...).Actual
+	s, err := d.TryFieldU27BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U27BE")
+	}
+	return s
 }
 
 // Reader U28BE
@@ -7680,14 +8878,14 @@ func (d *D) U28BE() uint64 {
 
 // TryFieldScalarU28BE tries to add a field and read 28 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU28BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(28, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU28BE adds a field and reads 28 bit unsigned integer in big-endian
@@ -7701,13 +8899,20 @@ func (d *D) FieldScalarU28BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU28BE tries to add a field and read 28 bit unsigned integer in big-endian
 func (d *D) TryFieldU28BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU28BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(28, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU28BE adds a field and reads 28 bit unsigned integer in big-endian
 func (d *D) FieldU28BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU28BE(name, sms...).Actual
+	s, err := d.TryFieldU28BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U28BE")
+	}
+	return s
 }
 
 // Reader U29BE
@@ -7726,14 +8931,14 @@ func (d *D) U29BE() uint64 {
 
 // TryFieldScalarU29BE tries to add a field and read 29 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU29BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(29, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU29BE adds a field and reads 29 bit unsigned integer in big-endian
@@ -7747,13 +8952,20 @@ func (d *D) FieldScalarU29BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU29BE tries to add a field and read 29 bit unsigned integer in big-endian
 func (d *D) TryFieldU29BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU29BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(29, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU29BE adds a field and reads 29 bit unsigned integer in big-endian
 func (d *D) FieldU29BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU29BE(name, sms...).Actual
+	s, err := d.TryFieldU29BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U29BE")
+	}
+	return s
 }
 
 // Reader U30BE
@@ -7772,14 +8984,14 @@ func (d *D) U30BE() uint64 {
 
 // TryFieldScalarU30BE tries to add a field and read 30 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU30BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(30, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU30BE adds a field and reads 30 bit unsigned integer in big-endian
@@ -7793,13 +9005,20 @@ func (d *D) FieldScalarU30BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU30BE tries to add a field and read 30 bit unsigned integer in big-endian
 func (d *D) TryFieldU30BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU30BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(30, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU30BE adds a field and reads 30 bit unsigned integer in big-endian
 func (d *D) FieldU30BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU30BE(name, sms...).Actual
+	s, err := d.TryFieldU30BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U30BE")
+	}
+	return s
 }
 
 // Reader U31BE
@@ -7818,14 +9037,14 @@ func (d *D) U31BE() uint64 {
 
 // TryFieldScalarU31BE tries to add a field and read 31 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU31BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(31, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU31BE adds a field and reads 31 bit unsigned integer in big-endian
@@ -7839,13 +9058,20 @@ func (d *D) FieldScalarU31BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU31BE tries to add a field and read 31 bit unsigned integer in big-endian
 func (d *D) TryFieldU31BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU31BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(31, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU31BE adds a field and reads 31 bit unsigned integer in big-endian
 func (d *D) FieldU31BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU31BE(name, sms...).Actual
+	s, err := d.TryFieldU31BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U31BE")
+	}
+	return s
 }
 
 // Reader U32BE
@@ -7864,14 +9090,14 @@ func (d *D) U32BE() uint64 {
 
 // TryFieldScalarU32BE tries to add a field and read 32 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU32BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(32, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU32BE adds a field and reads 32 bit unsigned integer in big-endian
@@ -7885,13 +9111,20 @@ func (d *D) FieldScalarU32BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU32BE tries to add a field and read 32 bit unsigned integer in big-endian
 func (d *D) TryFieldU32BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU32BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(32, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU32BE adds a field and reads 32 bit unsigned integer in big-endian
 func (d *D) FieldU32BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU32BE(name, sms...).Actual
+	s, err := d.TryFieldU32BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U32BE")
+	}
+	return s
 }
 
 // Reader U33BE
@@ -7910,14 +9143,14 @@ func (d *D) U33BE() uint64 {
 
 // TryFieldScalarU33BE tries to add a field and read 33 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU33BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(33, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU33BE adds a field and reads 33 bit unsigned integer in big-endian
@@ -7931,13 +9164,20 @@ func (d *D) FieldScalarU33BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU33BE tries to add a field and read 33 bit unsigned integer in big-endian
 func (d *D) TryFieldU33BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU33BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(33, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU33BE adds a field and reads 33 bit unsigned integer in big-endian
 func (d *D) FieldU33BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU33BE(name, sms...).Actual
+	s, err := d.TryFieldU33BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U33BE")
+	}
+	return s
 }
 
 // Reader U34BE
@@ -7956,14 +9196,14 @@ func (d *D) U34BE() uint64 {
 
 // TryFieldScalarU34BE tries to add a field and read 34 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU34BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(34, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU34BE adds a field and reads 34 bit unsigned integer in big-endian
@@ -7977,13 +9217,20 @@ func (d *D) FieldScalarU34BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU34BE tries to add a field and read 34 bit unsigned integer in big-endian
 func (d *D) TryFieldU34BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU34BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(34, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU34BE adds a field and reads 34 bit unsigned integer in big-endian
 func (d *D) FieldU34BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU34BE(name, sms...).Actual
+	s, err := d.TryFieldU34BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U34BE")
+	}
+	return s
 }
 
 // Reader U35BE
@@ -8002,14 +9249,14 @@ func (d *D) U35BE() uint64 {
 
 // TryFieldScalarU35BE tries to add a field and read 35 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU35BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(35, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU35BE adds a field and reads 35 bit unsigned integer in big-endian
@@ -8023,13 +9270,20 @@ func (d *D) FieldScalarU35BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU35BE tries to add a field and read 35 bit unsigned integer in big-endian
 func (d *D) TryFieldU35BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU35BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(35, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU35BE adds a field and reads 35 bit unsigned integer in big-endian
 func (d *D) FieldU35BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU35BE(name, sms...).Actual
+	s, err := d.TryFieldU35BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U35BE")
+	}
+	return s
 }
 
 // Reader U36BE
@@ -8048,14 +9302,14 @@ func (d *D) U36BE() uint64 {
 
 // TryFieldScalarU36BE tries to add a field and read 36 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU36BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(36, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU36BE adds a field and reads 36 bit unsigned integer in big-endian
@@ -8069,13 +9323,20 @@ func (d *D) FieldScalarU36BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU36BE tries to add a field and read 36 bit unsigned integer in big-endian
 func (d *D) TryFieldU36BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU36BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(36, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU36BE adds a field and reads 36 bit unsigned integer in big-endian
 func (d *D) FieldU36BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU36BE(name, sms...).Actual
+	s, err := d.TryFieldU36BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U36BE")
+	}
+	return s
 }
 
 // Reader U37BE
@@ -8094,14 +9355,14 @@ func (d *D) U37BE() uint64 {
 
 // TryFieldScalarU37BE tries to add a field and read 37 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU37BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(37, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU37BE adds a field and reads 37 bit unsigned integer in big-endian
@@ -8115,13 +9376,20 @@ func (d *D) FieldScalarU37BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU37BE tries to add a field and read 37 bit unsigned integer in big-endian
 func (d *D) TryFieldU37BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU37BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(37, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU37BE adds a field and reads 37 bit unsigned integer in big-endian
 func (d *D) FieldU37BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU37BE(name, sms...).Actual
+	s, err := d.TryFieldU37BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U37BE")
+	}
+	return s
 }
 
 // Reader U38BE
@@ -8140,14 +9408,14 @@ func (d *D) U38BE() uint64 {
 
 // TryFieldScalarU38BE tries to add a field and read 38 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU38BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(38, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU38BE adds a field and reads 38 bit unsigned integer in big-endian
@@ -8161,13 +9429,20 @@ func (d *D) FieldScalarU38BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU38BE tries to add a field and read 38 bit unsigned integer in big-endian
 func (d *D) TryFieldU38BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU38BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(38, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU38BE adds a field and reads 38 bit unsigned integer in big-endian
 func (d *D) FieldU38BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU38BE(name, sms...).Actual
+	s, err := d.TryFieldU38BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U38BE")
+	}
+	return s
 }
 
 // Reader U39BE
@@ -8186,14 +9461,14 @@ func (d *D) U39BE() uint64 {
 
 // TryFieldScalarU39BE tries to add a field and read 39 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU39BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(39, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU39BE adds a field and reads 39 bit unsigned integer in big-endian
@@ -8207,13 +9482,20 @@ func (d *D) FieldScalarU39BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU39BE tries to add a field and read 39 bit unsigned integer in big-endian
 func (d *D) TryFieldU39BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU39BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(39, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU39BE adds a field and reads 39 bit unsigned integer in big-endian
 func (d *D) FieldU39BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU39BE(name, sms...).Actual
+	s, err := d.TryFieldU39BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U39BE")
+	}
+	return s
 }
 
 // Reader U40BE
@@ -8232,14 +9514,14 @@ func (d *D) U40BE() uint64 {
 
 // TryFieldScalarU40BE tries to add a field and read 40 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU40BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(40, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU40BE adds a field and reads 40 bit unsigned integer in big-endian
@@ -8253,13 +9535,20 @@ func (d *D) FieldScalarU40BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU40BE tries to add a field and read 40 bit unsigned integer in big-endian
 func (d *D) TryFieldU40BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU40BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(40, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU40BE adds a field and reads 40 bit unsigned integer in big-endian
 func (d *D) FieldU40BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU40BE(name, sms...).Actual
+	s, err := d.TryFieldU40BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U40BE")
+	}
+	return s
 }
 
 // Reader U41BE
@@ -8278,14 +9567,14 @@ func (d *D) U41BE() uint64 {
 
 // TryFieldScalarU41BE tries to add a field and read 41 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU41BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(41, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU41BE adds a field and reads 41 bit unsigned integer in big-endian
@@ -8299,13 +9588,20 @@ func (d *D) FieldScalarU41BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU41BE tries to add a field and read 41 bit unsigned integer in big-endian
 func (d *D) TryFieldU41BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU41BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(41, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU41BE adds a field and reads 41 bit unsigned integer in big-endian
 func (d *D) FieldU41BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU41BE(name, sms...).Actual
+	s, err := d.TryFieldU41BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U41BE")
+	}
+	return s
 }
 
 // Reader U42BE
@@ -8324,14 +9620,14 @@ func (d *D) U42BE() uint64 {
 
 // TryFieldScalarU42BE tries to add a field and read 42 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU42BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(42, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU42BE adds a field and reads 42 bit unsigned integer in big-endian
@@ -8345,13 +9641,20 @@ func (d *D) FieldScalarU42BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU42BE tries to add a field and read 42 bit unsigned integer in big-endian
 func (d *D) TryFieldU42BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU42BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(42, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU42BE adds a field and reads 42 bit unsigned integer in big-endian
 func (d *D) FieldU42BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU42BE(name, sms...).Actual
+	s, err := d.TryFieldU42BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U42BE")
+	}
+	return s
 }
 
 // Reader U43BE
@@ -8370,14 +9673,14 @@ func (d *D) U43BE() uint64 {
 
 // TryFieldScalarU43BE tries to add a field and read 43 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU43BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(43, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU43BE adds a field and reads 43 bit unsigned integer in big-endian
@@ -8391,13 +9694,20 @@ func (d *D) FieldScalarU43BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU43BE tries to add a field and read 43 bit unsigned integer in big-endian
 func (d *D) TryFieldU43BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU43BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(43, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU43BE adds a field and reads 43 bit unsigned integer in big-endian
 func (d *D) FieldU43BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU43BE(name, sms...).Actual
+	s, err := d.TryFieldU43BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U43BE")
+	}
+	return s
 }
 
 // Reader U44BE
@@ -8416,14 +9726,14 @@ func (d *D) U44BE() uint64 {
 
 // TryFieldScalarU44BE tries to add a field and read 44 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU44BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(44, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU44BE adds a field and reads 44 bit unsigned integer in big-endian
@@ -8437,13 +9747,20 @@ func (d *D) FieldScalarU44BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU44BE tries to add a field and read 44 bit unsigned integer in big-endian
 func (d *D) TryFieldU44BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU44BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(44, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU44BE adds a field and reads 44 bit unsigned integer in big-endian
 func (d *D) FieldU44BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU44BE(name, sms...).Actual
+	s, err := d.TryFieldU44BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U44BE")
+	}
+	return s
 }
 
 // Reader U45BE
@@ -8462,14 +9779,14 @@ func (d *D) U45BE() uint64 {
 
 // TryFieldScalarU45BE tries to add a field and read 45 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU45BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(45, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU45BE adds a field and reads 45 bit unsigned integer in big-endian
@@ -8483,13 +9800,20 @@ func (d *D) FieldScalarU45BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU45BE tries to add a field and read 45 bit unsigned integer in big-endian
 func (d *D) TryFieldU45BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU45BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(45, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU45BE adds a field and reads 45 bit unsigned integer in big-endian
 func (d *D) FieldU45BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU45BE(name, sms...).Actual
+	s, err := d.TryFieldU45BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U45BE")
+	}
+	return s
 }
 
 // Reader U46BE
@@ -8508,14 +9832,14 @@ func (d *D) U46BE() uint64 {
 
 // TryFieldScalarU46BE tries to add a field and read 46 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU46BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(46, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU46BE adds a field and reads 46 bit unsigned integer in big-endian
@@ -8529,13 +9853,20 @@ func (d *D) FieldScalarU46BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU46BE tries to add a field and read 46 bit unsigned integer in big-endian
 func (d *D) TryFieldU46BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU46BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(46, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU46BE adds a field and reads 46 bit unsigned integer in big-endian
 func (d *D) FieldU46BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU46BE(name, sms...).Actual
+	s, err := d.TryFieldU46BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U46BE")
+	}
+	return s
 }
 
 // Reader U47BE
@@ -8554,14 +9885,14 @@ func (d *D) U47BE() uint64 {
 
 // TryFieldScalarU47BE tries to add a field and read 47 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU47BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(47, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU47BE adds a field and reads 47 bit unsigned integer in big-endian
@@ -8575,13 +9906,20 @@ func (d *D) FieldScalarU47BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU47BE tries to add a field and read 47 bit unsigned integer in big-endian
 func (d *D) TryFieldU47BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU47BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(47, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU47BE adds a field and reads 47 bit unsigned integer in big-endian
 func (d *D) FieldU47BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU47BE(name, sms...).Actual
+	s, err := d.TryFieldU47BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U47BE")
+	}
+	return s
 }
 
 // Reader U48BE
@@ -8600,14 +9938,14 @@ func (d *D) U48BE() uint64 {
 
 // TryFieldScalarU48BE tries to add a field and read 48 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU48BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(48, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU48BE adds a field and reads 48 bit unsigned integer in big-endian
@@ -8621,13 +9959,20 @@ func (d *D) FieldScalarU48BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU48BE tries to add a field and read 48 bit unsigned integer in big-endian
 func (d *D) TryFieldU48BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU48BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(48, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU48BE adds a field and reads 48 bit unsigned integer in big-endian
 func (d *D) FieldU48BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU48BE(name, sms...).Actual
+	s, err := d.TryFieldU48BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U48BE")
+	}
+	return s
 }
 
 // Reader U49BE
@@ -8646,14 +9991,14 @@ func (d *D) U49BE() uint64 {
 
 // TryFieldScalarU49BE tries to add a field and read 49 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU49BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(49, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU49BE adds a field and reads 49 bit unsigned integer in big-endian
@@ -8667,13 +10012,20 @@ func (d *D) FieldScalarU49BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU49BE tries to add a field and read 49 bit unsigned integer in big-endian
 func (d *D) TryFieldU49BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU49BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(49, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU49BE adds a field and reads 49 bit unsigned integer in big-endian
 func (d *D) FieldU49BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU49BE(name, sms...).Actual
+	s, err := d.TryFieldU49BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U49BE")
+	}
+	return s
 }
 
 // Reader U50BE
@@ -8692,14 +10044,14 @@ func (d *D) U50BE() uint64 {
 
 // TryFieldScalarU50BE tries to add a field and read 50 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU50BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(50, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU50BE adds a field and reads 50 bit unsigned integer in big-endian
@@ -8713,13 +10065,20 @@ func (d *D) FieldScalarU50BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU50BE tries to add a field and read 50 bit unsigned integer in big-endian
 func (d *D) TryFieldU50BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU50BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(50, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU50BE adds a field and reads 50 bit unsigned integer in big-endian
 func (d *D) FieldU50BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU50BE(name, sms...).Actual
+	s, err := d.TryFieldU50BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U50BE")
+	}
+	return s
 }
 
 // Reader U51BE
@@ -8738,14 +10097,14 @@ func (d *D) U51BE() uint64 {
 
 // TryFieldScalarU51BE tries to add a field and read 51 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU51BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(51, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU51BE adds a field and reads 51 bit unsigned integer in big-endian
@@ -8759,13 +10118,20 @@ func (d *D) FieldScalarU51BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU51BE tries to add a field and read 51 bit unsigned integer in big-endian
 func (d *D) TryFieldU51BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU51BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(51, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU51BE adds a field and reads 51 bit unsigned integer in big-endian
 func (d *D) FieldU51BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU51BE(name, sms...).Actual
+	s, err := d.TryFieldU51BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U51BE")
+	}
+	return s
 }
 
 // Reader U52BE
@@ -8784,14 +10150,14 @@ func (d *D) U52BE() uint64 {
 
 // TryFieldScalarU52BE tries to add a field and read 52 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU52BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(52, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU52BE adds a field and reads 52 bit unsigned integer in big-endian
@@ -8805,13 +10171,20 @@ func (d *D) FieldScalarU52BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU52BE tries to add a field and read 52 bit unsigned integer in big-endian
 func (d *D) TryFieldU52BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU52BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(52, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU52BE adds a field and reads 52 bit unsigned integer in big-endian
 func (d *D) FieldU52BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU52BE(name, sms...).Actual
+	s, err := d.TryFieldU52BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U52BE")
+	}
+	return s
 }
 
 // Reader U53BE
@@ -8830,14 +10203,14 @@ func (d *D) U53BE() uint64 {
 
 // TryFieldScalarU53BE tries to add a field and read 53 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU53BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(53, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU53BE adds a field and reads 53 bit unsigned integer in big-endian
@@ -8851,13 +10224,20 @@ func (d *D) FieldScalarU53BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU53BE tries to add a field and read 53 bit unsigned integer in big-endian
 func (d *D) TryFieldU53BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU53BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(53, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU53BE adds a field and reads 53 bit unsigned integer in big-endian
 func (d *D) FieldU53BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU53BE(name, sms...).Actual
+	s, err := d.TryFieldU53BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U53BE")
+	}
+	return s
 }
 
 // Reader U54BE
@@ -8876,14 +10256,14 @@ func (d *D) U54BE() uint64 {
 
 // TryFieldScalarU54BE tries to add a field and read 54 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU54BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(54, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU54BE adds a field and reads 54 bit unsigned integer in big-endian
@@ -8897,13 +10277,20 @@ func (d *D) FieldScalarU54BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU54BE tries to add a field and read 54 bit unsigned integer in big-endian
 func (d *D) TryFieldU54BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU54BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(54, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU54BE adds a field and reads 54 bit unsigned integer in big-endian
 func (d *D) FieldU54BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU54BE(name, sms...).Actual
+	s, err := d.TryFieldU54BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U54BE")
+	}
+	return s
 }
 
 // Reader U55BE
@@ -8922,14 +10309,14 @@ func (d *D) U55BE() uint64 {
 
 // TryFieldScalarU55BE tries to add a field and read 55 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU55BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(55, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU55BE adds a field and reads 55 bit unsigned integer in big-endian
@@ -8943,13 +10330,20 @@ func (d *D) FieldScalarU55BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU55BE tries to add a field and read 55 bit unsigned integer in big-endian
 func (d *D) TryFieldU55BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU55BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(55, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU55BE adds a field and reads 55 bit unsigned integer in big-endian
 func (d *D) FieldU55BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU55BE(name, sms...).Actual
+	s, err := d.TryFieldU55BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U55BE")
+	}
+	return s
 }
 
 // Reader U56BE
@@ -8968,14 +10362,14 @@ func (d *D) U56BE() uint64 {
 
 // TryFieldScalarU56BE tries to add a field and read 56 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU56BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(56, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU56BE adds a field and reads 56 bit unsigned integer in big-endian
@@ -8989,13 +10383,20 @@ func (d *D) FieldScalarU56BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU56BE tries to add a field and read 56 bit unsigned integer in big-endian
 func (d *D) TryFieldU56BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU56BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(56, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU56BE adds a field and reads 56 bit unsigned integer in big-endian
 func (d *D) FieldU56BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU56BE(name, sms...).Actual
+	s, err := d.TryFieldU56BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U56BE")
+	}
+	return s
 }
 
 // Reader U57BE
@@ -9014,14 +10415,14 @@ func (d *D) U57BE() uint64 {
 
 // TryFieldScalarU57BE tries to add a field and read 57 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU57BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(57, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU57BE adds a field and reads 57 bit unsigned integer in big-endian
@@ -9035,13 +10436,20 @@ func (d *D) FieldScalarU57BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU57BE tries to add a field and read 57 bit unsigned integer in big-endian
 func (d *D) TryFieldU57BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU57BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(57, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU57BE adds a field and reads 57 bit unsigned integer in big-endian
 func (d *D) FieldU57BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU57BE(name, sms...).Actual
+	s, err := d.TryFieldU57BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U57BE")
+	}
+	return s
 }
 
 // Reader U58BE
@@ -9060,14 +10468,14 @@ func (d *D) U58BE() uint64 {
 
 // TryFieldScalarU58BE tries to add a field and read 58 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU58BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(58, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU58BE adds a field and reads 58 bit unsigned integer in big-endian
@@ -9081,13 +10489,20 @@ func (d *D) FieldScalarU58BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU58BE tries to add a field and read 58 bit unsigned integer in big-endian
 func (d *D) TryFieldU58BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU58BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(58, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU58BE adds a field and reads 58 bit unsigned integer in big-endian
 func (d *D) FieldU58BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU58BE(name, sms...).Actual
+	s, err := d.TryFieldU58BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U58BE")
+	}
+	return s
 }
 
 // Reader U59BE
@@ -9106,14 +10521,14 @@ func (d *D) U59BE() uint64 {
 
 // TryFieldScalarU59BE tries to add a field and read 59 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU59BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(59, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU59BE adds a field and reads 59 bit unsigned integer in big-endian
@@ -9127,13 +10542,20 @@ func (d *D) FieldScalarU59BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU59BE tries to add a field and read 59 bit unsigned integer in big-endian
 func (d *D) TryFieldU59BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU59BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(59, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU59BE adds a field and reads 59 bit unsigned integer in big-endian
 func (d *D) FieldU59BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU59BE(name, sms...).Actual
+	s, err := d.TryFieldU59BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U59BE")
+	}
+	return s
 }
 
 // Reader U60BE
@@ -9152,14 +10574,14 @@ func (d *D) U60BE() uint64 {
 
 // TryFieldScalarU60BE tries to add a field and read 60 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU60BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(60, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU60BE adds a field and reads 60 bit unsigned integer in big-endian
@@ -9173,13 +10595,20 @@ func (d *D) FieldScalarU60BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU60BE tries to add a field and read 60 bit unsigned integer in big-endian
 func (d *D) TryFieldU60BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU60BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(60, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU60BE adds a field and reads 60 bit unsigned integer in big-endian
 func (d *D) FieldU60BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU60BE(name, sms...).Actual
+	s, err := d.TryFieldU60BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U60BE")
+	}
+	return s
 }
 
 // Reader U61BE
@@ -9198,14 +10627,14 @@ func (d *D) U61BE() uint64 {
 
 // TryFieldScalarU61BE tries to add a field and read 61 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU61BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(61, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU61BE adds a field and reads 61 bit unsigned integer in big-endian
@@ -9219,13 +10648,20 @@ func (d *D) FieldScalarU61BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU61BE tries to add a field and read 61 bit unsigned integer in big-endian
 func (d *D) TryFieldU61BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU61BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(61, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU61BE adds a field and reads 61 bit unsigned integer in big-endian
 func (d *D) FieldU61BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU61BE(name, sms...).Actual
+	s, err := d.TryFieldU61BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U61BE")
+	}
+	return s
 }
 
 // Reader U62BE
@@ -9244,14 +10680,14 @@ func (d *D) U62BE() uint64 {
 
 // TryFieldScalarU62BE tries to add a field and read 62 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU62BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(62, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU62BE adds a field and reads 62 bit unsigned integer in big-endian
@@ -9265,13 +10701,20 @@ func (d *D) FieldScalarU62BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU62BE tries to add a field and read 62 bit unsigned integer in big-endian
 func (d *D) TryFieldU62BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU62BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(62, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU62BE adds a field and reads 62 bit unsigned integer in big-endian
 func (d *D) FieldU62BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU62BE(name, sms...).Actual
+	s, err := d.TryFieldU62BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U62BE")
+	}
+	return s
 }
 
 // Reader U63BE
@@ -9290,14 +10733,14 @@ func (d *D) U63BE() uint64 {
 
 // TryFieldScalarU63BE tries to add a field and read 63 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU63BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(63, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU63BE adds a field and reads 63 bit unsigned integer in big-endian
@@ -9311,13 +10754,20 @@ func (d *D) FieldScalarU63BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU63BE tries to add a field and read 63 bit unsigned integer in big-endian
 func (d *D) TryFieldU63BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU63BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(63, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU63BE adds a field and reads 63 bit unsigned integer in big-endian
 func (d *D) FieldU63BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU63BE(name, sms...).Actual
+	s, err := d.TryFieldU63BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U63BE")
+	}
+	return s
 }
 
 // Reader U64BE
@@ -9336,14 +10786,14 @@ func (d *D) U64BE() uint64 {
 
 // TryFieldScalarU64BE tries to add a field and read 64 bit unsigned integer in big-endian
 func (d *D) TryFieldScalarU64BE(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUEndian(64, BigEndian)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarU64BE adds a field and reads 64 bit unsigned integer in big-endian
@@ -9357,13 +10807,20 @@ func (d *D) FieldScalarU64BE(name string, sms ...scalar.UintMapper) *scalar.Uint
 
 // TryFieldU64BE tries to add a field and read 64 bit unsigned integer in big-endian
 func (d *D) TryFieldU64BE(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarU64BE(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, uintEncoding(BigEndian), func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUEndian(64, BigEndian)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldU64BE adds a field and reads 64 bit unsigned integer in big-endian
 func (d *D) FieldU64BE(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarU64BE(name, sms...).Actual
+	s, err := d.TryFieldU64BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "U64BE")
+	}
+	return s
 }
 
 // Reader S
@@ -9382,14 +10839,14 @@ func (d *D) S(nBits int) int64 {
 
 // TryFieldScalarS tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldScalarS(name string, nBits int, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(nBits, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS adds a field and reads nBits bits signed integer in current endian
@@ -9403,13 +10860,20 @@ func (d *D) FieldScalarS(name string, nBits int, sms ...scalar.SintMapper) *scal
 
 // TryFieldS tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldS(name string, nBits int, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS(name, nBits, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(nBits, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS adds a field and reads nBits bits signed integer in current endian
 func (d *D) FieldS(name string, nBits int, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS(name, nBits, sms...).Actual
+	s, err := d.TryFieldS(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S")
+	}
+	return s
 }
 
 // Reader SE
@@ -9428,14 +10892,14 @@ func (d *D) SE(nBits int, endian Endian) int64 {
 
 // TryFieldScalarSE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldScalarSE(name string, nBits int, endian Endian, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(nBits, endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSE adds a field and reads nBits signed integer in specified endian
@@ -9449,13 +10913,20 @@ func (d *D) FieldScalarSE(name string, nBits int, endian Endian, sms ...scalar.S
 
 // TryFieldSE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldSE(name string, nBits int, endian Endian, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarSE(name, nBits, endian, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(nBits, endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSE adds a field and reads nBits signed integer in specified endian
 func (d *D) FieldSE(name string, nBits int, endian Endian, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarSE(name, nBits, endian, sms...).Actual
+	s, err := d.TryFieldSE(name, nBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SE")
+	}
+	return s
 }
 
 // Reader S1
@@ -9474,14 +10945,14 @@ func (d *D) S1() int64 {
 
 // TryFieldScalarS1 tries to add a field and read 1 bit signed integer in current endian
 func (d *D) TryFieldScalarS1(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(1, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS1 adds a field and reads 1 bit signed integer in current endian
@@ -9495,13 +10966,20 @@ func (d *D) FieldScalarS1(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS1 tries to add a field and read 1 bit signed integer in current endian
 func (d *D) TryFieldS1(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS1(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(1, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS1 adds a field and reads 1 bit signed integer in current endian
 func (d *D) FieldS1(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS1(name, sms...).Actual
+	s, err := d.TryFieldS1(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S1")
+	}
+	return s
 }
 
 // Reader S2
@@ -9520,14 +10998,14 @@ func (d *D) S2() int64 {
 
 // TryFieldScalarS2 tries to add a field and read 2 bit signed integer in current endian
 func (d *D) TryFieldScalarS2(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(2, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS2 adds a field and reads 2 bit signed integer in current endian
@@ -9541,13 +11019,20 @@ func (d *D) FieldScalarS2(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS2 tries to add a field and read 2 bit signed integer in current endian
 func (d *D) TryFieldS2(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS2(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(2, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS2 adds a field and reads 2 bit signed integer in current endian
 func (d *D) FieldS2(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS2(name, sms...).Actual
+	s, err := d.TryFieldS2(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S2")
+	}
+	return s
 }
 
 // Reader S3
@@ -9566,14 +11051,14 @@ func (d *D) S3() int64 {
 
 // TryFieldScalarS3 tries to add a field and read 3 bit signed integer in current endian
 func (d *D) TryFieldScalarS3(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(3, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS3 adds a field and reads 3 bit signed integer in current endian
@@ -9587,13 +11072,20 @@ func (d *D) FieldScalarS3(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS3 tries to add a field and read 3 bit signed integer in current endian
 func (d *D) TryFieldS3(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS3(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(3, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS3 adds a field and reads 3 bit signed integer in current endian
 func (d *D) FieldS3(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS3(name, sms...).Actual
+	s, err := d.TryFieldS3(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S3")
+	}
+	return s
 }
 
 // Reader S4
@@ -9612,14 +11104,14 @@ func (d *D) S4() int64 {
 
 // TryFieldScalarS4 tries to add a field and read 4 bit signed integer in current endian
 func (d *D) TryFieldScalarS4(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(4, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS4 adds a field and reads 4 bit signed integer in current endian
@@ -9633,13 +11125,20 @@ func (d *D) FieldScalarS4(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS4 tries to add a field and read 4 bit signed integer in current endian
 func (d *D) TryFieldS4(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS4(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(4, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS4 adds a field and reads 4 bit signed integer in current endian
 func (d *D) FieldS4(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS4(name, sms...).Actual
+	s, err := d.TryFieldS4(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S4")
+	}
+	return s
 }
 
 // Reader S5
@@ -9658,14 +11157,14 @@ func (d *D) S5() int64 {
 
 // TryFieldScalarS5 tries to add a field and read 5 bit signed integer in current endian
 func (d *D) TryFieldScalarS5(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(5, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS5 adds a field and reads 5 bit signed integer in current endian
@@ -9679,13 +11178,20 @@ func (d *D) FieldScalarS5(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS5 tries to add a field and read 5 bit signed integer in current endian
 func (d *D) TryFieldS5(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS5(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(5, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS5 adds a field and reads 5 bit signed integer in current endian
 func (d *D) FieldS5(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS5(name, sms...).Actual
+	s, err := d.TryFieldS5(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S5")
+	}
+	return s
 }
 
 // Reader S6
@@ -9704,14 +11210,14 @@ func (d *D) S6() int64 {
 
 // TryFieldScalarS6 tries to add a field and read 6 bit signed integer in current endian
 func (d *D) TryFieldScalarS6(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(6, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS6 adds a field and reads 6 bit signed integer in current endian
@@ -9725,13 +11231,20 @@ func (d *D) FieldScalarS6(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS6 tries to add a field and read 6 bit signed integer in current endian
 func (d *D) TryFieldS6(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS6(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(6, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS6 adds a field and reads 6 bit signed integer in current endian
 func (d *D) FieldS6(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS6(name, sms...).Actual
+	s, err := d.TryFieldS6(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S6")
+	}
+	return s
 }
 
 // Reader S7
@@ -9750,14 +11263,14 @@ func (d *D) S7() int64 {
 
 // TryFieldScalarS7 tries to add a field and read 7 bit signed integer in current endian
 func (d *D) TryFieldScalarS7(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(7, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS7 adds a field and reads 7 bit signed integer in current endian
@@ -9771,13 +11284,20 @@ func (d *D) FieldScalarS7(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS7 tries to add a field and read 7 bit signed integer in current endian
 func (d *D) TryFieldS7(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS7(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(7, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS7 adds a field and reads 7 bit signed integer in current endian
 func (d *D) FieldS7(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS7(name, sms...).Actual
+	s, err := d.TryFieldS7(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S7")
+	}
+	return s
 }
 
 // Reader S8
@@ -9796,14 +11316,14 @@ func (d *D) S8() int64 {
 
 // TryFieldScalarS8 tries to add a field and read 8 bit signed integer in current endian
 func (d *D) TryFieldScalarS8(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(8, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS8 adds a field and reads 8 bit signed integer in current endian
@@ -9817,13 +11337,20 @@ func (d *D) FieldScalarS8(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS8 tries to add a field and read 8 bit signed integer in current endian
 func (d *D) TryFieldS8(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS8(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(8, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS8 adds a field and reads 8 bit signed integer in current endian
 func (d *D) FieldS8(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS8(name, sms...).Actual
+	s, err := d.TryFieldS8(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S8")
+	}
+	return s
 }
 
 // Reader S9
@@ -9842,14 +11369,14 @@ func (d *D) S9() int64 {
 
 // TryFieldScalarS9 tries to add a field and read 9 bit signed integer in current endian
 func (d *D) TryFieldScalarS9(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(9, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS9 adds a field and reads 9 bit signed integer in current endian
@@ -9863,13 +11390,20 @@ func (d *D) FieldScalarS9(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS9 tries to add a field and read 9 bit signed integer in current endian
 func (d *D) TryFieldS9(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS9(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(9, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS9 adds a field and reads 9 bit signed integer in current endian
 func (d *D) FieldS9(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS9(name, sms...).Actual
+	s, err := d.TryFieldS9(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S9")
+	}
+	return s
 }
 
 // Reader S10
@@ -9888,14 +11422,14 @@ func (d *D) S10() int64 {
 
 // TryFieldScalarS10 tries to add a field and read 10 bit signed integer in current endian
 func (d *D) TryFieldScalarS10(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(10, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS10 adds a field and reads 10 bit signed integer in current endian
@@ -9909,13 +11443,20 @@ func (d *D) FieldScalarS10(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS10 tries to add a field and read 10 bit signed integer in current endian
 func (d *D) TryFieldS10(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS10(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(10, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS10 adds a field and reads 10 bit signed integer in current endian
 func (d *D) FieldS10(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS10(name, sms...).Actual
+	s, err := d.TryFieldS10(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S10")
+	}
+	return s
 }
 
 // Reader S11
@@ -9934,14 +11475,14 @@ func (d *D) S11() int64 {
 
 // TryFieldScalarS11 tries to add a field and read 11 bit signed integer in current endian
 func (d *D) TryFieldScalarS11(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(11, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS11 adds a field and reads 11 bit signed integer in current endian
@@ -9955,13 +11496,20 @@ func (d *D) FieldScalarS11(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS11 tries to add a field and read 11 bit signed integer in current endian
 func (d *D) TryFieldS11(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS11(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(11, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS11 adds a field and reads 11 bit signed integer in current endian
 func (d *D) FieldS11(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS11(name, sms...).Actual
+	s, err := d.TryFieldS11(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S11")
+	}
+	return s
 }
 
 // Reader S12
@@ -9980,14 +11528,14 @@ func (d *D) S12() int64 {
 
 // TryFieldScalarS12 tries to add a field and read 12 bit signed integer in current endian
 func (d *D) TryFieldScalarS12(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(12, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS12 adds a field and reads 12 bit signed integer in current endian
@@ -10001,13 +11549,20 @@ func (d *D) FieldScalarS12(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS12 tries to add a field and read 12 bit signed integer in current endian
 func (d *D) TryFieldS12(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS12(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(12, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS12 adds a field and reads 12 bit signed integer in current endian
 func (d *D) FieldS12(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS12(name, sms...).Actual
+	s, err := d.TryFieldS12(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S12")
+	}
+	return s
 }
 
 // Reader S13
@@ -10026,14 +11581,14 @@ func (d *D) S13() int64 {
 
 // TryFieldScalarS13 tries to add a field and read 13 bit signed integer in current endian
 func (d *D) TryFieldScalarS13(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(13, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS13 adds a field and reads 13 bit signed integer in current endian
@@ -10047,13 +11602,20 @@ func (d *D) FieldScalarS13(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS13 tries to add a field and read 13 bit signed integer in current endian
 func (d *D) TryFieldS13(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS13(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(13, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS13 adds a field and reads 13 bit signed integer in current endian
 func (d *D) FieldS13(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS13(name, sms...).Actual
+	s, err := d.TryFieldS13(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S13")
+	}
+	return s
 }
 
 // Reader S14
@@ -10072,14 +11634,14 @@ func (d *D) S14() int64 {
 
 // TryFieldScalarS14 tries to add a field and read 14 bit signed integer in current endian
 func (d *D) TryFieldScalarS14(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(14, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS14 adds a field and reads 14 bit signed integer in current endian
@@ -10093,13 +11655,20 @@ func (d *D) FieldScalarS14(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS14 tries to add a field and read 14 bit signed integer in current endian
 func (d *D) TryFieldS14(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS14(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(14, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS14 adds a field and reads 14 bit signed integer in current endian
 func (d *D) FieldS14(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS14(name, sms...).Actual
+	s, err := d.TryFieldS14(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S14")
+	}
+	return s
 }
 
 // Reader S15
@@ -10118,14 +11687,14 @@ func (d *D) S15() int64 {
 
 // TryFieldScalarS15 tries to add a field and read 15 bit signed integer in current endian
 func (d *D) TryFieldScalarS15(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(15, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS15 adds a field and reads 15 bit signed integer in current endian
@@ -10139,13 +11708,20 @@ func (d *D) FieldScalarS15(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS15 tries to add a field and read 15 bit signed integer in current endian
 func (d *D) TryFieldS15(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS15(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(15, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS15 adds a field and reads 15 bit signed integer in current endian
 func (d *D) FieldS15(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS15(name, sms...).Actual
+	s, err := d.TryFieldS15(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S15")
+	}
+	return s
 }
 
 // Reader S16
@@ -10164,14 +11740,14 @@ func (d *D) S16() int64 {
 
 // TryFieldScalarS16 tries to add a field and read 16 bit signed integer in current endian
 func (d *D) TryFieldScalarS16(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(16, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS16 adds a field and reads 16 bit signed integer in current endian
@@ -10185,13 +11761,20 @@ func (d *D) FieldScalarS16(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS16 tries to add a field and read 16 bit signed integer in current endian
 func (d *D) TryFieldS16(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS16(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(16, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS16 adds a field and reads 16 bit signed integer in current endian
 func (d *D) FieldS16(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS16(name, sms...).Actual
+	s, err := d.TryFieldS16(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S16")
+	}
+	return s
 }
 
 // Reader S17
@@ -10210,14 +11793,14 @@ func (d *D) S17() int64 {
 
 // TryFieldScalarS17 tries to add a field and read 17 bit signed integer in current endian
 func (d *D) TryFieldScalarS17(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(17, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS17 adds a field and reads 17 bit signed integer in current endian
@@ -10231,13 +11814,20 @@ func (d *D) FieldScalarS17(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS17 tries to add a field and read 17 bit signed integer in current endian
 func (d *D) TryFieldS17(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS17(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(17, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS17 adds a field and reads 17 bit signed integer in current endian
 func (d *D) FieldS17(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS17(name, sms...).Actual
+	s, err := d.TryFieldS17(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S17")
+	}
+	return s
 }
 
 // Reader S18
@@ -10256,14 +11846,14 @@ func (d *D) S18() int64 {
 
 // TryFieldScalarS18 tries to add a field and read 18 bit signed integer in current endian
 func (d *D) TryFieldScalarS18(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(18, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS18 adds a field and reads 18 bit signed integer in current endian
@@ -10277,13 +11867,20 @@ func (d *D) FieldScalarS18(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS18 tries to add a field and read 18 bit signed integer in current endian
 func (d *D) TryFieldS18(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS18(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(18, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS18 adds a field and reads 18 bit signed integer in current endian
 func (d *D) FieldS18(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS18(name, sms...).Actual
+	s, err := d.TryFieldS18(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S18")
+	}
+	return s
 }
 
 // Reader S19
@@ -10302,14 +11899,14 @@ func (d *D) S19() int64 {
 
 // TryFieldScalarS19 tries to add a field and read 19 bit signed integer in current endian
 func (d *D) TryFieldScalarS19(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(19, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS19 adds a field and reads 19 bit signed integer in current endian
@@ -10323,13 +11920,20 @@ func (d *D) FieldScalarS19(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS19 tries to add a field and read 19 bit signed integer in current endian
 func (d *D) TryFieldS19(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS19(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(19, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS19 adds a field and reads 19 bit signed integer in current endian
 func (d *D) FieldS19(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS19(name, sms...).Actual
+	s, err := d.TryFieldS19(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S19")
+	}
+	return s
 }
 
 // Reader S20
@@ -10348,14 +11952,14 @@ func (d *D) S20() int64 {
 
 // TryFieldScalarS20 tries to add a field and read 20 bit signed integer in current endian
 func (d *D) TryFieldScalarS20(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(20, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS20 adds a field and reads 20 bit signed integer in current endian
@@ -10369,13 +11973,20 @@ func (d *D) FieldScalarS20(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS20 tries to add a field and read 20 bit signed integer in current endian
 func (d *D) TryFieldS20(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS20(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(20, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS20 adds a field and reads 20 bit signed integer in current endian
 func (d *D) FieldS20(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS20(name, sms...).Actual
+	s, err := d.TryFieldS20(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S20")
+	}
+	return s
 }
 
 // Reader S21
@@ -10394,14 +12005,14 @@ func (d *D) S21() int64 {
 
 // TryFieldScalarS21 tries to add a field and read 21 bit signed integer in current endian
 func (d *D) TryFieldScalarS21(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(21, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS21 adds a field and reads 21 bit signed integer in current endian
@@ -10415,13 +12026,20 @@ func (d *D) FieldScalarS21(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS21 tries to add a field and read 21 bit signed integer in current endian
 func (d *D) TryFieldS21(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS21(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(21, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS21 adds a field and reads 21 bit signed integer in current endian
 func (d *D) FieldS21(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS21(name, sms...).Actual
+	s, err := d.TryFieldS21(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S21")
+	}
+	return s
 }
 
 // Reader S22
@@ -10440,14 +12058,14 @@ func (d *D) S22() int64 {
 
 // TryFieldScalarS22 tries to add a field and read 22 bit signed integer in current endian
 func (d *D) TryFieldScalarS22(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(22, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS22 adds a field and reads 22 bit signed integer in current endian
@@ -10461,13 +12079,20 @@ func (d *D) FieldScalarS22(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS22 tries to add a field and read 22 bit signed integer in current endian
 func (d *D) TryFieldS22(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS22(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(22, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS22 adds a field and reads 22 bit signed integer in current endian
 func (d *D) FieldS22(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS22(name, sms...).Actual
+	s, err := d.TryFieldS22(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S22")
+	}
+	return s
 }
 
 // Reader S23
@@ -10486,14 +12111,14 @@ func (d *D) S23() int64 {
 
 // TryFieldScalarS23 tries to add a field and read 23 bit signed integer in current endian
 func (d *D) TryFieldScalarS23(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(23, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS23 adds a field and reads 23 bit signed integer in current endian
@@ -10507,13 +12132,20 @@ func (d *D) FieldScalarS23(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS23 tries to add a field and read 23 bit signed integer in current endian
 func (d *D) TryFieldS23(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS23(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(23, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS23 adds a field and reads 23 bit signed integer in current endian
 func (d *D) FieldS23(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS23(name, sms...).Actual
+	s, err := d.TryFieldS23(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S23")
+	}
+	return s
 }
 
 // Reader S24
@@ -10532,14 +12164,14 @@ func (d *D) S24() int64 {
 
 // TryFieldScalarS24 tries to add a field and read 24 bit signed integer in current endian
 func (d *D) TryFieldScalarS24(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(24, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS24 adds a field and reads 24 bit signed integer in current endian
@@ -10553,13 +12185,20 @@ func (d *D) FieldScalarS24(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS24 tries to add a field and read 24 bit signed integer in current endian
 func (d *D) TryFieldS24(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS24(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(24, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS24 adds a field and reads 24 bit signed integer in current endian
 func (d *D) FieldS24(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS24(name, sms...).Actual
+	s, err := d.TryFieldS24(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S24")
+	}
+	return s
 }
 
 // Reader S25
@@ -10578,14 +12217,14 @@ func (d *D) S25() int64 {
 
 // TryFieldScalarS25 tries to add a field and read 25 bit signed integer in current endian
 func (d *D) TryFieldScalarS25(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(25, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS25 adds a field and reads 25 bit signed integer in current endian
@@ -10599,13 +12238,20 @@ func (d *D) FieldScalarS25(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS25 tries to add a field and read 25 bit signed integer in current endian
 func (d *D) TryFieldS25(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS25(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(25, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS25 adds a field and reads 25 bit signed integer in current endian
 func (d *D) FieldS25(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS25(name, sms...).Actual
+	s, err := d.TryFieldS25(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S25")
+	}
+	return s
 }
 
 // Reader S26
@@ -10624,14 +12270,14 @@ func (d *D) S26() int64 {
 
 // TryFieldScalarS26 tries to add a field and read 26 bit signed integer in current endian
 func (d *D) TryFieldScalarS26(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(26, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS26 adds a field and reads 26 bit signed integer in current endian
@@ -10645,13 +12291,20 @@ func (d *D) FieldScalarS26(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS26 tries to add a field and read 26 bit signed integer in current endian
 func (d *D) TryFieldS26(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS26(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(26, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS26 adds a field and reads 26 bit signed integer in current endian
 func (d *D) FieldS26(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS26(name, sms...).Actual
+	s, err := d.TryFieldS26(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S26")
+	}
+	return s
 }
 
 // Reader S27
@@ -10670,14 +12323,14 @@ func (d *D) S27() int64 {
 
 // TryFieldScalarS27 tries to add a field and read 27 bit signed integer in current endian
 func (d *D) TryFieldScalarS27(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(27, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS27 adds a field and reads 27 bit signed integer in current endian
@@ -10691,13 +12344,20 @@ func (d *D) FieldScalarS27(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS27 tries to add a field and read 27 bit signed integer in current endian
 func (d *D) TryFieldS27(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS27(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(27, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS27 adds a field and reads 27 bit signed integer in current endian
 func (d *D) FieldS27(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS27(name, sms...).Actual
+	s, err := d.TryFieldS27(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S27")
+	}
+	return s
 }
 
 // Reader S28
@@ -10716,14 +12376,14 @@ func (d *D) S28() int64 {
 
 // TryFieldScalarS28 tries to add a field and read 28 bit signed integer in current endian
 func (d *D) TryFieldScalarS28(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(28, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS28 adds a field and reads 28 bit signed integer in current endian
@@ -10737,13 +12397,20 @@ func (d *D) FieldScalarS28(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS28 tries to add a field and read 28 bit signed integer in current endian
 func (d *D) TryFieldS28(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS28(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(28, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS28 adds a field and reads 28 bit signed integer in current endian
 func (d *D) FieldS28(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS28(name, sms...).Actual
+	s, err := d.TryFieldS28(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S28")
+	}
+	return s
 }
 
 // Reader S29
@@ -10762,14 +12429,14 @@ func (d *D) S29() int64 {
 
 // TryFieldScalarS29 tries to add a field and read 29 bit signed integer in current endian
 func (d *D) TryFieldScalarS29(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(29, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS29 adds a field and reads 29 bit signed integer in current endian
@@ -10783,13 +12450,20 @@ func (d *D) FieldScalarS29(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS29 tries to add a field and read 29 bit signed integer in current endian
 func (d *D) TryFieldS29(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS29(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(29, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS29 adds a field and reads 29 bit signed integer in current endian
 func (d *D) FieldS29(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS29(name, sms...).Actual
+	s, err := d.TryFieldS29(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S29")
+	}
+	return s
 }
 
 // Reader S30
@@ -10808,14 +12482,14 @@ func (d *D) S30() int64 {
 
 // TryFieldScalarS30 tries to add a field and read 30 bit signed integer in current endian
 func (d *D) TryFieldScalarS30(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(30, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS30 adds a field and reads 30 bit signed integer in current endian
@@ -10829,13 +12503,20 @@ func (d *D) FieldScalarS30(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS30 tries to add a field and read 30 bit signed integer in current endian
 func (d *D) TryFieldS30(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS30(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(30, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS30 adds a field and reads 30 bit signed integer in current endian
 func (d *D) FieldS30(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS30(name, sms...).Actual
+	s, err := d.TryFieldS30(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S30")
+	}
+	return s
 }
 
 // Reader S31
@@ -10854,14 +12535,14 @@ func (d *D) S31() int64 {
 
 // TryFieldScalarS31 tries to add a field and read 31 bit signed integer in current endian
 func (d *D) TryFieldScalarS31(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(31, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS31 adds a field and reads 31 bit signed integer in current endian
@@ -10875,13 +12556,20 @@ func (d *D) FieldScalarS31(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS31 tries to add a field and read 31 bit signed integer in current endian
 func (d *D) TryFieldS31(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS31(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(31, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS31 adds a field and reads 31 bit signed integer in current endian
 func (d *D) FieldS31(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS31(name, sms...).Actual
+	s, err := d.TryFieldS31(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S31")
+	}
+	return s
 }
 
 // Reader S32
@@ -10900,14 +12588,14 @@ func (d *D) S32() int64 {
 
 // TryFieldScalarS32 tries to add a field and read 32 bit signed integer in current endian
 func (d *D) TryFieldScalarS32(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(32, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS32 adds a field and reads 32 bit signed integer in current endian
@@ -10921,13 +12609,20 @@ func (d *D) FieldScalarS32(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS32 tries to add a field and read 32 bit signed integer in current endian
 func (d *D) TryFieldS32(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS32(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(32, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS32 adds a field and reads 32 bit signed integer in current endian
 func (d *D) FieldS32(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS32(name, sms...).Actual
+	s, err := d.TryFieldS32(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S32")
+	}
+	return s
 }
 
 // Reader S33
@@ -10946,14 +12641,14 @@ func (d *D) S33() int64 {
 
 // TryFieldScalarS33 tries to add a field and read 33 bit signed integer in current endian
 func (d *D) TryFieldScalarS33(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(33, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS33 adds a field and reads 33 bit signed integer in current endian
@@ -10967,13 +12662,20 @@ func (d *D) FieldScalarS33(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS33 tries to add a field and read 33 bit signed integer in current endian
 func (d *D) TryFieldS33(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS33(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(33, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS33 adds a field and reads 33 bit signed integer in current endian
 func (d *D) FieldS33(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS33(name, sms...).Actual
+	s, err := d.TryFieldS33(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S33")
+	}
+	return s
 }
 
 // Reader S34
@@ -10992,14 +12694,14 @@ func (d *D) S34() int64 {
 
 // TryFieldScalarS34 tries to add a field and read 34 bit signed integer in current endian
 func (d *D) TryFieldScalarS34(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(34, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS34 adds a field and reads 34 bit signed integer in current endian
@@ -11013,13 +12715,20 @@ func (d *D) FieldScalarS34(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS34 tries to add a field and read 34 bit signed integer in current endian
 func (d *D) TryFieldS34(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS34(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(34, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS34 adds a field and reads 34 bit signed integer in current endian
 func (d *D) FieldS34(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS34(name, sms...).Actual
+	s, err := d.TryFieldS34(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S34")
+	}
+	return s
 }
 
 // Reader S35
@@ -11038,14 +12747,14 @@ func (d *D) S35() int64 {
 
 // TryFieldScalarS35 tries to add a field and read 35 bit signed integer in current endian
 func (d *D) TryFieldScalarS35(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(35, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS35 adds a field and reads 35 bit signed integer in current endian
@@ -11059,13 +12768,20 @@ func (d *D) FieldScalarS35(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS35 tries to add a field and read 35 bit signed integer in current endian
 func (d *D) TryFieldS35(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS35(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(35, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS35 adds a field and reads 35 bit signed integer in current endian
 func (d *D) FieldS35(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS35(name, sms...).Actual
+	s, err := d.TryFieldS35(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S35")
+	}
+	return s
 }
 
 // Reader S36
@@ -11084,14 +12800,14 @@ func (d *D) S36() int64 {
 
 // TryFieldScalarS36 tries to add a field and read 36 bit signed integer in current endian
 func (d *D) TryFieldScalarS36(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(36, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS36 adds a field and reads 36 bit signed integer in current endian
@@ -11105,13 +12821,20 @@ func (d *D) FieldScalarS36(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS36 tries to add a field and read 36 bit signed integer in current endian
 func (d *D) TryFieldS36(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS36(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(36, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS36 adds a field and reads 36 bit signed integer in current endian
 func (d *D) FieldS36(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS36(name, sms...).Actual
+	s, err := d.TryFieldS36(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S36")
+	}
+	return s
 }
 
 // Reader S37
@@ -11130,14 +12853,14 @@ func (d *D) S37() int64 {
 
 // TryFieldScalarS37 tries to add a field and read 37 bit signed integer in current endian
 func (d *D) TryFieldScalarS37(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(37, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS37 adds a field and reads 37 bit signed integer in current endian
@@ -11151,13 +12874,20 @@ func (d *D) FieldScalarS37(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS37 tries to add a field and read 37 bit signed integer in current endian
 func (d *D) TryFieldS37(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS37(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(37, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS37 adds a field and reads 37 bit signed integer in current endian
 func (d *D) FieldS37(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS37(name, sms...).Actual
+	s, err := d.TryFieldS37(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S37")
+	}
+	return s
 }
 
 // Reader S38
@@ -11176,14 +12906,14 @@ func (d *D) S38() int64 {
 
 // TryFieldScalarS38 tries to add a field and read 38 bit signed integer in current endian
 func (d *D) TryFieldScalarS38(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(38, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS38 adds a field and reads 38 bit signed integer in current endian
@@ -11197,13 +12927,20 @@ func (d *D) FieldScalarS38(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS38 tries to add a field and read 38 bit signed integer in current endian
 func (d *D) TryFieldS38(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS38(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(38, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS38 adds a field and reads 38 bit signed integer in current endian
 func (d *D) FieldS38(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS38(name, sms...).Actual
+	s, err := d.TryFieldS38(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S38")
+	}
+	return s
 }
 
 // Reader S39
@@ -11222,14 +12959,14 @@ func (d *D) S39() int64 {
 
 // TryFieldScalarS39 tries to add a field and read 39 bit signed integer in current endian
 func (d *D) TryFieldScalarS39(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(39, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS39 adds a field and reads 39 bit signed integer in current endian
@@ -11243,13 +12980,20 @@ func (d *D) FieldScalarS39(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS39 tries to add a field and read 39 bit signed integer in current endian
 func (d *D) TryFieldS39(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS39(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(39, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS39 adds a field and reads 39 bit signed integer in current endian
 func (d *D) FieldS39(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS39(name, sms...).Actual
+	s, err := d.TryFieldS39(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S39")
+	}
+	return s
 }
 
 // Reader S40
@@ -11268,14 +13012,14 @@ func (d *D) S40() int64 {
 
 // TryFieldScalarS40 tries to add a field and read 40 bit signed integer in current endian
 func (d *D) TryFieldScalarS40(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(40, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS40 adds a field and reads 40 bit signed integer in current endian
@@ -11289,13 +13033,20 @@ func (d *D) FieldScalarS40(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS40 tries to add a field and read 40 bit signed integer in current endian
 func (d *D) TryFieldS40(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS40(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(40, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS40 adds a field and reads 40 bit signed integer in current endian
 func (d *D) FieldS40(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS40(name, sms...).Actual
+	s, err := d.TryFieldS40(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S40")
+	}
+	return s
 }
 
 // Reader S41
@@ -11314,14 +13065,14 @@ func (d *D) S41() int64 {
 
 // TryFieldScalarS41 tries to add a field and read 41 bit signed integer in current endian
 func (d *D) TryFieldScalarS41(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(41, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS41 adds a field and reads 41 bit signed integer in current endian
@@ -11335,13 +13086,20 @@ func (d *D) FieldScalarS41(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS41 tries to add a field and read 41 bit signed integer in current endian
 func (d *D) TryFieldS41(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS41(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(41, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS41 adds a field and reads 41 bit signed integer in current endian
 func (d *D) FieldS41(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS41(name, sms...).Actual
+	s, err := d.TryFieldS41(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S41")
+	}
+	return s
 }
 
 // Reader S42
@@ -11360,14 +13118,14 @@ func (d *D) S42() int64 {
 
 // TryFieldScalarS42 tries to add a field and read 42 bit signed integer in current endian
 func (d *D) TryFieldScalarS42(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(42, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS42 adds a field and reads 42 bit signed integer in current endian
@@ -11381,13 +13139,20 @@ func (d *D) FieldScalarS42(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS42 tries to add a field and read 42 bit signed integer in current endian
 func (d *D) TryFieldS42(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS42(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(42, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS42 adds a field and reads 42 bit signed integer in current endian
 func (d *D) FieldS42(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS42(name, sms...).Actual
+	s, err := d.TryFieldS42(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S42")
+	}
+	return s
 }
 
 // Reader S43
@@ -11406,14 +13171,14 @@ func (d *D) S43() int64 {
 
 // TryFieldScalarS43 tries to add a field and read 43 bit signed integer in current endian
 func (d *D) TryFieldScalarS43(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(43, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS43 adds a field and reads 43 bit signed integer in current endian
@@ -11427,13 +13192,20 @@ func (d *D) FieldScalarS43(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS43 tries to add a field and read 43 bit signed integer in current endian
 func (d *D) TryFieldS43(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS43(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(43, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS43 adds a field and reads 43 bit signed integer in current endian
 func (d *D) FieldS43(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS43(name, sms...).Actual
+	s, err := d.TryFieldS43(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S43")
+	}
+	return s
 }
 
 // Reader S44
@@ -11452,14 +13224,14 @@ func (d *D) S44() int64 {
 
 // TryFieldScalarS44 tries to add a field and read 44 bit signed integer in current endian
 func (d *D) TryFieldScalarS44(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(44, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS44 adds a field and reads 44 bit signed integer in current endian
@@ -11473,13 +13245,20 @@ func (d *D) FieldScalarS44(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS44 tries to add a field and read 44 bit signed integer in current endian
 func (d *D) TryFieldS44(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS44(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(44, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS44 adds a field and reads 44 bit signed integer in current endian
 func (d *D) FieldS44(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS44(name, sms...).Actual
+	s, err := d.TryFieldS44(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S44")
+	}
+	return s
 }
 
 // Reader S45
@@ -11498,14 +13277,14 @@ func (d *D) S45() int64 {
 
 // TryFieldScalarS45 tries to add a field and read 45 bit signed integer in current endian
 func (d *D) TryFieldScalarS45(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(45, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS45 adds a field and reads 45 bit signed integer in current endian
@@ -11519,13 +13298,20 @@ func (d *D) FieldScalarS45(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS45 tries to add a field and read 45 bit signed integer in current endian
 func (d *D) TryFieldS45(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS45(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(45, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS45 adds a field and reads 45 bit signed integer in current endian
 func (d *D) FieldS45(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS45(name, sms...).Actual
+	s, err := d.TryFieldS45(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S45")
+	}
+	return s
 }
 
 // Reader S46
@@ -11544,14 +13330,14 @@ func (d *D) S46() int64 {
 
 // TryFieldScalarS46 tries to add a field and read 46 bit signed integer in current endian
 func (d *D) TryFieldScalarS46(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(46, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS46 adds a field and reads 46 bit signed integer in current endian
@@ -11565,13 +13351,20 @@ func (d *D) FieldScalarS46(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS46 tries to add a field and read 46 bit signed integer in current endian
 func (d *D) TryFieldS46(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS46(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(46, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS46 adds a field and reads 46 bit signed integer in current endian
 func (d *D) FieldS46(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS46(name, sms...).Actual
+	s, err := d.TryFieldS46(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S46")
+	}
+	return s
 }
 
 // Reader S47
@@ -11590,14 +13383,14 @@ func (d *D) S47() int64 {
 
 // TryFieldScalarS47 tries to add a field and read 47 bit signed integer in current endian
 func (d *D) TryFieldScalarS47(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(47, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS47 adds a field and reads 47 bit signed integer in current endian
@@ -11611,13 +13404,20 @@ func (d *D) FieldScalarS47(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS47 tries to add a field and read 47 bit signed integer in current endian
 func (d *D) TryFieldS47(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS47(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(47, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS47 adds a field and reads 47 bit signed integer in current endian
 func (d *D) FieldS47(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS47(name, sms...).Actual
+	s, err := d.TryFieldS47(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S47")
+	}
+	return s
 }
 
 // Reader S48
@@ -11636,14 +13436,14 @@ func (d *D) S48() int64 {
 
 // TryFieldScalarS48 tries to add a field and read 48 bit signed integer in current endian
 func (d *D) TryFieldScalarS48(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(48, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS48 adds a field and reads 48 bit signed integer in current endian
@@ -11657,13 +13457,20 @@ func (d *D) FieldScalarS48(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS48 tries to add a field and read 48 bit signed integer in current endian
 func (d *D) TryFieldS48(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS48(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(48, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS48 adds a field and reads 48 bit signed integer in current endian
 func (d *D) FieldS48(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS48(name, sms...).Actual
+	s, err := d.TryFieldS48(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S48")
+	}
+	return s
 }
 
 // Reader S49
@@ -11682,14 +13489,14 @@ func (d *D) S49() int64 {
 
 // TryFieldScalarS49 tries to add a field and read 49 bit signed integer in current endian
 func (d *D) TryFieldScalarS49(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(49, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS49 adds a field and reads 49 bit signed integer in current endian
@@ -11703,13 +13510,20 @@ func (d *D) FieldScalarS49(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS49 tries to add a field and read 49 bit signed integer in current endian
 func (d *D) TryFieldS49(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS49(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(49, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS49 adds a field and reads 49 bit signed integer in current endian
 func (d *D) FieldS49(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS49(name, sms...).Actual
+	s, err := d.TryFieldS49(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S49")
+	}
+	return s
 }
 
 // Reader S50
@@ -11728,14 +13542,14 @@ func (d *D) S50() int64 {
 
 // TryFieldScalarS50 tries to add a field and read 50 bit signed integer in current endian
 func (d *D) TryFieldScalarS50(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(50, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS50 adds a field and reads 50 bit signed integer in current endian
@@ -11749,13 +13563,20 @@ func (d *D) FieldScalarS50(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS50 tries to add a field and read 50 bit signed integer in current endian
 func (d *D) TryFieldS50(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS50(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(50, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS50 adds a field and reads 50 bit signed integer in current endian
 func (d *D) FieldS50(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS50(name, sms...).Actual
+	s, err := d.TryFieldS50(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S50")
+	}
+	return s
 }
 
 // Reader S51
@@ -11774,14 +13595,14 @@ func (d *D) S51() int64 {
 
 // TryFieldScalarS51 tries to add a field and read 51 bit signed integer in current endian
 func (d *D) TryFieldScalarS51(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(51, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS51 adds a field and reads 51 bit signed integer in current endian
@@ -11795,13 +13616,20 @@ func (d *D) FieldScalarS51(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS51 tries to add a field and read 51 bit signed integer in current endian
 func (d *D) TryFieldS51(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS51(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(51, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS51 adds a field and reads 51 bit signed integer in current endian
 func (d *D) FieldS51(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS51(name, sms...).Actual
+	s, err := d.TryFieldS51(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S51")
+	}
+	return s
 }
 
 // Reader S52
@@ -11820,14 +13648,14 @@ func (d *D) S52() int64 {
 
 // TryFieldScalarS52 tries to add a field and read 52 bit signed integer in current endian
 func (d *D) TryFieldScalarS52(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(52, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS52 adds a field and reads 52 bit signed integer in current endian
@@ -11841,13 +13669,20 @@ func (d *D) FieldScalarS52(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS52 tries to add a field and read 52 bit signed integer in current endian
 func (d *D) TryFieldS52(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS52(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(52, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS52 adds a field and reads 52 bit signed integer in current endian
 func (d *D) FieldS52(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS52(name, sms...).Actual
+	s, err := d.TryFieldS52(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S52")
+	}
+	return s
 }
 
 // Reader S53
@@ -11866,14 +13701,14 @@ func (d *D) S53() int64 {
 
 // TryFieldScalarS53 tries to add a field and read 53 bit signed integer in current endian
 func (d *D) TryFieldScalarS53(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(53, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS53 adds a field and reads 53 bit signed integer in current endian
@@ -11887,13 +13722,20 @@ func (d *D) FieldScalarS53(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS53 tries to add a field and read 53 bit signed integer in current endian
 func (d *D) TryFieldS53(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS53(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(53, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS53 adds a field and reads 53 bit signed integer in current endian
 func (d *D) FieldS53(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS53(name, sms...).Actual
+	s, err := d.TryFieldS53(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S53")
+	}
+	return s
 }
 
 // Reader S54
@@ -11912,14 +13754,14 @@ func (d *D) S54() int64 {
 
 // TryFieldScalarS54 tries to add a field and read 54 bit signed integer in current endian
 func (d *D) TryFieldScalarS54(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(54, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS54 adds a field and reads 54 bit signed integer in current endian
@@ -11933,13 +13775,20 @@ func (d *D) FieldScalarS54(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS54 tries to add a field and read 54 bit signed integer in current endian
 func (d *D) TryFieldS54(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS54(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(54, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS54 adds a field and reads 54 bit signed integer in current endian
 func (d *D) FieldS54(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS54(name, sms...).Actual
+	s, err := d.TryFieldS54(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S54")
+	}
+	return s
 }
 
 // Reader S55
@@ -11958,14 +13807,14 @@ func (d *D) S55() int64 {
 
 // TryFieldScalarS55 tries to add a field and read 55 bit signed integer in current endian
 func (d *D) TryFieldScalarS55(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(55, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS55 adds a field and reads 55 bit signed integer in current endian
@@ -11979,13 +13828,20 @@ func (d *D) FieldScalarS55(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS55 tries to add a field and read 55 bit signed integer in current endian
 func (d *D) TryFieldS55(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS55(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(55, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS55 adds a field and reads 55 bit signed integer in current endian
 func (d *D) FieldS55(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS55(name, sms...).Actual
+	s, err := d.TryFieldS55(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S55")
+	}
+	return s
 }
 
 // Reader S56
@@ -12004,14 +13860,14 @@ func (d *D) S56() int64 {
 
 // TryFieldScalarS56 tries to add a field and read 56 bit signed integer in current endian
 func (d *D) TryFieldScalarS56(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(56, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS56 adds a field and reads 56 bit signed integer in current endian
@@ -12025,13 +13881,20 @@ func (d *D) FieldScalarS56(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS56 tries to add a field and read 56 bit signed integer in current endian
 func (d *D) TryFieldS56(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS56(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(56, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS56 adds a field and reads 56 bit signed integer in current endian
 func (d *D) FieldS56(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS56(name, sms...).Actual
+	s, err := d.TryFieldS56(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S56")
+	}
+	return s
 }
 
 // Reader S57
@@ -12050,14 +13913,14 @@ func (d *D) S57() int64 {
 
 // TryFieldScalarS57 tries to add a field and read 57 bit signed integer in current endian
 func (d *D) TryFieldScalarS57(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(57, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS57 adds a field and reads 57 bit signed integer in current endian
@@ -12071,13 +13934,20 @@ func (d *D) FieldScalarS57(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS57 tries to add a field and read 57 bit signed integer in current endian
 func (d *D) TryFieldS57(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS57(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(57, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS57 adds a field and reads 57 bit signed integer in current endian
 func (d *D) FieldS57(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS57(name, sms...).Actual
+	s, err := d.TryFieldS57(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S57")
+	}
+	return s
 }
 
 // Reader S58
@@ -12096,14 +13966,14 @@ func (d *D) S58() int64 {
 
 // TryFieldScalarS58 tries to add a field and read 58 bit signed integer in current endian
 func (d *D) TryFieldScalarS58(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(58, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS58 adds a field and reads 58 bit signed integer in current endian
@@ -12117,13 +13987,20 @@ func (d *D) FieldScalarS58(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS58 tries to add a field and read 58 bit signed integer in current endian
 func (d *D) TryFieldS58(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS58(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(58, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS58 adds a field and reads 58 bit signed integer in current endian
 func (d *D) FieldS58(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS58(name, sms...).Actual
+	s, err := d.TryFieldS58(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S58")
+	}
+	return s
 }
 
 // Reader S59
@@ -12142,14 +14019,14 @@ func (d *D) S59() int64 {
 
 // TryFieldScalarS59 tries to add a field and read 59 bit signed integer in current endian
 func (d *D) TryFieldScalarS59(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(59, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS59 adds a field and reads 59 bit signed integer in current endian
@@ -12163,13 +14040,20 @@ func (d *D) FieldScalarS59(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS59 tries to add a field and read 59 bit signed integer in current endian
 func (d *D) TryFieldS59(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS59(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(59, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS59 adds a field and reads 59 bit signed integer in current endian
 func (d *D) FieldS59(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS59(name, sms...).Actual
+	s, err := d.TryFieldS59(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S59")
+	}
+	return s
 }
 
 // Reader S60
@@ -12188,14 +14072,14 @@ func (d *D) S60() int64 {
 
 // TryFieldScalarS60 tries to add a field and read 60 bit signed integer in current endian
 func (d *D) TryFieldScalarS60(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(60, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS60 adds a field and reads 60 bit signed integer in current endian
@@ -12209,13 +14093,20 @@ func (d *D) FieldScalarS60(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS60 tries to add a field and read 60 bit signed integer in current endian
 func (d *D) TryFieldS60(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS60(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(60, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS60 adds a field and reads 60 bit signed integer in current endian
 func (d *D) FieldS60(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS60(name, sms...).Actual
+	s, err := d.TryFieldS60(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S60")
+	}
+	return s
 }
 
 // Reader S61
@@ -12234,14 +14125,14 @@ func (d *D) S61() int64 {
 
 // TryFieldScalarS61 tries to add a field and read 61 bit signed integer in current endian
 func (d *D) TryFieldScalarS61(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(61, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS61 adds a field and reads 61 bit signed integer in current endian
@@ -12255,13 +14146,20 @@ func (d *D) FieldScalarS61(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS61 tries to add a field and read 61 bit signed integer in current endian
 func (d *D) TryFieldS61(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS61(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(61, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS61 adds a field and reads 61 bit signed integer in current endian
 func (d *D) FieldS61(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS61(name, sms...).Actual
+	s, err := d.TryFieldS61(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S61")
+	}
+	return s
 }
 
 // Reader S62
@@ -12280,14 +14178,14 @@ func (d *D) S62() int64 {
 
 // TryFieldScalarS62 tries to add a field and read 62 bit signed integer in current endian
 func (d *D) TryFieldScalarS62(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(62, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS62 adds a field and reads 62 bit signed integer in current endian
@@ -12301,13 +14199,20 @@ func (d *D) FieldScalarS62(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS62 tries to add a field and read 62 bit signed integer in current endian
 func (d *D) TryFieldS62(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS62(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(62, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS62 adds a field and reads 62 bit signed integer in current endian
 func (d *D) FieldS62(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS62(name, sms...).Actual
+	s, err := d.TryFieldS62(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S62")
+	}
+	return s
 }
 
 // Reader S63
@@ -12326,14 +14231,14 @@ func (d *D) S63() int64 {
 
 // TryFieldScalarS63 tries to add a field and read 63 bit signed integer in current endian
 func (d *D) TryFieldScalarS63(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(63, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS63 adds a field and reads 63 bit signed integer in current endian
@@ -12347,13 +14252,20 @@ func (d *D) FieldScalarS63(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS63 tries to add a field and read 63 bit signed integer in current endian
 func (d *D) TryFieldS63(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS63(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(63, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS63 adds a field and reads 63 bit signed integer in current endian
 func (d *D) FieldS63(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS63(name, sms...).Actual
+	s, err := d.TryFieldS63(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S63")
+	}
+	return s
 }
 
 // Reader S64
@@ -12372,14 +14284,14 @@ func (d *D) S64() int64 {
 
 // TryFieldScalarS64 tries to add a field and read 64 bit signed integer in current endian
 func (d *D) TryFieldScalarS64(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(64, d.Endian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS64 adds a field and reads 64 bit signed integer in current endian
@@ -12393,13 +14305,20 @@ func (d *D) FieldScalarS64(name string, sms ...scalar.SintMapper) *scalar.Sint {
 
 // TryFieldS64 tries to add a field and read 64 bit signed integer in current endian
 func (d *D) TryFieldS64(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS64(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(d.Endian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(64, d.Endian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS64 adds a field and reads 64 bit signed integer in current endian
 func (d *D) FieldS64(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS64(name, sms...).Actual
+	s, err := d.TryFieldS64(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S64")
+	}
+	return s
 }
 
 // Reader S8LE
@@ -12418,14 +14337,14 @@ func (d *D) S8LE() int64 {
 
 // TryFieldScalarS8LE tries to add a field and read 8 bit signed integer in little-endian
 func (d *D) TryFieldScalarS8LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(8, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS8LE adds a field and reads 8 bit signed integer in little-endian
@@ -12439,13 +14358,20 @@ func (d *D) FieldScalarS8LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS8LE tries to add a field and read 8 bit signed integer in little-endian
 func (d *D) TryFieldS8LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS8LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(8, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS8LE adds a field and reads 8 bit signed integer in little-endian
 func (d *D) FieldS8LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS8LE(name, sms...).Actual
+	s, err := d.TryFieldS8LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S8LE")
+	}
+	return s
 }
 
 // Reader S9LE
@@ -12464,14 +14390,14 @@ func (d *D) S9LE() int64 {
 
 // TryFieldScalarS9LE tries to add a field and read 9 bit signed integer in little-endian
 func (d *D) TryFieldScalarS9LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(9, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS9LE adds a field and reads 9 bit signed integer in little-endian
@@ -12485,13 +14411,20 @@ func (d *D) FieldScalarS9LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS9LE tries to add a field and read 9 bit signed integer in little-endian
 func (d *D) TryFieldS9LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS9LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(9, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS9LE adds a field and reads 9 bit signed integer in little-endian
 func (d *D) FieldS9LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS9LE(name, sms...).Actual
+	s, err := d.TryFieldS9LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S9LE")
+	}
+	return s
 }
 
 // Reader S10LE
@@ -12510,14 +14443,14 @@ func (d *D) S10LE() int64 {
 
 // TryFieldScalarS10LE tries to add a field and read 10 bit signed integer in little-endian
 func (d *D) TryFieldScalarS10LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(10, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS10LE adds a field and reads 10 bit signed integer in little-endian
@@ -12531,13 +14464,20 @@ func (d *D) FieldScalarS10LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS10LE tries to add a field and read 10 bit signed integer in little-endian
 func (d *D) TryFieldS10LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS10LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(10, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS10LE adds a field and reads 10 bit signed integer in little-endian
 func (d *D) FieldS10LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS10LE(name, sms...).Actual
+	s, err := d.TryFieldS10LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S10LE")
+	}
+	return s
 }
 
 // Reader S11LE
@@ -12556,14 +14496,14 @@ func (d *D) S11LE() int64 {
 
 // TryFieldScalarS11LE tries to add a field and read 11 bit signed integer in little-endian
 func (d *D) TryFieldScalarS11LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(11, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS11LE adds a field and reads 11 bit signed integer in little-endian
@@ -12577,13 +14517,20 @@ func (d *D) FieldScalarS11LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS11LE tries to add a field and read 11 bit signed integer in little-endian
 func (d *D) TryFieldS11LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS11LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(11, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS11LE adds a field and reads 11 bit signed integer in little-endian
 func (d *D) FieldS11LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS11LE(name, sms...).Actual
+	s, err := d.TryFieldS11LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S11LE")
+	}
+	return s
 }
 
 // Reader S12LE
@@ -12602,14 +14549,14 @@ func (d *D) S12LE() int64 {
 
 // TryFieldScalarS12LE tries to add a field and read 12 bit signed integer in little-endian
 func (d *D) TryFieldScalarS12LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(12, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS12LE adds a field and reads 12 bit signed integer in little-endian
@@ -12623,13 +14570,20 @@ func (d *D) FieldScalarS12LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS12LE tries to add a field and read 12 bit signed integer in little-endian
 func (d *D) TryFieldS12LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS12LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(12, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS12LE adds a field and reads 12 bit signed integer in little-endian
 func (d *D) FieldS12LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS12LE(name, sms...).Actual
+	s, err := d.TryFieldS12LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S12LE")
+	}
+	return s
 }
 
 // Reader S13LE
@@ -12648,14 +14602,14 @@ func (d *D) S13LE() int64 {
 
 // TryFieldScalarS13LE tries to add a field and read 13 bit signed integer in little-endian
 func (d *D) TryFieldScalarS13LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(13, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS13LE adds a field and reads 13 bit signed integer in little-endian
@@ -12669,13 +14623,20 @@ func (d *D) FieldScalarS13LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS13LE tries to add a field and read 13 bit signed integer in little-endian
 func (d *D) TryFieldS13LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS13LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(13, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS13LE adds a field and reads 13 bit signed integer in little-endian
 func (d *D) FieldS13LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS13LE(name, sms...).Actual
+	s, err := d.TryFieldS13LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S13LE")
+	}
+	return s
 }
 
 // Reader S14LE
@@ -12694,14 +14655,14 @@ func (d *D) S14LE() int64 {
 
 // TryFieldScalarS14LE tries to add a field and read 14 bit signed integer in little-endian
 func (d *D) TryFieldScalarS14LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(14, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS14LE adds a field and reads 14 bit signed integer in little-endian
@@ -12715,13 +14676,20 @@ func (d *D) FieldScalarS14LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS14LE tries to add a field and read 14 bit signed integer in little-endian
 func (d *D) TryFieldS14LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS14LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(14, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS14LE adds a field and reads 14 bit signed integer in little-endian
 func (d *D) FieldS14LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS14LE(name, sms...).Actual
+	s, err := d.TryFieldS14LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S14LE")
+	}
+	return s
 }
 
 // Reader S15LE
@@ -12740,14 +14708,14 @@ func (d *D) S15LE() int64 {
 
 // TryFieldScalarS15LE tries to add a field and read 15 bit signed integer in little-endian
 func (d *D) TryFieldScalarS15LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(15, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS15LE adds a field and reads 15 bit signed integer in little-endian
@@ -12761,13 +14729,20 @@ func (d *D) FieldScalarS15LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS15LE tries to add a field and read 15 bit signed integer in little-endian
 func (d *D) TryFieldS15LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS15LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(15, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS15LE adds a field and reads 15 bit signed integer in little-endian
 func (d *D) FieldS15LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS15LE(name, sms...).Actual
+	s, err := d.TryFieldS15LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S15LE")
+	}
+	return s
 }
 
 // Reader S16LE
@@ -12786,14 +14761,14 @@ func (d *D) S16LE() int64 {
 
 // TryFieldScalarS16LE tries to add a field and read 16 bit signed integer in little-endian
 func (d *D) TryFieldScalarS16LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(16, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS16LE adds a field and reads 16 bit signed integer in little-endian
@@ -12807,13 +14782,20 @@ func (d *D) FieldScalarS16LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS16LE tries to add a field and read 16 bit signed integer in little-endian
 func (d *D) TryFieldS16LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS16LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(16, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS16LE adds a field and reads 16 bit signed integer in little-endian
 func (d *D) FieldS16LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS16LE(name, sms...).Actual
+	s, err := d.TryFieldS16LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S16LE")
+	}
+	return s
 }
 
 // Reader S17LE
@@ -12832,14 +14814,14 @@ func (d *D) S17LE() int64 {
 
 // TryFieldScalarS17LE tries to add a field and read 17 bit signed integer in little-endian
 func (d *D) TryFieldScalarS17LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(17, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS17LE adds a field and reads 17 bit signed integer in little-endian
@@ -12853,13 +14835,20 @@ func (d *D) FieldScalarS17LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS17LE tries to add a field and read 17 bit signed integer in little-endian
 func (d *D) TryFieldS17LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS17LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(17, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS17LE adds a field and reads 17 bit signed integer in little-endian
 func (d *D) FieldS17LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS17LE(name, sms...).Actual
+	s, err := d.TryFieldS17LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S17LE")
+	}
+	return s
 }
 
 // Reader S18LE
@@ -12878,14 +14867,14 @@ func (d *D) S18LE() int64 {
 
 // TryFieldScalarS18LE tries to add a field and read 18 bit signed integer in little-endian
 func (d *D) TryFieldScalarS18LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(18, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS18LE adds a field and reads 18 bit signed integer in little-endian
@@ -12899,13 +14888,20 @@ func (d *D) FieldScalarS18LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS18LE tries to add a field and read 18 bit signed integer in little-endian
 func (d *D) TryFieldS18LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS18LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(18, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS18LE adds a field and reads 18 bit signed integer in little-endian
 func (d *D) FieldS18LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS18LE(name, sms...).Actual
+	s, err := d.TryFieldS18LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S18LE")
+	}
+	return s
 }
 
 // Reader S19LE
@@ -12924,14 +14920,14 @@ func (d *D) S19LE() int64 {
 
 // TryFieldScalarS19LE tries to add a field and read 19 bit signed integer in little-endian
 func (d *D) TryFieldScalarS19LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(19, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS19LE adds a field and reads 19 bit signed integer in little-endian
@@ -12945,13 +14941,20 @@ func (d *D) FieldScalarS19LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS19LE tries to add a field and read 19 bit signed integer in little-endian
 func (d *D) TryFieldS19LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS19LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(19, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS19LE adds a field and reads 19 bit signed integer in little-endian
 func (d *D) FieldS19LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS19LE(name, sms...).Actual
+	s, err := d.TryFieldS19LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S19LE")
+	}
+	return s
 }
 
 // Reader S20LE
@@ -12970,14 +14973,14 @@ func (d *D) S20LE() int64 {
 
 // TryFieldScalarS20LE tries to add a field and read 20 bit signed integer in little-endian
 func (d *D) TryFieldScalarS20LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(20, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS20LE adds a field and reads 20 bit signed integer in little-endian
@@ -12991,13 +14994,20 @@ func (d *D) FieldScalarS20LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS20LE tries to add a field and read 20 bit signed integer in little-endian
 func (d *D) TryFieldS20LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS20LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(20, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS20LE adds a field and reads 20 bit signed integer in little-endian
 func (d *D) FieldS20LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS20LE(name, sms...).Actual
+	s, err := d.TryFieldS20LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S20LE")
+	}
+	return s
 }
 
 // Reader S21LE
@@ -13016,14 +15026,14 @@ func (d *D) S21LE() int64 {
 
 // TryFieldScalarS21LE tries to add a field and read 21 bit signed integer in little-endian
 func (d *D) TryFieldScalarS21LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(21, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS21LE adds a field and reads 21 bit signed integer in little-endian
@@ -13037,13 +15047,20 @@ func (d *D) FieldScalarS21LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS21LE tries to add a field and read 21 bit signed integer in little-endian
 func (d *D) TryFieldS21LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS21LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(21, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS21LE adds a field and reads 21 bit signed integer in little-endian
 func (d *D) FieldS21LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS21LE(name, sms...).Actual
+	s, err := d.TryFieldS21LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S21LE")
+	}
+	return s
 }
 
 // Reader S22LE
@@ -13062,14 +15079,14 @@ func (d *D) S22LE() int64 {
 
 // TryFieldScalarS22LE tries to add a field and read 22 bit signed integer in little-endian
 func (d *D) TryFieldScalarS22LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(22, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS22LE adds a field and reads 22 bit signed integer in little-endian
@@ -13083,13 +15100,20 @@ func (d *D) FieldScalarS22LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS22LE tries to add a field and read 22 bit signed integer in little-endian
 func (d *D) TryFieldS22LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS22LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(22, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS22LE adds a field and reads 22 bit signed integer in little-endian
 func (d *D) FieldS22LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS22LE(name, sms...).Actual
+	s, err := d.TryFieldS22LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S22LE")
+	}
+	return s
 }
 
 // Reader S23LE
@@ -13108,14 +15132,14 @@ func (d *D) S23LE() int64 {
 
 // TryFieldScalarS23LE tries to add a field and read 23 bit signed integer in little-endian
 func (d *D) TryFieldScalarS23LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(23, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS23LE adds a field and reads 23 bit signed integer in little-endian
@@ -13129,13 +15153,20 @@ func (d *D) FieldScalarS23LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS23LE tries to add a field and read 23 bit signed integer in little-endian
 func (d *D) TryFieldS23LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS23LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(23, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS23LE adds a field and reads 23 bit signed integer in little-endian
 func (d *D) FieldS23LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS23LE(name, sms...).Actual
+	s, err := d.TryFieldS23LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S23LE")
+	}
+	return s
 }
 
 // Reader S24LE
@@ -13154,14 +15185,14 @@ func (d *D) S24LE() int64 {
 
 // TryFieldScalarS24LE tries to add a field and read 24 bit signed integer in little-endian
 func (d *D) TryFieldScalarS24LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(24, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS24LE adds a field and reads 24 bit signed integer in little-endian
@@ -13175,13 +15206,20 @@ func (d *D) FieldScalarS24LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS24LE tries to add a field and read 24 bit signed integer in little-endian
 func (d *D) TryFieldS24LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS24LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(24, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS24LE adds a field and reads 24 bit signed integer in little-endian
 func (d *D) FieldS24LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS24LE(name, sms...).Actual
+	s, err := d.TryFieldS24LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S24LE")
+	}
+	return s
 }
 
 // Reader S25LE
@@ -13200,14 +15238,14 @@ func (d *D) S25LE() int64 {
 
 // TryFieldScalarS25LE tries to add a field and read 25 bit signed integer in little-endian
 func (d *D) TryFieldScalarS25LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(25, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS25LE adds a field and reads 25 bit signed integer in little-endian
@@ -13221,13 +15259,20 @@ func (d *D) FieldScalarS25LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS25LE tries to add a field and read 25 bit signed integer in little-endian
 func (d *D) TryFieldS25LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS25LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(25, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS25LE adds a field and reads 25 bit signed integer in little-endian
 func (d *D) FieldS25LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS25LE(name, sms...).Actual
+	s, err := d.TryFieldS25LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S25LE")
+	}
+	return s
 }
 
 // Reader S26LE
@@ -13246,14 +15291,14 @@ func (d *D) S26LE() int64 {
 
 // TryFieldScalarS26LE tries to add a field and read 26 bit signed integer in little-endian
 func (d *D) TryFieldScalarS26LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(26, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS26LE adds a field and reads 26 bit signed integer in little-endian
@@ -13267,13 +15312,20 @@ func (d *D) FieldScalarS26LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS26LE tries to add a field and read 26 bit signed integer in little-endian
 func (d *D) TryFieldS26LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS26LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(26, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS26LE adds a field and reads 26 bit signed integer in little-endian
 func (d *D) FieldS26LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS26LE(name, sms...).Actual
+	s, err := d.TryFieldS26LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S26LE")
+	}
+	return s
 }
 
 // Reader S27LE
@@ -13292,14 +15344,14 @@ func (d *D) S27LE() int64 {
 
 // TryFieldScalarS27LE tries to add a field and read 27 bit signed integer in little-endian
 func (d *D) TryFieldScalarS27LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(27, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS27LE adds a field and reads 27 bit signed integer in little-endian
@@ -13313,13 +15365,20 @@ func (d *D) FieldScalarS27LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS27LE tries to add a field and read 27 bit signed integer in little-endian
 func (d *D) TryFieldS27LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS27LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(27, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS27LE adds a field and reads 27 bit signed integer in little-endian
 func (d *D) FieldS27LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS27LE(name, sms...).Actual
+	s, err := d.TryFieldS27LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S27LE")
+	}
+	return s
 }
 
 // Reader S28LE
@@ -13338,14 +15397,14 @@ func (d *D) S28LE() int64 {
 
 // TryFieldScalarS28LE tries to add a field and read 28 bit signed integer in little-endian
 func (d *D) TryFieldScalarS28LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(28, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS28LE adds a field and reads 28 bit signed integer in little-endian
@@ -13359,13 +15418,20 @@ func (d *D) FieldScalarS28LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS28LE tries to add a field and read 28 bit signed integer in little-endian
 func (d *D) TryFieldS28LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS28LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(28, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS28LE adds a field and reads 28 bit signed integer in little-endian
 func (d *D) FieldS28LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS28LE(name, sms...).Actual
+	s, err := d.TryFieldS28LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S28LE")
+	}
+	return s
 }
 
 // Reader S29LE
@@ -13384,14 +15450,14 @@ func (d *D) S29LE() int64 {
 
 // TryFieldScalarS29LE tries to add a field and read 29 bit signed integer in little-endian
 func (d *D) TryFieldScalarS29LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(29, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS29LE adds a field and reads 29 bit signed integer in little-endian
@@ -13405,13 +15471,20 @@ func (d *D) FieldScalarS29LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS29LE tries to add a field and read 29 bit signed integer in little-endian
 func (d *D) TryFieldS29LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS29LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(29, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS29LE adds a field and reads 29 bit signed integer in little-endian
 func (d *D) FieldS29LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS29LE(name, sms...).Actual
+	s, err := d.TryFieldS29LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S29LE")
+	}
+	return s
 }
 
 // Reader S30LE
@@ -13430,14 +15503,14 @@ func (d *D) S30LE() int64 {
 
 // TryFieldScalarS30LE tries to add a field and read 30 bit signed integer in little-endian
 func (d *D) TryFieldScalarS30LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(30, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS30LE adds a field and reads 30 bit signed integer in little-endian
@@ -13451,13 +15524,20 @@ func (d *D) FieldScalarS30LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS30LE tries to add a field and read 30 bit signed integer in little-endian
 func (d *D) TryFieldS30LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS30LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(30, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS30LE adds a field and reads 30 bit signed integer in little-endian
 func (d *D) FieldS30LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS30LE(name, sms...).Actual
+	s, err := d.TryFieldS30LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S30LE")
+	}
+	return s
 }
 
 // Reader S31LE
@@ -13476,14 +15556,14 @@ func (d *D) S31LE() int64 {
 
 // TryFieldScalarS31LE tries to add a field and read 31 bit signed integer in little-endian
 func (d *D) TryFieldScalarS31LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(31, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS31LE adds a field and reads 31 bit signed integer in little-endian
@@ -13497,13 +15577,20 @@ func (d *D) FieldScalarS31LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS31LE tries to add a field and read 31 bit signed integer in little-endian
 func (d *D) TryFieldS31LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS31LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(31, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS31LE adds a field and reads 31 bit signed integer in little-endian
 func (d *D) FieldS31LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS31LE(name, sms...).Actual
+	s, err := d.TryFieldS31LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S31LE")
+	}
+	return s
 }
 
 // Reader S32LE
@@ -13522,14 +15609,14 @@ func (d *D) S32LE() int64 {
 
 // TryFieldScalarS32LE tries to add a field and read 32 bit signed integer in little-endian
 func (d *D) TryFieldScalarS32LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(32, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS32LE adds a field and reads 32 bit signed integer in little-endian
@@ -13543,13 +15630,20 @@ func (d *D) FieldScalarS32LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS32LE tries to add a field and read 32 bit signed integer in little-endian
 func (d *D) TryFieldS32LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS32LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(32, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS32LE adds a field and reads 32 bit signed integer in little-endian
 func (d *D) FieldS32LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS32LE(name, sms...).Actual
+	s, err := d.TryFieldS32LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S32LE")
+	}
+	return s
 }
 
 // Reader S33LE
@@ -13568,14 +15662,14 @@ func (d *D) S33LE() int64 {
 
 // TryFieldScalarS33LE tries to add a field and read 33 bit signed integer in little-endian
 func (d *D) TryFieldScalarS33LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(33, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS33LE adds a field and reads 33 bit signed integer in little-endian
@@ -13589,13 +15683,20 @@ func (d *D) FieldScalarS33LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS33LE tries to add a field and read 33 bit signed integer in little-endian
 func (d *D) TryFieldS33LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS33LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(33, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS33LE adds a field and reads 33 bit signed integer in little-endian
 func (d *D) FieldS33LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS33LE(name, sms...).Actual
+	s, err := d.TryFieldS33LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S33LE")
+	}
+	return s
 }
 
 // Reader S34LE
@@ -13614,14 +15715,14 @@ func (d *D) S34LE() int64 {
 
 // TryFieldScalarS34LE tries to add a field and read 34 bit signed integer in little-endian
 func (d *D) TryFieldScalarS34LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(34, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS34LE adds a field and reads 34 bit signed integer in little-endian
@@ -13635,13 +15736,20 @@ func (d *D) FieldScalarS34LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS34LE tries to add a field and read 34 bit signed integer in little-endian
 func (d *D) TryFieldS34LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS34LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(34, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS34LE adds a field and reads 34 bit signed integer in little-endian
 func (d *D) FieldS34LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS34LE(name, sms...).Actual
+	s, err := d.TryFieldS34LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S34LE")
+	}
+	return s
 }
 
 // Reader S35LE
@@ -13660,14 +15768,14 @@ func (d *D) S35LE() int64 {
 
 // TryFieldScalarS35LE tries to add a field and read 35 bit signed integer in little-endian
 func (d *D) TryFieldScalarS35LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(35, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS35LE adds a field and reads 35 bit signed integer in little-endian
@@ -13681,13 +15789,20 @@ func (d *D) FieldScalarS35LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS35LE tries to add a field and read 35 bit signed integer in little-endian
 func (d *D) TryFieldS35LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS35LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(35, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS35LE adds a field and reads 35 bit signed integer in little-endian
 func (d *D) FieldS35LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS35LE(name, sms...).Actual
+	s, err := d.TryFieldS35LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S35LE")
+	}
+	return s
 }
 
 // Reader S36LE
@@ -13706,14 +15821,14 @@ func (d *D) S36LE() int64 {
 
 // TryFieldScalarS36LE tries to add a field and read 36 bit signed integer in little-endian
 func (d *D) TryFieldScalarS36LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(36, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS36LE adds a field and reads 36 bit signed integer in little-endian
@@ -13727,13 +15842,20 @@ func (d *D) FieldScalarS36LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS36LE tries to add a field and read 36 bit signed integer in little-endian
 func (d *D) TryFieldS36LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS36LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(36, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS36LE adds a field and reads 36 bit signed integer in little-endian
 func (d *D) FieldS36LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS36LE(name, sms...).Actual
+	s, err := d.TryFieldS36LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S36LE")
+	}
+	return s
 }
 
 // Reader S37LE
@@ -13752,14 +15874,14 @@ func (d *D) S37LE() int64 {
 
 // TryFieldScalarS37LE tries to add a field and read 37 bit signed integer in little-endian
 func (d *D) TryFieldScalarS37LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(37, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS37LE adds a field and reads 37 bit signed integer in little-endian
@@ -13773,13 +15895,20 @@ func (d *D) FieldScalarS37LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS37LE tries to add a field and read 37 bit signed integer in little-endian
 func (d *D) TryFieldS37LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS37LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(37, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS37LE adds a field and reads 37 bit signed integer in little-endian
 func (d *D) FieldS37LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS37LE(name, sms...).Actual
+	s, err := d.TryFieldS37LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S37LE")
+	}
+	return s
 }
 
 // Reader S38LE
@@ -13798,14 +15927,14 @@ func (d *D) S38LE() int64 {
 
 // TryFieldScalarS38LE tries to add a field and read 38 bit signed integer in little-endian
 func (d *D) TryFieldScalarS38LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(38, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS38LE adds a field and reads 38 bit signed integer in little-endian
@@ -13819,13 +15948,20 @@ func (d *D) FieldScalarS38LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS38LE tries to add a field and read 38 bit signed integer in little-endian
 func (d *D) TryFieldS38LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS38LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(38, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS38LE adds a field and reads 38 bit signed integer in little-endian
 func (d *D) FieldS38LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS38LE(name, sms...).Actual
+	s, err := d.TryFieldS38LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S38LE")
+	}
+	return s
 }
 
 // Reader S39LE
@@ -13844,14 +15980,14 @@ func (d *D) S39LE() int64 {
 
 // TryFieldScalarS39LE tries to add a field and read 39 bit signed integer in little-endian
 func (d *D) TryFieldScalarS39LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(39, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS39LE adds a field and reads 39 bit signed integer in little-endian
@@ -13865,13 +16001,20 @@ func (d *D) FieldScalarS39LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS39LE tries to add a field and read 39 bit signed integer in little-endian
 func (d *D) TryFieldS39LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS39LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(39, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS39LE adds a field and reads 39 bit signed integer in little-endian
 func (d *D) FieldS39LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS39LE(name, sms...).Actual
+	s, err := d.TryFieldS39LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S39LE")
+	}
+	return s
 }
 
 // Reader S40LE
@@ -13890,14 +16033,14 @@ func (d *D) S40LE() int64 {
 
 // TryFieldScalarS40LE tries to add a field and read 40 bit signed integer in little-endian
 func (d *D) TryFieldScalarS40LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(40, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS40LE adds a field and reads 40 bit signed integer in little-endian
@@ -13911,13 +16054,20 @@ func (d *D) FieldScalarS40LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS40LE tries to add a field and read 40 bit signed integer in little-endian
 func (d *D) TryFieldS40LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS40LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(40, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS40LE adds a field and reads 40 bit signed integer in little-endian
 func (d *D) FieldS40LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS40LE(name, sms...).Actual
+	s, err := d.TryFieldS40LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S40LE")
+	}
+	return s
 }
 
 // Reader S41LE
@@ -13936,14 +16086,14 @@ func (d *D) S41LE() int64 {
 
 // TryFieldScalarS41LE tries to add a field and read 41 bit signed integer in little-endian
 func (d *D) TryFieldScalarS41LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(41, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS41LE adds a field and reads 41 bit signed integer in little-endian
@@ -13957,13 +16107,20 @@ func (d *D) FieldScalarS41LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS41LE tries to add a field and read 41 bit signed integer in little-endian
 func (d *D) TryFieldS41LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS41LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(41, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS41LE adds a field and reads 41 bit signed integer in little-endian
 func (d *D) FieldS41LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS41LE(name, sms...).Actual
+	s, err := d.TryFieldS41LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S41LE")
+	}
+	return s
 }
 
 // Reader S42LE
@@ -13982,14 +16139,14 @@ func (d *D) S42LE() int64 {
 
 // TryFieldScalarS42LE tries to add a field and read 42 bit signed integer in little-endian
 func (d *D) TryFieldScalarS42LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(42, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS42LE adds a field and reads 42 bit signed integer in little-endian
@@ -14003,13 +16160,20 @@ func (d *D) FieldScalarS42LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS42LE tries to add a field and read 42 bit signed integer in little-endian
 func (d *D) TryFieldS42LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS42LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(42, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS42LE adds a field and reads 42 bit signed integer in little-endian
 func (d *D) FieldS42LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS42LE(name, sms...).Actual
+	s, err := d.TryFieldS42LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S42LE")
+	}
+	return s
 }
 
 // Reader S43LE
@@ -14028,14 +16192,14 @@ func (d *D) S43LE() int64 {
 
 // TryFieldScalarS43LE tries to add a field and read 43 bit signed integer in little-endian
 func (d *D) TryFieldScalarS43LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(43, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS43LE adds a field and reads 43 bit signed integer in little-endian
@@ -14049,13 +16213,20 @@ func (d *D) FieldScalarS43LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS43LE tries to add a field and read 43 bit signed integer in little-endian
 func (d *D) TryFieldS43LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS43LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(43, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS43LE adds a field and reads 43 bit signed integer in little-endian
 func (d *D) FieldS43LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS43LE(name, sms...).Actual
+	s, err := d.TryFieldS43LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S43LE")
+	}
+	return s
 }
 
 // Reader S44LE
@@ -14074,14 +16245,14 @@ func (d *D) S44LE() int64 {
 
 // TryFieldScalarS44LE tries to add a field and read 44 bit signed integer in little-endian
 func (d *D) TryFieldScalarS44LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(44, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS44LE adds a field and reads 44 bit signed integer in little-endian
@@ -14095,13 +16266,20 @@ func (d *D) FieldScalarS44LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS44LE tries to add a field and read 44 bit signed integer in little-endian
 func (d *D) TryFieldS44LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS44LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(44, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS44LE adds a field and reads 44 bit signed integer in little-endian
 func (d *D) FieldS44LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS44LE(name, sms...).Actual
+	s, err := d.TryFieldS44LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S44LE")
+	}
+	return s
 }
 
 // Reader S45LE
@@ -14120,14 +16298,14 @@ func (d *D) S45LE() int64 {
 
 // TryFieldScalarS45LE tries to add a field and read 45 bit signed integer in little-endian
 func (d *D) TryFieldScalarS45LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(45, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS45LE adds a field and reads 45 bit signed integer in little-endian
@@ -14141,13 +16319,20 @@ func (d *D) FieldScalarS45LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS45LE tries to add a field and read 45 bit signed integer in little-endian
 func (d *D) TryFieldS45LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS45LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(45, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS45LE adds a field and reads 45 bit signed integer in little-endian
 func (d *D) FieldS45LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS45LE(name, sms...).Actual
+	s, err := d.TryFieldS45LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S45LE")
+	}
+	return s
 }
 
 // Reader S46LE
@@ -14166,14 +16351,14 @@ func (d *D) S46LE() int64 {
 
 // TryFieldScalarS46LE tries to add a field and read 46 bit signed integer in little-endian
 func (d *D) TryFieldScalarS46LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(46, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS46LE adds a field and reads 46 bit signed integer in little-endian
@@ -14187,13 +16372,20 @@ func (d *D) FieldScalarS46LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS46LE tries to add a field and read 46 bit signed integer in little-endian
 func (d *D) TryFieldS46LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS46LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(46, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS46LE adds a field and reads 46 bit signed integer in little-endian
 func (d *D) FieldS46LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS46LE(name, sms...).Actual
+	s, err := d.TryFieldS46LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S46LE")
+	}
+	return s
 }
 
 // Reader S47LE
@@ -14212,14 +16404,14 @@ func (d *D) S47LE() int64 {
 
 // TryFieldScalarS47LE tries to add a field and read 47 bit signed integer in little-endian
 func (d *D) TryFieldScalarS47LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(47, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS47LE adds a field and reads 47 bit signed integer in little-endian
@@ -14233,13 +16425,20 @@ func (d *D) FieldScalarS47LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS47LE tries to add a field and read 47 bit signed integer in little-endian
 func (d *D) TryFieldS47LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS47LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(47, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS47LE adds a field and reads 47 bit signed integer in little-endian
 func (d *D) FieldS47LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS47LE(name, sms...).Actual
+	s, err := d.TryFieldS47LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S47LE")
+	}
+	return s
 }
 
 // Reader S48LE
@@ -14258,14 +16457,14 @@ func (d *D) S48LE() int64 {
 
 // TryFieldScalarS48LE tries to add a field and read 48 bit signed integer in little-endian
 func (d *D) TryFieldScalarS48LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(48, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS48LE adds a field and reads 48 bit signed integer in little-endian
@@ -14279,13 +16478,20 @@ func (d *D) FieldScalarS48LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS48LE tries to add a field and read 48 bit signed integer in little-endian
 func (d *D) TryFieldS48LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS48LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(48, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS48LE adds a field and reads 48 bit signed integer in little-endian
 func (d *D) FieldS48LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS48LE(name, sms...).Actual
+	s, err := d.TryFieldS48LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S48LE")
+	}
+	return s
 }
 
 // Reader S49LE
@@ -14304,14 +16510,14 @@ func (d *D) S49LE() int64 {
 
 // TryFieldScalarS49LE tries to add a field and read 49 bit signed integer in little-endian
 func (d *D) TryFieldScalarS49LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(49, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS49LE adds a field and reads 49 bit signed integer in little-endian
@@ -14325,13 +16531,20 @@ func (d *D) FieldScalarS49LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS49LE tries to add a field and read 49 bit signed integer in little-endian
 func (d *D) TryFieldS49LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS49LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(49, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS49LE adds a field and reads 49 bit signed integer in little-endian
 func (d *D) FieldS49LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS49LE(name, sms...).Actual
+	s, err := d.TryFieldS49LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S49LE")
+	}
+	return s
 }
 
 // Reader S50LE
@@ -14350,14 +16563,14 @@ func (d *D) S50LE() int64 {
 
 // TryFieldScalarS50LE tries to add a field and read 50 bit signed integer in little-endian
 func (d *D) TryFieldScalarS50LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(50, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS50LE adds a field and reads 50 bit signed integer in little-endian
@@ -14371,13 +16584,20 @@ func (d *D) FieldScalarS50LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS50LE tries to add a field and read 50 bit signed integer in little-endian
 func (d *D) TryFieldS50LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS50LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(50, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS50LE adds a field and reads 50 bit signed integer in little-endian
 func (d *D) FieldS50LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS50LE(name, sms...).Actual
+	s, err := d.TryFieldS50LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S50LE")
+	}
+	return s
 }
 
 // Reader S51LE
@@ -14396,14 +16616,14 @@ func (d *D) S51LE() int64 {
 
 // TryFieldScalarS51LE tries to add a field and read 51 bit signed integer in little-endian
 func (d *D) TryFieldScalarS51LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(51, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS51LE adds a field and reads 51 bit signed integer in little-endian
@@ -14417,13 +16637,20 @@ func (d *D) FieldScalarS51LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS51LE tries to add a field and read 51 bit signed integer in little-endian
 func (d *D) TryFieldS51LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS51LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(51, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS51LE adds a field and reads 51 bit signed integer in little-endian
 func (d *D) FieldS51LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS51LE(name, sms...).Actual
+	s, err := d.TryFieldS51LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S51LE")
+	}
+	return s
 }
 
 // Reader S52LE
@@ -14442,14 +16669,14 @@ func (d *D) S52LE() int64 {
 
 // TryFieldScalarS52LE tries to add a field and read 52 bit signed integer in little-endian
 func (d *D) TryFieldScalarS52LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(52, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS52LE adds a field and reads 52 bit signed integer in little-endian
@@ -14463,13 +16690,20 @@ func (d *D) FieldScalarS52LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS52LE tries to add a field and read 52 bit signed integer in little-endian
 func (d *D) TryFieldS52LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS52LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(52, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS52LE adds a field and reads 52 bit signed integer in little-endian
 func (d *D) FieldS52LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS52LE(name, sms...).Actual
+	s, err := d.TryFieldS52LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S52LE")
+	}
+	return s
 }
 
 // Reader S53LE
@@ -14488,14 +16722,14 @@ func (d *D) S53LE() int64 {
 
 // TryFieldScalarS53LE tries to add a field and read 53 bit signed integer in little-endian
 func (d *D) TryFieldScalarS53LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(53, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS53LE adds a field and reads 53 bit signed integer in little-endian
@@ -14509,13 +16743,20 @@ func (d *D) FieldScalarS53LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS53LE tries to add a field and read 53 bit signed integer in little-endian
 func (d *D) TryFieldS53LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS53LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(53, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS53LE adds a field and reads 53 bit signed integer in little-endian
 func (d *D) FieldS53LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS53LE(name, sms...).Actual
+	s, err := d.TryFieldS53LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S53LE")
+	}
+	return s
 }
 
 // Reader S54LE
@@ -14534,14 +16775,14 @@ func (d *D) S54LE() int64 {
 
 // TryFieldScalarS54LE tries to add a field and read 54 bit signed integer in little-endian
 func (d *D) TryFieldScalarS54LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(54, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS54LE adds a field and reads 54 bit signed integer in little-endian
@@ -14555,13 +16796,20 @@ func (d *D) FieldScalarS54LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS54LE tries to add a field and read 54 bit signed integer in little-endian
 func (d *D) TryFieldS54LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS54LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(54, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS54LE adds a field and reads 54 bit signed integer in little-endian
 func (d *D) FieldS54LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS54LE(name, sms...).Actual
+	s, err := d.TryFieldS54LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S54LE")
+	}
+	return s
 }
 
 // Reader S55LE
@@ -14580,14 +16828,14 @@ func (d *D) S55LE() int64 {
 
 // TryFieldScalarS55LE tries to add a field and read 55 bit signed integer in little-endian
 func (d *D) TryFieldScalarS55LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(55, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS55LE adds a field and reads 55 bit signed integer in little-endian
@@ -14601,13 +16849,20 @@ func (d *D) FieldScalarS55LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS55LE tries to add a field and read 55 bit signed integer in little-endian
 func (d *D) TryFieldS55LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS55LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(55, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS55LE adds a field and reads 55 bit signed integer in little-endian
 func (d *D) FieldS55LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS55LE(name, sms...).Actual
+	s, err := d.TryFieldS55LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S55LE")
+	}
+	return s
 }
 
 // Reader S56LE
@@ -14626,14 +16881,14 @@ func (d *D) S56LE() int64 {
 
 // TryFieldScalarS56LE tries to add a field and read 56 bit signed integer in little-endian
 func (d *D) TryFieldScalarS56LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(56, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS56LE adds a field and reads 56 bit signed integer in little-endian
@@ -14647,13 +16902,20 @@ func (d *D) FieldScalarS56LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS56LE tries to add a field and read 56 bit signed integer in little-endian
 func (d *D) TryFieldS56LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS56LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(56, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS56LE adds a field and reads 56 bit signed integer in little-endian
 func (d *D) FieldS56LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS56LE(name, sms...).Actual
+	s, err := d.TryFieldS56LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S56LE")
+	}
+	return s
 }
 
 // Reader S57LE
@@ -14672,14 +16934,14 @@ func (d *D) S57LE() int64 {
 
 // TryFieldScalarS57LE tries to add a field and read 57 bit signed integer in little-endian
 func (d *D) TryFieldScalarS57LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(57, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS57LE adds a field and reads 57 bit signed integer in little-endian
@@ -14693,13 +16955,20 @@ func (d *D) FieldScalarS57LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS57LE tries to add a field and read 57 bit signed integer in little-endian
 func (d *D) TryFieldS57LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS57LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(57, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS57LE adds a field and reads 57 bit signed integer in little-endian
 func (d *D) FieldS57LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS57LE(name, sms...).Actual
+	s, err := d.TryFieldS57LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S57LE")
+	}
+	return s
 }
 
 // Reader S58LE
@@ -14718,14 +16987,14 @@ func (d *D) S58LE() int64 {
 
 // TryFieldScalarS58LE tries to add a field and read 58 bit signed integer in little-endian
 func (d *D) TryFieldScalarS58LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(58, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS58LE adds a field and reads 58 bit signed integer in little-endian
@@ -14739,13 +17008,20 @@ func (d *D) FieldScalarS58LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS58LE tries to add a field and read 58 bit signed integer in little-endian
 func (d *D) TryFieldS58LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS58LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(58, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS58LE adds a field and reads 58 bit signed integer in little-endian
 func (d *D) FieldS58LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS58LE(name, sms...).Actual
+	s, err := d.TryFieldS58LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S58LE")
+	}
+	return s
 }
 
 // Reader S59LE
@@ -14764,14 +17040,14 @@ func (d *D) S59LE() int64 {
 
 // TryFieldScalarS59LE tries to add a field and read 59 bit signed integer in little-endian
 func (d *D) TryFieldScalarS59LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(59, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS59LE adds a field and reads 59 bit signed integer in little-endian
@@ -14785,13 +17061,20 @@ func (d *D) FieldScalarS59LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS59LE tries to add a field and read 59 bit signed integer in little-endian
 func (d *D) TryFieldS59LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS59LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(59, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS59LE adds a field and reads 59 bit signed integer in little-endian
 func (d *D) FieldS59LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS59LE(name, sms...).Actual
+	s, err := d.TryFieldS59LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S59LE")
+	}
+	return s
 }
 
 // Reader S60LE
@@ -14810,14 +17093,14 @@ func (d *D) S60LE() int64 {
 
 // TryFieldScalarS60LE tries to add a field and read 60 bit signed integer in little-endian
 func (d *D) TryFieldScalarS60LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(60, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS60LE adds a field and reads 60 bit signed integer in little-endian
@@ -14831,13 +17114,20 @@ func (d *D) FieldScalarS60LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS60LE tries to add a field and read 60 bit signed integer in little-endian
 func (d *D) TryFieldS60LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS60LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(60, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS60LE adds a field and reads 60 bit signed integer in little-endian
 func (d *D) FieldS60LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS60LE(name, sms...).Actual
+	s, err := d.TryFieldS60LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S60LE")
+	}
+	return s
 }
 
 // Reader S61LE
@@ -14856,14 +17146,14 @@ func (d *D) S61LE() int64 {
 
 // TryFieldScalarS61LE tries to add a field and read 61 bit signed integer in little-endian
 func (d *D) TryFieldScalarS61LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(61, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS61LE adds a field and reads 61 bit signed integer in little-endian
@@ -14877,13 +17167,20 @@ func (d *D) FieldScalarS61LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS61LE tries to add a field and read 61 bit signed integer in little-endian
 func (d *D) TryFieldS61LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS61LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(61, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS61LE adds a field and reads 61 bit signed integer in little-endian
 func (d *D) FieldS61LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS61LE(name, sms...).Actual
+	s, err := d.TryFieldS61LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S61LE")
+	}
+	return s
 }
 
 // Reader S62LE
@@ -14902,14 +17199,14 @@ func (d *D) S62LE() int64 {
 
 // TryFieldScalarS62LE tries to add a field and read 62 bit signed integer in little-endian
 func (d *D) TryFieldScalarS62LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(62, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS62LE adds a field and reads 62 bit signed integer in little-endian
@@ -14923,13 +17220,20 @@ func (d *D) FieldScalarS62LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS62LE tries to add a field and read 62 bit signed integer in little-endian
 func (d *D) TryFieldS62LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS62LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(62, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS62LE adds a field and reads 62 bit signed integer in little-endian
 func (d *D) FieldS62LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS62LE(name, sms...).Actual
+	s, err := d.TryFieldS62LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S62LE")
+	}
+	return s
 }
 
 // Reader S63LE
@@ -14948,14 +17252,14 @@ func (d *D) S63LE() int64 {
 
 // TryFieldScalarS63LE tries to add a field and read 63 bit signed integer in little-endian
 func (d *D) TryFieldScalarS63LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(63, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS63LE adds a field and reads 63 bit signed integer in little-endian
@@ -14969,13 +17273,20 @@ func (d *D) FieldScalarS63LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS63LE tries to add a field and read 63 bit signed integer in little-endian
 func (d *D) TryFieldS63LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS63LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(63, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS63LE adds a field and reads 63 bit signed integer in little-endian
 func (d *D) FieldS63LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS63LE(name, sms...).Actual
+	s, err := d.TryFieldS63LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S63LE")
+	}
+	return s
 }
 
 // Reader S64LE
@@ -14994,14 +17305,14 @@ func (d *D) S64LE() int64 {
 
 // TryFieldScalarS64LE tries to add a field and read 64 bit signed integer in little-endian
 func (d *D) TryFieldScalarS64LE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(64, LittleEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS64LE adds a field and reads 64 bit signed integer in little-endian
@@ -15015,13 +17326,20 @@ func (d *D) FieldScalarS64LE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS64LE tries to add a field and read 64 bit signed integer in little-endian
 func (d *D) TryFieldS64LE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS64LE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(LittleEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(64, LittleEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS64LE adds a field and reads 64 bit signed integer in little-endian
 func (d *D) FieldS64LE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS64LE(name, sms...).Actual
+	s, err := d.TryFieldS64LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S64LE")
+	}
+	return s
 }
 
 // Reader S8BE
@@ -15040,14 +17358,14 @@ func (d *D) S8BE() int64 {
 
 // TryFieldScalarS8BE tries to add a field and read 8 bit signed integer in big-endian
 func (d *D) TryFieldScalarS8BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(8, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS8BE adds a field and reads 8 bit signed integer in big-endian
@@ -15061,13 +17379,20 @@ func (d *D) FieldScalarS8BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS8BE tries to add a field and read 8 bit signed integer in big-endian
 func (d *D) TryFieldS8BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS8BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(8, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS8BE adds a field and reads 8 bit signed integer in big-endian
 func (d *D) FieldS8BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS8BE(name, sms...).Actual
+	s, err := d.TryFieldS8BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S8BE")
+	}
+	return s
 }
 
 // Reader S9BE
@@ -15086,14 +17411,14 @@ func (d *D) S9BE() int64 {
 
 // TryFieldScalarS9BE tries to add a field and read 9 bit signed integer in big-endian
 func (d *D) TryFieldScalarS9BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(9, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS9BE adds a field and reads 9 bit signed integer in big-endian
@@ -15107,13 +17432,20 @@ func (d *D) FieldScalarS9BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS9BE tries to add a field and read 9 bit signed integer in big-endian
 func (d *D) TryFieldS9BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS9BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(9, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS9BE adds a field and reads 9 bit signed integer in big-endian
 func (d *D) FieldS9BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS9BE(name, sms...).Actual
+	s, err := d.TryFieldS9BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S9BE")
+	}
+	return s
 }
 
 // Reader S10BE
@@ -15132,14 +17464,14 @@ func (d *D) S10BE() int64 {
 
 // TryFieldScalarS10BE tries to add a field and read 10 bit signed integer in big-endian
 func (d *D) TryFieldScalarS10BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(10, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS10BE adds a field and reads 10 bit signed integer in big-endian
@@ -15153,13 +17485,20 @@ func (d *D) FieldScalarS10BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS10BE tries to add a field and read 10 bit signed integer in big-endian
 func (d *D) TryFieldS10BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS10BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(10, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS10BE adds a field and reads 10 bit signed integer in big-endian
 func (d *D) FieldS10BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS10BE(name, sms...).Actual
+	s, err := d.TryFieldS10BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S10BE")
+	}
+	return s
 }
 
 // Reader S11BE
@@ -15178,14 +17517,14 @@ func (d *D) S11BE() int64 {
 
 // TryFieldScalarS11BE tries to add a field and read 11 bit signed integer in big-endian
 func (d *D) TryFieldScalarS11BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(11, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS11BE adds a field and reads 11 bit signed integer in big-endian
@@ -15199,13 +17538,20 @@ func (d *D) FieldScalarS11BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS11BE tries to add a field and read 11 bit signed integer in big-endian
 func (d *D) TryFieldS11BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS11BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(11, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS11BE adds a field and reads 11 bit signed integer in big-endian
 func (d *D) FieldS11BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS11BE(name, sms...).Actual
+	s, err := d.TryFieldS11BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S11BE")
+	}
+	return s
 }
 
 // Reader S12BE
@@ -15224,14 +17570,14 @@ func (d *D) S12BE() int64 {
 
 // TryFieldScalarS12BE tries to add a field and read 12 bit signed integer in big-endian
 func (d *D) TryFieldScalarS12BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(12, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS12BE adds a field and reads 12 bit signed integer in big-endian
@@ -15245,13 +17591,20 @@ func (d *D) FieldScalarS12BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS12BE tries to add a field and read 12 bit signed integer in big-endian
 func (d *D) TryFieldS12BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS12BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(12, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS12BE adds a field and reads 12 bit signed integer in big-endian
 func (d *D) FieldS12BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS12BE(name, sms...).Actual
+	s, err := d.TryFieldS12BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S12BE")
+	}
+	return s
 }
 
 // Reader S13BE
@@ -15270,14 +17623,14 @@ func (d *D) S13BE() int64 {
 
 // TryFieldScalarS13BE tries to add a field and read 13 bit signed integer in big-endian
 func (d *D) TryFieldScalarS13BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(13, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS13BE adds a field and reads 13 bit signed integer in big-endian
@@ -15291,13 +17644,20 @@ func (d *D) FieldScalarS13BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS13BE tries to add a field and read 13 bit signed integer in big-endian
 func (d *D) TryFieldS13BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS13BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(13, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS13BE adds a field and reads 13 bit signed integer in big-endian
 func (d *D) FieldS13BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS13BE(name, sms...).Actual
+	s, err := d.TryFieldS13BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S13BE")
+	}
+	return s
 }
 
 // Reader S14BE
@@ -15316,14 +17676,14 @@ func (d *D) S14BE() int64 {
 
 // TryFieldScalarS14BE tries to add a field and read 14 bit signed integer in big-endian
 func (d *D) TryFieldScalarS14BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(14, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS14BE adds a field and reads 14 bit signed integer in big-endian
@@ -15337,13 +17697,20 @@ func (d *D) FieldScalarS14BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS14BE tries to add a field and read 14 bit signed integer in big-endian
 func (d *D) TryFieldS14BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS14BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(14, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS14BE adds a field and reads 14 bit signed integer in big-endian
 func (d *D) FieldS14BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS14BE(name, sms...).Actual
+	s, err := d.TryFieldS14BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S14BE")
+	}
+	return s
 }
 
 // Reader S15BE
@@ -15362,14 +17729,14 @@ func (d *D) S15BE() int64 {
 
 // TryFieldScalarS15BE tries to add a field and read 15 bit signed integer in big-endian
 func (d *D) TryFieldScalarS15BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(15, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS15BE adds a field and reads 15 bit signed integer in big-endian
@@ -15383,13 +17750,20 @@ func (d *D) FieldScalarS15BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS15BE tries to add a field and read 15 bit signed integer in big-endian
 func (d *D) TryFieldS15BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS15BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(15, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS15BE adds a field and reads 15 bit signed integer in big-endian
 func (d *D) FieldS15BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS15BE(name, sms...).Actual
+	s, err := d.TryFieldS15BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S15BE")
+	}
+	return s
 }
 
 // Reader S16BE
@@ -15408,14 +17782,14 @@ func (d *D) S16BE() int64 {
 
 // TryFieldScalarS16BE tries to add a field and read 16 bit signed integer in big-endian
 func (d *D) TryFieldScalarS16BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(16, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS16BE adds a field and reads 16 bit signed integer in big-endian
@@ -15429,13 +17803,20 @@ func (d *D) FieldScalarS16BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS16BE tries to add a field and read 16 bit signed integer in big-endian
 func (d *D) TryFieldS16BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS16BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(16, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS16BE adds a field and reads 16 bit signed integer in big-endian
 func (d *D) FieldS16BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS16BE(name, sms...).Actual
+	s, err := d.TryFieldS16BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S16BE")
+	}
+	return s
 }
 
 // Reader S17BE
@@ -15454,14 +17835,14 @@ func (d *D) S17BE() int64 {
 
 // TryFieldScalarS17BE tries to add a field and read 17 bit signed integer in big-endian
 func (d *D) TryFieldScalarS17BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(17, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS17BE adds a field and reads 17 bit signed integer in big-endian
@@ -15475,13 +17856,20 @@ func (d *D) FieldScalarS17BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS17BE tries to add a field and read 17 bit signed integer in big-endian
 func (d *D) TryFieldS17BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS17BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(17, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS17BE adds a field and reads 17 bit signed integer in big-endian
 func (d *D) FieldS17BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS17BE(name, sms...).Actual
+	s, err := d.TryFieldS17BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S17BE")
+	}
+	return s
 }
 
 // Reader S18BE
@@ -15500,14 +17888,14 @@ func (d *D) S18BE() int64 {
 
 // TryFieldScalarS18BE tries to add a field and read 18 bit signed integer in big-endian
 func (d *D) TryFieldScalarS18BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(18, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS18BE adds a field and reads 18 bit signed integer in big-endian
@@ -15521,13 +17909,20 @@ func (d *D) FieldScalarS18BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS18BE tries to add a field and read 18 bit signed integer in big-endian
 func (d *D) TryFieldS18BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS18BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(18, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS18BE adds a field and reads 18 bit signed integer in big-endian
 func (d *D) FieldS18BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS18BE(name, sms...).Actual
+	s, err := d.TryFieldS18BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S18BE")
+	}
+	return s
 }
 
 // Reader S19BE
@@ -15546,14 +17941,14 @@ func (d *D) S19BE() int64 {
 
 // TryFieldScalarS19BE tries to add a field and read 19 bit signed integer in big-endian
 func (d *D) TryFieldScalarS19BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(19, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS19BE adds a field and reads 19 bit signed integer in big-endian
@@ -15567,13 +17962,20 @@ func (d *D) FieldScalarS19BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS19BE tries to add a field and read 19 bit signed integer in big-endian
 func (d *D) TryFieldS19BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS19BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(19, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS19BE adds a field and reads 19 bit signed integer in big-endian
 func (d *D) FieldS19BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS19BE(name, sms...).Actual
+	s, err := d.TryFieldS19BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S19BE")
+	}
+	return s
 }
 
 // Reader S20BE
@@ -15592,14 +17994,14 @@ func (d *D) S20BE() int64 {
 
 // TryFieldScalarS20BE tries to add a field and read 20 bit signed integer in big-endian
 func (d *D) TryFieldScalarS20BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(20, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS20BE adds a field and reads 20 bit signed integer in big-endian
@@ -15613,13 +18015,20 @@ func (d *D) FieldScalarS20BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS20BE tries to add a field and read 20 bit signed integer in big-endian
 func (d *D) TryFieldS20BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS20BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(20, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS20BE adds a field and reads 20 bit signed integer in big-endian
 func (d *D) FieldS20BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS20BE(name, sms...).Actual
+	s, err := d.TryFieldS20BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S20BE")
+	}
+	return s
 }
 
 // Reader S21BE
@@ -15638,14 +18047,14 @@ func (d *D) S21BE() int64 {
 
 // TryFieldScalarS21BE tries to add a field and read 21 bit signed integer in big-endian
 func (d *D) TryFieldScalarS21BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(21, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS21BE adds a field and reads 21 bit signed integer in big-endian
@@ -15659,13 +18068,20 @@ func (d *D) FieldScalarS21BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS21BE tries to add a field and read 21 bit signed integer in big-endian
 func (d *D) TryFieldS21BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS21BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(21, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS21BE adds a field and reads 21 bit signed integer in big-endian
 func (d *D) FieldS21BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS21BE(name, sms...).Actual
+	s, err := d.TryFieldS21BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S21BE")
+	}
+	return s
 }
 
 // Reader S22BE
@@ -15684,14 +18100,14 @@ func (d *D) S22BE() int64 {
 
 // TryFieldScalarS22BE tries to add a field and read 22 bit signed integer in big-endian
 func (d *D) TryFieldScalarS22BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(22, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS22BE adds a field and reads 22 bit signed integer in big-endian
@@ -15705,13 +18121,20 @@ func (d *D) FieldScalarS22BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS22BE tries to add a field and read 22 bit signed integer in big-endian
 func (d *D) TryFieldS22BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS22BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(22, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS22BE adds a field and reads 22 bit signed integer in big-endian
 func (d *D) FieldS22BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS22BE(name, sms...).Actual
+	s, err := d.TryFieldS22BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S22BE")
+	}
+	return s
 }
 
 // Reader S23BE
@@ -15730,14 +18153,14 @@ func (d *D) S23BE() int64 {
 
 // TryFieldScalarS23BE tries to add a field and read 23 bit signed integer in big-endian
 func (d *D) TryFieldScalarS23BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(23, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS23BE adds a field and reads 23 bit signed integer in big-endian
@@ -15751,13 +18174,20 @@ func (d *D) FieldScalarS23BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS23BE tries to add a field and read 23 bit signed integer in big-endian
 func (d *D) TryFieldS23BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS23BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(23, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS23BE adds a field and reads 23 bit signed integer in big-endian
 func (d *D) FieldS23BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS23BE(name, sms...).Actual
+	s, err := d.TryFieldS23BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S23BE")
+	}
+	return s
 }
 
 // Reader S24BE
@@ -15776,14 +18206,14 @@ func (d *D) S24BE() int64 {
 
 // TryFieldScalarS24BE tries to add a field and read 24 bit signed integer in big-endian
 func (d *D) TryFieldScalarS24BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(24, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS24BE adds a field and reads 24 bit signed integer in big-endian
@@ -15797,13 +18227,20 @@ func (d *D) FieldScalarS24BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS24BE tries to add a field and read 24 bit signed integer in big-endian
 func (d *D) TryFieldS24BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS24BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(24, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS24BE adds a field and reads 24 bit signed integer in big-endian
 func (d *D) FieldS24BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS24BE(name, sms...).Actual
+	s, err := d.TryFieldS24BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S24BE")
+	}
+	return s
 }
 
 // Reader S25BE
@@ -15822,14 +18259,14 @@ func (d *D) S25BE() int64 {
 
 // TryFieldScalarS25BE tries to add a field and read 25 bit signed integer in big-endian
 func (d *D) TryFieldScalarS25BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(25, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS25BE adds a field and reads 25 bit signed integer in big-endian
@@ -15843,13 +18280,20 @@ func (d *D) FieldScalarS25BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS25BE tries to add a field and read 25 bit signed integer in big-endian
 func (d *D) TryFieldS25BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS25BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(25, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS25BE adds a field and reads 25 bit signed integer in big-endian
 func (d *D) FieldS25BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS25BE(name, sms...).Actual
+	s, err := d.TryFieldS25BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S25BE")
+	}
+	return s
 }
 
 // Reader S26BE
@@ -15868,14 +18312,14 @@ func (d *D) S26BE() int64 {
 
 // TryFieldScalarS26BE tries to add a field and read 26 bit signed integer in big-endian
 func (d *D) TryFieldScalarS26BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(26, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS26BE adds a field and reads 26 bit signed integer in big-endian
@@ -15889,13 +18333,20 @@ func (d *D) FieldScalarS26BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS26BE tries to add a field and read 26 bit signed integer in big-endian
 func (d *D) TryFieldS26BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS26BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(26, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS26BE adds a field and reads 26 bit signed integer in big-endian
 func (d *D) FieldS26BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS26BE(name, sms...).Actual
+	s, err := d.TryFieldS26BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S26BE")
+	}
+	return s
 }
 
 // Reader S27BE
@@ -15914,14 +18365,14 @@ func (d *D) S27BE() int64 {
 
 // TryFieldScalarS27BE tries to add a field and read 27 bit signed integer in big-endian
 func (d *D) TryFieldScalarS27BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(27, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS27BE adds a field and reads 27 bit signed integer in big-endian
@@ -15935,13 +18386,20 @@ func (d *D) FieldScalarS27BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS27BE tries to add a field and read 27 bit signed integer in big-endian
 func (d *D) TryFieldS27BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS27BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(27, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS27BE adds a field and reads 27 bit signed integer in big-endian
 func (d *D) FieldS27BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS27BE(name, sms...).Actual
+	s, err := d.TryFieldS27BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S27BE")
+	}
+	return s
 }
 
 // Reader S28BE
@@ -15960,14 +18418,14 @@ func (d *D) S28BE() int64 {
 
 // TryFieldScalarS28BE tries to add a field and read 28 bit signed integer in big-endian
 func (d *D) TryFieldScalarS28BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(28, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS28BE adds a field and reads 28 bit signed integer in big-endian
@@ -15981,13 +18439,20 @@ func (d *D) FieldScalarS28BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS28BE tries to add a field and read 28 bit signed integer in big-endian
 func (d *D) TryFieldS28BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS28BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(28, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS28BE adds a field and reads 28 bit signed integer in big-endian
 func (d *D) FieldS28BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS28BE(name, sms...).Actual
+	s, err := d.TryFieldS28BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S28BE")
+	}
+	return s
 }
 
 // Reader S29BE
@@ -16006,14 +18471,14 @@ func (d *D) S29BE() int64 {
 
 // TryFieldScalarS29BE tries to add a field and read 29 bit signed integer in big-endian
 func (d *D) TryFieldScalarS29BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(29, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS29BE adds a field and reads 29 bit signed integer in big-endian
@@ -16027,13 +18492,20 @@ func (d *D) FieldScalarS29BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS29BE tries to add a field and read 29 bit signed integer in big-endian
 func (d *D) TryFieldS29BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS29BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(29, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS29BE adds a field and reads 29 bit signed integer in big-endian
 func (d *D) FieldS29BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS29BE(name, sms...).Actual
+	s, err := d.TryFieldS29BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S29BE")
+	}
+	return s
 }
 
 // Reader S30BE
@@ -16052,14 +18524,14 @@ func (d *D) S30BE() int64 {
 
 // TryFieldScalarS30BE tries to add a field and read 30 bit signed integer in big-endian
 func (d *D) TryFieldScalarS30BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(30, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS30BE adds a field and reads 30 bit signed integer in big-endian
@@ -16073,13 +18545,20 @@ func (d *D) FieldScalarS30BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS30BE tries to add a field and read 30 bit signed integer in big-endian
 func (d *D) TryFieldS30BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS30BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(30, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS30BE adds a field and reads 30 bit signed integer in big-endian
 func (d *D) FieldS30BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS30BE(name, sms...).Actual
+	s, err := d.TryFieldS30BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S30BE")
+	}
+	return s
 }
 
 // Reader S31BE
@@ -16098,14 +18577,14 @@ func (d *D) S31BE() int64 {
 
 // TryFieldScalarS31BE tries to add a field and read 31 bit signed integer in big-endian
 func (d *D) TryFieldScalarS31BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(31, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS31BE adds a field and reads 31 bit signed integer in big-endian
@@ -16119,13 +18598,20 @@ func (d *D) FieldScalarS31BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS31BE tries to add a field and read 31 bit signed integer in big-endian
 func (d *D) TryFieldS31BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS31BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(31, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS31BE adds a field and reads 31 bit signed integer in big-endian
 func (d *D) FieldS31BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS31BE(name, sms...).Actual
+	s, err := d.TryFieldS31BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S31BE")
+	}
+	return s
 }
 
 // Reader S32BE
@@ -16144,14 +18630,14 @@ func (d *D) S32BE() int64 {
 
 // TryFieldScalarS32BE tries to add a field and read 32 bit signed integer in big-endian
 func (d *D) TryFieldScalarS32BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(32, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS32BE adds a field and reads 32 bit signed integer in big-endian
@@ -16165,13 +18651,20 @@ func (d *D) FieldScalarS32BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS32BE tries to add a field and read 32 bit signed integer in big-endian
 func (d *D) TryFieldS32BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS32BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(32, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS32BE adds a field and reads 32 bit signed integer in big-endian
 func (d *D) FieldS32BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS32BE(name, sms...).Actual
+	s, err := d.TryFieldS32BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S32BE")
+	}
+	return s
 }
 
 // Reader S33BE
@@ -16190,14 +18683,14 @@ func (d *D) S33BE() int64 {
 
 // TryFieldScalarS33BE tries to add a field and read 33 bit signed integer in big-endian
 func (d *D) TryFieldScalarS33BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(33, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS33BE adds a field and reads 33 bit signed integer in big-endian
@@ -16211,13 +18704,20 @@ func (d *D) FieldScalarS33BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS33BE tries to add a field and read 33 bit signed integer in big-endian
 func (d *D) TryFieldS33BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS33BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(33, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS33BE adds a field and reads 33 bit signed integer in big-endian
 func (d *D) FieldS33BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS33BE(name, sms...).Actual
+	s, err := d.TryFieldS33BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S33BE")
+	}
+	return s
 }
 
 // Reader S34BE
@@ -16236,14 +18736,14 @@ func (d *D) S34BE() int64 {
 
 // TryFieldScalarS34BE tries to add a field and read 34 bit signed integer in big-endian
 func (d *D) TryFieldScalarS34BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(34, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS34BE adds a field and reads 34 bit signed integer in big-endian
@@ -16257,13 +18757,20 @@ func (d *D) FieldScalarS34BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS34BE tries to add a field and read 34 bit signed integer in big-endian
 func (d *D) TryFieldS34BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS34BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(34, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS34BE adds a field and reads 34 bit signed integer in big-endian
 func (d *D) FieldS34BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS34BE(name, sms...).Actual
+	s, err := d.TryFieldS34BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S34BE")
+	}
+	return s
 }
 
 // Reader S35BE
@@ -16282,14 +18789,14 @@ func (d *D) S35BE() int64 {
 
 // TryFieldScalarS35BE tries to add a field and read 35 bit signed integer in big-endian
 func (d *D) TryFieldScalarS35BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(35, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS35BE adds a field and reads 35 bit signed integer in big-endian
@@ -16303,13 +18810,20 @@ func (d *D) FieldScalarS35BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS35BE tries to add a field and read 35 bit signed integer in big-endian
 func (d *D) TryFieldS35BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS35BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(35, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS35BE adds a field and reads 35 bit signed integer in big-endian
 func (d *D) FieldS35BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS35BE(name, sms...).Actual
+	s, err := d.TryFieldS35BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S35BE")
+	}
+	return s
 }
 
 // Reader S36BE
@@ -16328,14 +18842,14 @@ func (d *D) S36BE() int64 {
 
 // TryFieldScalarS36BE tries to add a field and read 36 bit signed integer in big-endian
 func (d *D) TryFieldScalarS36BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(36, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS36BE adds a field and reads 36 bit signed integer in big-endian
@@ -16349,13 +18863,20 @@ func (d *D) FieldScalarS36BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS36BE tries to add a field and read 36 bit signed integer in big-endian
 func (d *D) TryFieldS36BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS36BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(36, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS36BE adds a field and reads 36 bit signed integer in big-endian
 func (d *D) FieldS36BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS36BE(name, sms...).Actual
+	s, err := d.TryFieldS36BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S36BE")
+	}
+	return s
 }
 
 // Reader S37BE
@@ -16374,14 +18895,14 @@ func (d *D) S37BE() int64 {
 
 // TryFieldScalarS37BE tries to add a field and read 37 bit signed integer in big-endian
 func (d *D) TryFieldScalarS37BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(37, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS37BE adds a field and reads 37 bit signed integer in big-endian
@@ -16395,13 +18916,20 @@ func (d *D) FieldScalarS37BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS37BE tries to add a field and read 37 bit signed integer in big-endian
 func (d *D) TryFieldS37BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS37BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(37, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS37BE adds a field and reads 37 bit signed integer in big-endian
 func (d *D) FieldS37BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS37BE(name, sms...).Actual
+	s, err := d.TryFieldS37BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S37BE")
+	}
+	return s
 }
 
 // Reader S38BE
@@ -16420,14 +18948,14 @@ func (d *D) S38BE() int64 {
 
 // TryFieldScalarS38BE tries to add a field and read 38 bit signed integer in big-endian
 func (d *D) TryFieldScalarS38BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(38, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS38BE adds a field and reads 38 bit signed integer in big-endian
@@ -16441,13 +18969,20 @@ func (d *D) FieldScalarS38BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS38BE tries to add a field and read 38 bit signed integer in big-endian
 func (d *D) TryFieldS38BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS38BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(38, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS38BE adds a field and reads 38 bit signed integer in big-endian
 func (d *D) FieldS38BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS38BE(name, sms...).Actual
+	s, err := d.TryFieldS38BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S38BE")
+	}
+	return s
 }
 
 // Reader S39BE
@@ -16466,14 +19001,14 @@ func (d *D) S39BE() int64 {
 
 // TryFieldScalarS39BE tries to add a field and read 39 bit signed integer in big-endian
 func (d *D) TryFieldScalarS39BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(39, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS39BE adds a field and reads 39 bit signed integer in big-endian
@@ -16487,13 +19022,20 @@ func (d *D) FieldScalarS39BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS39BE tries to add a field and read 39 bit signed integer in big-endian
 func (d *D) TryFieldS39BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS39BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(39, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS39BE adds a field and reads 39 bit signed integer in big-endian
 func (d *D) FieldS39BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS39BE(name, sms...).Actual
+	s, err := d.TryFieldS39BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S39BE")
+	}
+	return s
 }
 
 // Reader S40BE
@@ -16512,14 +19054,14 @@ func (d *D) S40BE() int64 {
 
 // TryFieldScalarS40BE tries to add a field and read 40 bit signed integer in big-endian
 func (d *D) TryFieldScalarS40BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(40, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS40BE adds a field and reads 40 bit signed integer in big-endian
@@ -16533,13 +19075,20 @@ func (d *D) FieldScalarS40BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS40BE tries to add a field and read 40 bit signed integer in big-endian
 func (d *D) TryFieldS40BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS40BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(40, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS40BE adds a field and reads 40 bit signed integer in big-endian
 func (d *D) FieldS40BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS40BE(name, sms...).Actual
+	s, err := d.TryFieldS40BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S40BE")
+	}
+	return s
 }
 
 // Reader S41BE
@@ -16558,14 +19107,14 @@ func (d *D) S41BE() int64 {
 
 // TryFieldScalarS41BE tries to add a field and read 41 bit signed integer in big-endian
 func (d *D) TryFieldScalarS41BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(41, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS41BE adds a field and reads 41 bit signed integer in big-endian
@@ -16579,13 +19128,20 @@ func (d *D) FieldScalarS41BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS41BE tries to add a field and read 41 bit signed integer in big-endian
 func (d *D) TryFieldS41BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS41BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(41, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS41BE adds a field and reads 41 bit signed integer in big-endian
 func (d *D) FieldS41BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS41BE(name, sms...).Actual
+	s, err := d.TryFieldS41BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S41BE")
+	}
+	return s
 }
 
 // Reader S42BE
@@ -16604,14 +19160,14 @@ func (d *D) S42BE() int64 {
 
 // TryFieldScalarS42BE tries to add a field and read 42 bit signed integer in big-endian
 func (d *D) TryFieldScalarS42BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(42, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS42BE adds a field and reads 42 bit signed integer in big-endian
@@ -16625,13 +19181,20 @@ func (d *D) FieldScalarS42BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS42BE tries to add a field and read 42 bit signed integer in big-endian
 func (d *D) TryFieldS42BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS42BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(42, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS42BE adds a field and reads 42 bit signed integer in big-endian
 func (d *D) FieldS42BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS42BE(name, sms...).Actual
+	s, err := d.TryFieldS42BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S42BE")
+	}
+	return s
 }
 
 // Reader S43BE
@@ -16650,14 +19213,14 @@ func (d *D) S43BE() int64 {
 
 // TryFieldScalarS43BE tries to add a field and read 43 bit signed integer in big-endian
 func (d *D) TryFieldScalarS43BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(43, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS43BE adds a field and reads 43 bit signed integer in big-endian
@@ -16671,13 +19234,20 @@ func (d *D) FieldScalarS43BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS43BE tries to add a field and read 43 bit signed integer in big-endian
 func (d *D) TryFieldS43BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS43BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(43, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS43BE adds a field and reads 43 bit signed integer in big-endian
 func (d *D) FieldS43BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS43BE(name, sms...).Actual
+	s, err := d.TryFieldS43BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S43BE")
+	}
+	return s
 }
 
 // Reader S44BE
@@ -16696,14 +19266,14 @@ func (d *D) S44BE() int64 {
 
 // TryFieldScalarS44BE tries to add a field and read 44 bit signed integer in big-endian
 func (d *D) TryFieldScalarS44BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(44, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS44BE adds a field and reads 44 bit signed integer in big-endian
@@ -16717,13 +19287,20 @@ func (d *D) FieldScalarS44BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS44BE tries to add a field and read 44 bit signed integer in big-endian
 func (d *D) TryFieldS44BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS44BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(44, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS44BE adds a field and reads 44 bit signed integer in big-endian
 func (d *D) FieldS44BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS44BE(name, sms...).Actual
+	s, err := d.TryFieldS44BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S44BE")
+	}
+	return s
 }
 
 // Reader S45BE
@@ -16742,14 +19319,14 @@ func (d *D) S45BE() int64 {
 
 // TryFieldScalarS45BE tries to add a field and read 45 bit signed integer in big-endian
 func (d *D) TryFieldScalarS45BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(45, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS45BE adds a field and reads 45 bit signed integer in big-endian
@@ -16763,13 +19340,20 @@ func (d *D) FieldScalarS45BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS45BE tries to add a field and read 45 bit signed integer in big-endian
 func (d *D) TryFieldS45BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS45BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(45, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS45BE adds a field and reads 45 bit signed integer in big-endian
 func (d *D) FieldS45BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS45BE(name, sms...).Actual
+	s, err := d.TryFieldS45BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S45BE")
+	}
+	return s
 }
 
 // Reader S46BE
@@ -16788,14 +19372,14 @@ func (d *D) S46BE() int64 {
 
 // TryFieldScalarS46BE tries to add a field and read 46 bit signed integer in big-endian
 func (d *D) TryFieldScalarS46BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(46, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS46BE adds a field and reads 46 bit signed integer in big-endian
@@ -16809,13 +19393,20 @@ func (d *D) FieldScalarS46BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS46BE tries to add a field and read 46 bit signed integer in big-endian
 func (d *D) TryFieldS46BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS46BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(46, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS46BE adds a field and reads 46 bit signed integer in big-endian
 func (d *D) FieldS46BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS46BE(name, sms...).Actual
+	s, err := d.TryFieldS46BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S46BE")
+	}
+	return s
 }
 
 // Reader S47BE
@@ -16834,14 +19425,14 @@ func (d *D) S47BE() int64 {
 
 // TryFieldScalarS47BE tries to add a field and read 47 bit signed integer in big-endian
 func (d *D) TryFieldScalarS47BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(47, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS47BE adds a field and reads 47 bit signed integer in big-endian
@@ -16855,13 +19446,20 @@ func (d *D) FieldScalarS47BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS47BE tries to add a field and read 47 bit signed integer in big-endian
 func (d *D) TryFieldS47BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS47BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(47, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS47BE adds a field and reads 47 bit signed integer in big-endian
 func (d *D) FieldS47BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS47BE(name, sms...).Actual
+	s, err := d.TryFieldS47BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S47BE")
+	}
+	return s
 }
 
 // Reader S48BE
@@ -16880,14 +19478,14 @@ func (d *D) S48BE() int64 {
 
 // TryFieldScalarS48BE tries to add a field and read 48 bit signed integer in big-endian
 func (d *D) TryFieldScalarS48BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(48, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS48BE adds a field and reads 48 bit signed integer in big-endian
@@ -16901,13 +19499,20 @@ func (d *D) FieldScalarS48BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS48BE tries to add a field and read 48 bit signed integer in big-endian
 func (d *D) TryFieldS48BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS48BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(48, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS48BE adds a field and reads 48 bit signed integer in big-endian
 func (d *D) FieldS48BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS48BE(name, sms...).Actual
+	s, err := d.TryFieldS48BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S48BE")
+	}
+	return s
 }
 
 // Reader S49BE
@@ -16926,14 +19531,14 @@ func (d *D) S49BE() int64 {
 
 // TryFieldScalarS49BE tries to add a field and read 49 bit signed integer in big-endian
 func (d *D) TryFieldScalarS49BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(49, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS49BE adds a field and reads 49 bit signed integer in big-endian
@@ -16947,13 +19552,20 @@ func (d *D) FieldScalarS49BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS49BE tries to add a field and read 49 bit signed integer in big-endian
 func (d *D) TryFieldS49BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS49BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(49, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS49BE adds a field and reads 49 bit signed integer in big-endian
 func (d *D) FieldS49BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS49BE(name, sms...).Actual
+	s, err := d.TryFieldS49BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S49BE")
+	}
+	return s
 }
 
 // Reader S50BE
@@ -16972,14 +19584,14 @@ func (d *D) S50BE() int64 {
 
 // TryFieldScalarS50BE tries to add a field and read 50 bit signed integer in big-endian
 func (d *D) TryFieldScalarS50BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(50, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS50BE adds a field and reads 50 bit signed integer in big-endian
@@ -16993,13 +19605,20 @@ func (d *D) FieldScalarS50BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS50BE tries to add a field and read 50 bit signed integer in big-endian
 func (d *D) TryFieldS50BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS50BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(50, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS50BE adds a field and reads 50 bit signed integer in big-endian
 func (d *D) FieldS50BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS50BE(name, sms...).Actual
+	s, err := d.TryFieldS50BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S50BE")
+	}
+	return s
 }
 
 // Reader S51BE
@@ -17018,14 +19637,14 @@ func (d *D) S51BE() int64 {
 
 // TryFieldScalarS51BE tries to add a field and read 51 bit signed integer in big-endian
 func (d *D) TryFieldScalarS51BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(51, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS51BE adds a field and reads 51 bit signed integer in big-endian
@@ -17039,13 +19658,20 @@ func (d *D) FieldScalarS51BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS51BE tries to add a field and read 51 bit signed integer in big-endian
 func (d *D) TryFieldS51BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS51BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(51, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS51BE adds a field and reads 51 bit signed integer in big-endian
 func (d *D) FieldS51BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS51BE(name, sms...).Actual
+	s, err := d.TryFieldS51BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S51BE")
+	}
+	return s
 }
 
 // Reader S52BE
@@ -17064,14 +19690,14 @@ func (d *D) S52BE() int64 {
 
 // TryFieldScalarS52BE tries to add a field and read 52 bit signed integer in big-endian
 func (d *D) TryFieldScalarS52BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(52, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS52BE adds a field and reads 52 bit signed integer in big-endian
@@ -17085,13 +19711,20 @@ func (d *D) FieldScalarS52BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS52BE tries to add a field and read 52 bit signed integer in big-endian
 func (d *D) TryFieldS52BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS52BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(52, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS52BE adds a field and reads 52 bit signed integer in big-endian
 func (d *D) FieldS52BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS52BE(name, sms...).Actual
+	s, err := d.TryFieldS52BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S52BE")
+	}
+	return s
 }
 
 // Reader S53BE
@@ -17110,14 +19743,14 @@ func (d *D) S53BE() int64 {
 
 // TryFieldScalarS53BE tries to add a field and read 53 bit signed integer in big-endian
 func (d *D) TryFieldScalarS53BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(53, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS53BE adds a field and reads 53 bit signed integer in big-endian
@@ -17131,13 +19764,20 @@ func (d *D) FieldScalarS53BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS53BE tries to add a field and read 53 bit signed integer in big-endian
 func (d *D) TryFieldS53BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS53BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(53, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS53BE adds a field and reads 53 bit signed integer in big-endian
 func (d *D) FieldS53BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS53BE(name, sms...).Actual
+	s, err := d.TryFieldS53BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S53BE")
+	}
+	return s
 }
 
 // Reader S54BE
@@ -17156,14 +19796,14 @@ func (d *D) S54BE() int64 {
 
 // TryFieldScalarS54BE tries to add a field and read 54 bit signed integer in big-endian
 func (d *D) TryFieldScalarS54BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(54, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS54BE adds a field and reads 54 bit signed integer in big-endian
@@ -17177,13 +19817,20 @@ func (d *D) FieldScalarS54BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS54BE tries to add a field and read 54 bit signed integer in big-endian
 func (d *D) TryFieldS54BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS54BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(54, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS54BE adds a field and reads 54 bit signed integer in big-endian
 func (d *D) FieldS54BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS54BE(name, sms...).Actual
+	s, err := d.TryFieldS54BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S54BE")
+	}
+	return s
 }
 
 // Reader S55BE
@@ -17202,14 +19849,14 @@ func (d *D) S55BE() int64 {
 
 // TryFieldScalarS55BE tries to add a field and read 55 bit signed integer in big-endian
 func (d *D) TryFieldScalarS55BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(55, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS55BE adds a field and reads 55 bit signed integer in big-endian
@@ -17223,13 +19870,20 @@ func (d *D) FieldScalarS55BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS55BE tries to add a field and read 55 bit signed integer in big-endian
 func (d *D) TryFieldS55BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS55BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(55, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS55BE adds a field and reads 55 bit signed integer in big-endian
 func (d *D) FieldS55BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS55BE(name, sms...).Actual
+	s, err := d.TryFieldS55BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S55BE")
+	}
+	return s
 }
 
 // Reader S56BE
@@ -17248,14 +19902,14 @@ func (d *D) S56BE() int64 {
 
 // TryFieldScalarS56BE tries to add a field and read 56 bit signed integer in big-endian
 func (d *D) TryFieldScalarS56BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(56, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS56BE adds a field and reads 56 bit signed integer in big-endian
@@ -17269,13 +19923,20 @@ func (d *D) FieldScalarS56BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS56BE tries to add a field and read 56 bit signed integer in big-endian
 func (d *D) TryFieldS56BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS56BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(56, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS56BE adds a field and reads 56 bit signed integer in big-endian
 func (d *D) FieldS56BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS56BE(name, sms...).Actual
+	s, err := d.TryFieldS56BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S56BE")
+	}
+	return s
 }
 
 // Reader S57BE
@@ -17294,14 +19955,14 @@ func (d *D) S57BE() int64 {
 
 // TryFieldScalarS57BE tries to add a field and read 57 bit signed integer in big-endian
 func (d *D) TryFieldScalarS57BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(57, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS57BE adds a field and reads 57 bit signed integer in big-endian
@@ -17315,13 +19976,20 @@ func (d *D) FieldScalarS57BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS57BE tries to add a field and read 57 bit signed integer in big-endian
 func (d *D) TryFieldS57BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS57BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(57, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS57BE adds a field and reads 57 bit signed integer in big-endian
 func (d *D) FieldS57BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS57BE(name, sms...).Actual
+	s, err := d.TryFieldS57BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S57BE")
+	}
+	return s
 }
 
 // Reader S58BE
@@ -17340,14 +20008,14 @@ func (d *D) S58BE() int64 {
 
 // TryFieldScalarS58BE tries to add a field and read 58 bit signed integer in big-endian
 func (d *D) TryFieldScalarS58BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(58, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS58BE adds a field and reads 58 bit signed integer in big-endian
@@ -17361,13 +20029,20 @@ func (d *D) FieldScalarS58BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS58BE tries to add a field and read 58 bit signed integer in big-endian
 func (d *D) TryFieldS58BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS58BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(58, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS58BE adds a field and reads 58 bit signed integer in big-endian
 func (d *D) FieldS58BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS58BE(name, sms...).Actual
+	s, err := d.TryFieldS58BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S58BE")
+	}
+	return s
 }
 
 // Reader S59BE
@@ -17386,14 +20061,14 @@ func (d *D) S59BE() int64 {
 
 // TryFieldScalarS59BE tries to add a field and read 59 bit signed integer in big-endian
 func (d *D) TryFieldScalarS59BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(59, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS59BE adds a field and reads 59 bit signed integer in big-endian
@@ -17407,13 +20082,20 @@ func (d *D) FieldScalarS59BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS59BE tries to add a field and read 59 bit signed integer in big-endian
 func (d *D) TryFieldS59BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS59BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(59, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS59BE adds a field and reads 59 bit signed integer in big-endian
 func (d *D) FieldS59BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS59BE(name, sms...).Actual
+	s, err := d.TryFieldS59BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S59BE")
+	}
+	return s
 }
 
 // Reader S60BE
@@ -17432,14 +20114,14 @@ func (d *D) S60BE() int64 {
 
 // TryFieldScalarS60BE tries to add a field and read 60 bit signed integer in big-endian
 func (d *D) TryFieldScalarS60BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(60, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS60BE adds a field and reads 60 bit signed integer in big-endian
@@ -17453,13 +20135,20 @@ func (d *D) FieldScalarS60BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS60BE tries to add a field and read 60 bit signed integer in big-endian
 func (d *D) TryFieldS60BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS60BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(60, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS60BE adds a field and reads 60 bit signed integer in big-endian
 func (d *D) FieldS60BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS60BE(name, sms...).Actual
+	s, err := d.TryFieldS60BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S60BE")
+	}
+	return s
 }
 
 // Reader S61BE
@@ -17478,14 +20167,14 @@ func (d *D) S61BE() int64 {
 
 // TryFieldScalarS61BE tries to add a field and read 61 bit signed integer in big-endian
 func (d *D) TryFieldScalarS61BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(61, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS61BE adds a field and reads 61 bit signed integer in big-endian
@@ -17499,13 +20188,20 @@ func (d *D) FieldScalarS61BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS61BE tries to add a field and read 61 bit signed integer in big-endian
 func (d *D) TryFieldS61BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS61BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(61, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS61BE adds a field and reads 61 bit signed integer in big-endian
 func (d *D) FieldS61BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS61BE(name, sms...).Actual
+	s, err := d.TryFieldS61BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S61BE")
+	}
+	return s
 }
 
 // Reader S62BE
@@ -17524,14 +20220,14 @@ func (d *D) S62BE() int64 {
 
 // TryFieldScalarS62BE tries to add a field and read 62 bit signed integer in big-endian
 func (d *D) TryFieldScalarS62BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(62, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS62BE adds a field and reads 62 bit signed integer in big-endian
@@ -17545,13 +20241,20 @@ func (d *D) FieldScalarS62BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS62BE tries to add a field and read 62 bit signed integer in big-endian
 func (d *D) TryFieldS62BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS62BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(62, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS62BE adds a field and reads 62 bit signed integer in big-endian
 func (d *D) FieldS62BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS62BE(name, sms...).Actual
+	s, err := d.TryFieldS62BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S62BE")
+	}
+	return s
 }
 
 // Reader S63BE
@@ -17570,14 +20273,14 @@ func (d *D) S63BE() int64 {
 
 // TryFieldScalarS63BE tries to add a field and read 63 bit signed integer in big-endian
 func (d *D) TryFieldScalarS63BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(63, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS63BE adds a field and reads 63 bit signed integer in big-endian
@@ -17591,13 +20294,20 @@ func (d *D) FieldScalarS63BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS63BE tries to add a field and read 63 bit signed integer in big-endian
 func (d *D) TryFieldS63BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS63BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(63, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS63BE adds a field and reads 63 bit signed integer in big-endian
 func (d *D) FieldS63BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS63BE(name, sms...).Actual
+	s, err := d.TryFieldS63BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S63BE")
+	}
+	return s
 }
 
 // Reader S64BE
@@ -17616,14 +20326,14 @@ func (d *D) S64BE() int64 {
 
 // TryFieldScalarS64BE tries to add a field and read 64 bit signed integer in big-endian
 func (d *D) TryFieldScalarS64BE(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
 		v, err := d.trySEndian(64, BigEndian)
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarS64BE adds a field and reads 64 bit signed integer in big-endian
@@ -17637,13 +20347,20 @@ func (d *D) FieldScalarS64BE(name string, sms ...scalar.SintMapper) *scalar.Sint
 
 // TryFieldS64BE tries to add a field and read 64 bit signed integer in big-endian
 func (d *D) TryFieldS64BE(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarS64BE(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, sintEncoding(BigEndian), func(d *D) (scalar.Sint, error) {
+		v, err := d.trySEndian(64, BigEndian)
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldS64BE adds a field and reads 64 bit signed integer in big-endian
 func (d *D) FieldS64BE(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarS64BE(name, sms...).Actual
+	s, err := d.TryFieldS64BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "S64BE")
+	}
+	return s
 }
 
 // Reader UBigInt
@@ -17664,14 +20381,14 @@ func (d *D) UBigInt(nBits int) *big.Int {
 
 // TryFieldScalarUBigInt tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldScalarUBigInt(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, d.Endian, false)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUBigInt adds a field and reads nBits bits signed integer in current endian
@@ -17685,13 +20402,20 @@ func (d *D) FieldScalarUBigInt(name string, nBits int, sms ...scalar.BigIntMappe
 
 // TryFieldUBigInt tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldUBigInt(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarUBigInt(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, d.Endian, false)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUBigInt adds a field and reads nBits bits signed integer in current endian
 func (d *D) FieldUBigInt(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarUBigInt(name, nBits, sms...).Actual
+	s, err := d.TryFieldUBigInt(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UBigInt")
+	}
+	return s
 }
 
 // Reader UBigIntE
@@ -17712,14 +20436,14 @@ func (d *D) UBigIntE(nBits int, endian Endian) *big.Int {
 
 // TryFieldScalarUBigIntE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldScalarUBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, endian, false)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUBigIntE adds a field and reads nBits signed integer in specified endian
@@ -17733,13 +20457,20 @@ func (d *D) FieldScalarUBigIntE(name string, nBits int, endian Endian, sms ...sc
 
 // TryFieldUBigIntE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldUBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarUBigIntE(name, nBits, endian, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, endian, false)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUBigIntE adds a field and reads nBits signed integer in specified endian
 func (d *D) FieldUBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarUBigIntE(name, nBits, endian, sms...).Actual
+	s, err := d.TryFieldUBigIntE(name, nBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UBigIntE")
+	}
+	return s
 }
 
 // Reader UBigIntLE
@@ -17760,14 +20491,14 @@ func (d *D) UBigIntLE(nBits int) *big.Int {
 
 // TryFieldScalarUBigIntLE tries to add a field and read nBits bit signed integer in little-endian
 func (d *D) TryFieldScalarUBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, LittleEndian, false)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUBigIntLE adds a field and reads nBits bit signed integer in little-endian
@@ -17781,13 +20512,20 @@ func (d *D) FieldScalarUBigIntLE(name string, nBits int, sms ...scalar.BigIntMap
 
 // TryFieldUBigIntLE tries to add a field and read nBits bit signed integer in little-endian
 func (d *D) TryFieldUBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarUBigIntLE(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, LittleEndian, false)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUBigIntLE adds a field and reads nBits bit signed integer in little-endian
 func (d *D) FieldUBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarUBigIntLE(name, nBits, sms...).Actual
+	s, err := d.TryFieldUBigIntLE(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UBigIntLE")
+	}
+	return s
 }
 
 // Reader UBigIntBE
@@ -17808,14 +20546,14 @@ func (d *D) UBigIntBE(nBits int) *big.Int {
 
 // TryFieldScalarUBigIntBE tries to add a field and read nBits bit signed integer in big-endian
 func (d *D) TryFieldScalarUBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, BigEndian, false)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUBigIntBE adds a field and reads nBits bit signed integer in big-endian
@@ -17829,13 +20567,20 @@ func (d *D) FieldScalarUBigIntBE(name string, nBits int, sms ...scalar.BigIntMap
 
 // TryFieldUBigIntBE tries to add a field and read nBits bit signed integer in big-endian
 func (d *D) TryFieldUBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarUBigIntBE(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, BigEndian, false)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUBigIntBE adds a field and reads nBits bit signed integer in big-endian
 func (d *D) FieldUBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarUBigIntBE(name, nBits, sms...).Actual
+	s, err := d.TryFieldUBigIntBE(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UBigIntBE")
+	}
+	return s
 }
 
 // Reader SBigInt
@@ -17856,14 +20601,14 @@ func (d *D) SBigInt(nBits int) *big.Int {
 
 // TryFieldScalarSBigInt tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldScalarSBigInt(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, d.Endian, true)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSBigInt adds a field and reads nBits bits signed integer in current endian
@@ -17877,13 +20622,20 @@ func (d *D) FieldScalarSBigInt(name string, nBits int, sms ...scalar.BigIntMappe
 
 // TryFieldSBigInt tries to add a field and read nBits bits signed integer in current endian
 func (d *D) TryFieldSBigInt(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarSBigInt(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, d.Endian, true)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSBigInt adds a field and reads nBits bits signed integer in current endian
 func (d *D) FieldSBigInt(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarSBigInt(name, nBits, sms...).Actual
+	s, err := d.TryFieldSBigInt(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SBigInt")
+	}
+	return s
 }
 
 // Reader SBigIntE
@@ -17904,14 +20656,14 @@ func (d *D) SBigIntE(nBits int, endian Endian) *big.Int {
 
 // TryFieldScalarSBigIntE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldScalarSBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, endian, true)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSBigIntE adds a field and reads nBits signed integer in specified endian
@@ -17925,13 +20677,20 @@ func (d *D) FieldScalarSBigIntE(name string, nBits int, endian Endian, sms ...sc
 
 // TryFieldSBigIntE tries to add a field and read nBits signed integer in specified endian
 func (d *D) TryFieldSBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarSBigIntE(name, nBits, endian, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, endian, true)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSBigIntE adds a field and reads nBits signed integer in specified endian
 func (d *D) FieldSBigIntE(name string, nBits int, endian Endian, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarSBigIntE(name, nBits, endian, sms...).Actual
+	s, err := d.TryFieldSBigIntE(name, nBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SBigIntE")
+	}
+	return s
 }
 
 // Reader SBigIntLE
@@ -17952,14 +20711,14 @@ func (d *D) SBigIntLE(nBits int) *big.Int {
 
 // TryFieldScalarSBigIntLE tries to add a field and read nBits bit signed integer in little-endian
 func (d *D) TryFieldScalarSBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, LittleEndian, true)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSBigIntLE adds a field and reads nBits bit signed integer in little-endian
@@ -17973,13 +20732,20 @@ func (d *D) FieldScalarSBigIntLE(name string, nBits int, sms ...scalar.BigIntMap
 
 // TryFieldSBigIntLE tries to add a field and read nBits bit signed integer in little-endian
 func (d *D) TryFieldSBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarSBigIntLE(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, LittleEndian, true)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSBigIntLE adds a field and reads nBits bit signed integer in little-endian
 func (d *D) FieldSBigIntLE(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarSBigIntLE(name, nBits, sms...).Actual
+	s, err := d.TryFieldSBigIntLE(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SBigIntLE")
+	}
+	return s
 }
 
 // Reader SBigIntBE
@@ -18000,14 +20766,14 @@ func (d *D) SBigIntBE(nBits int) *big.Int {
 
 // TryFieldScalarSBigIntBE tries to add a field and read nBits bit signed integer in big-endian
 func (d *D) TryFieldScalarSBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) (*scalar.BigInt, error) {
-	s, err := d.TryFieldScalarBigIntFn(name, func(d *D) (scalar.BigInt, error) {
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
 		v, err := d.tryBigIntEndianSign(nBits, BigEndian, true)
 		return scalar.BigInt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSBigIntBE adds a field and reads nBits bit signed integer in big-endian
@@ -18021,13 +20787,20 @@ func (d *D) FieldScalarSBigIntBE(name string, nBits int, sms ...scalar.BigIntMap
 
 // TryFieldSBigIntBE tries to add a field and read nBits bit signed integer in big-endian
 func (d *D) TryFieldSBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) (*big.Int, error) {
-	s, err := d.TryFieldScalarSBigIntBE(name, nBits, sms...)
+	s, err := d.tryFieldScalarBigIntFn(name, nil, func(d *D) (scalar.BigInt, error) {
+		v, err := d.tryBigIntEndianSign(nBits, BigEndian, true)
+		return scalar.BigInt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSBigIntBE adds a field and reads nBits bit signed integer in big-endian
 func (d *D) FieldSBigIntBE(name string, nBits int, sms ...scalar.BigIntMapper) *big.Int {
-	return d.FieldScalarSBigIntBE(name, nBits, sms...).Actual
+	s, err := d.TryFieldSBigIntBE(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SBigIntBE")
+	}
+	return s
 }
 
 // Reader F
@@ -18046,14 +20819,14 @@ func (d *D) F(nBits int) float64 {
 
 // TryFieldScalarF tries to add a field and read nBit IEEE 754 float in current endian
 func (d *D) TryFieldScalarF(name string, nBits int, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(nBits, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF adds a field and reads nBit IEEE 754 float in current endian
@@ -18067,13 +20840,20 @@ func (d *D) FieldScalarF(name string, nBits int, sms ...scalar.FltMapper) *scala
 
 // TryFieldF tries to add a field and read nBit IEEE 754 float in current endian
 func (d *D) TryFieldF(name string, nBits int, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF(name, nBits, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(nBits, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF adds a field and reads nBit IEEE 754 float in current endian
 func (d *D) FieldF(name string, nBits int, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF(name, nBits, sms...).Actual
+	s, err := d.TryFieldF(name, nBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F")
+	}
+	return s
 }
 
 // Reader FE
@@ -18092,14 +20872,14 @@ func (d *D) FE(nBits int, endian Endian) float64 {
 
 // TryFieldScalarFE tries to add a field and read nBit IEEE 754 float in specified endian
 func (d *D) TryFieldScalarFE(name string, nBits int, endian Endian, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(nBits, endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFE adds a field and reads nBit IEEE 754 float in specified endian
@@ -18113,13 +20893,20 @@ func (d *D) FieldScalarFE(name string, nBits int, endian Endian, sms ...scalar.F
 
 // TryFieldFE tries to add a field and read nBit IEEE 754 float in specified endian
 func (d *D) TryFieldFE(name string, nBits int, endian Endian, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFE(name, nBits, endian, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(nBits, endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFE adds a field and reads nBit IEEE 754 float in specified endian
 func (d *D) FieldFE(name string, nBits int, endian Endian, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFE(name, nBits, endian, sms...).Actual
+	s, err := d.TryFieldFE(name, nBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FE")
+	}
+	return s
 }
 
 // Reader F16
@@ -18138,14 +20925,14 @@ func (d *D) F16() float64 {
 
 // TryFieldScalarF16 tries to add a field and read 16 bit IEEE 754 float in current endian
 func (d *D) TryFieldScalarF16(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(16, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF16 adds a field and reads 16 bit IEEE 754 float in current endian
@@ -18159,13 +20946,20 @@ func (d *D) FieldScalarF16(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF16 tries to add a field and read 16 bit IEEE 754 float in current endian
 func (d *D) TryFieldF16(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF16(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(16, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF16 adds a field and reads 16 bit IEEE 754 float in current endian
 func (d *D) FieldF16(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF16(name, sms...).Actual
+	s, err := d.TryFieldF16(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F16")
+	}
+	return s
 }
 
 // Reader F32
@@ -18184,14 +20978,14 @@ func (d *D) F32() float64 {
 
 // TryFieldScalarF32 tries to add a field and read 32 bit IEEE 754 float in current endian
 func (d *D) TryFieldScalarF32(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(32, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF32 adds a field and reads 32 bit IEEE 754 float in current endian
@@ -18205,13 +20999,20 @@ func (d *D) FieldScalarF32(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF32 tries to add a field and read 32 bit IEEE 754 float in current endian
 func (d *D) TryFieldF32(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF32(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(32, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF32 adds a field and reads 32 bit IEEE 754 float in current endian
 func (d *D) FieldF32(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF32(name, sms...).Actual
+	s, err := d.TryFieldF32(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F32")
+	}
+	return s
 }
 
 // Reader F64
@@ -18230,14 +21031,14 @@ func (d *D) F64() float64 {
 
 // TryFieldScalarF64 tries to add a field and read 64 bit IEEE 754 float in current endian
 func (d *D) TryFieldScalarF64(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(64, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF64 adds a field and reads 64 bit IEEE 754 float in current endian
@@ -18251,13 +21052,20 @@ func (d *D) FieldScalarF64(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF64 tries to add a field and read 64 bit IEEE 754 float in current endian
 func (d *D) TryFieldF64(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF64(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(64, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF64 adds a field and reads 64 bit IEEE 754 float in current endian
 func (d *D) FieldF64(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF64(name, sms...).Actual
+	s, err := d.TryFieldF64(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F64")
+	}
+	return s
 }
 
 // Reader F80
@@ -18276,14 +21084,14 @@ func (d *D) F80() float64 {
 
 // TryFieldScalarF80 tries to add a field and read 80 bit IEEE 754 float in current endian
 func (d *D) TryFieldScalarF80(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(80, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF80 adds a field and reads 80 bit IEEE 754 float in current endian
@@ -18297,13 +21105,20 @@ func (d *D) FieldScalarF80(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF80 tries to add a field and read 80 bit IEEE 754 float in current endian
 func (d *D) TryFieldF80(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF80(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(d.Endian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(80, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF80 adds a field and reads 80 bit IEEE 754 float in current endian
 func (d *D) FieldF80(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF80(name, sms...).Actual
+	s, err := d.TryFieldF80(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F80")
+	}
+	return s
 }
 
 // Reader F16LE
@@ -18322,14 +21137,14 @@ func (d *D) F16LE() float64 {
 
 // TryFieldScalarF16LE tries to add a field and read 16 bit IEEE 754 float in little-endian
 func (d *D) TryFieldScalarF16LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(16, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF16LE adds a field and reads 16 bit IEEE 754 float in little-endian
@@ -18343,13 +21158,20 @@ func (d *D) FieldScalarF16LE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF16LE tries to add a field and read 16 bit IEEE 754 float in little-endian
 func (d *D) TryFieldF16LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF16LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(16, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF16LE adds a field and reads 16 bit IEEE 754 float in little-endian
 func (d *D) FieldF16LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF16LE(name, sms...).Actual
+	s, err := d.TryFieldF16LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F16LE")
+	}
+	return s
 }
 
 // Reader F32LE
@@ -18368,14 +21190,14 @@ func (d *D) F32LE() float64 {
 
 // TryFieldScalarF32LE tries to add a field and read 32 bit IEEE 754 float in little-endian
 func (d *D) TryFieldScalarF32LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(32, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF32LE adds a field and reads 32 bit IEEE 754 float in little-endian
@@ -18389,13 +21211,20 @@ func (d *D) FieldScalarF32LE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF32LE tries to add a field and read 32 bit IEEE 754 float in little-endian
 func (d *D) TryFieldF32LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF32LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(32, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF32LE adds a field and reads 32 bit IEEE 754 float in little-endian
 func (d *D) FieldF32LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF32LE(name, sms...).Actual
+	s, err := d.TryFieldF32LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F32LE")
+	}
+	return s
 }
 
 // Reader F64LE
@@ -18414,14 +21243,14 @@ func (d *D) F64LE() float64 {
 
 // TryFieldScalarF64LE tries to add a field and read 64 bit IEEE 754 float in little-endian
 func (d *D) TryFieldScalarF64LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(64, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF64LE adds a field and reads 64 bit IEEE 754 float in little-endian
@@ -18435,13 +21264,20 @@ func (d *D) FieldScalarF64LE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF64LE tries to add a field and read 64 bit IEEE 754 float in little-endian
 func (d *D) TryFieldF64LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF64LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(64, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF64LE adds a field and reads 64 bit IEEE 754 float in little-endian
 func (d *D) FieldF64LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF64LE(name, sms...).Actual
+	s, err := d.TryFieldF64LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F64LE")
+	}
+	return s
 }
 
 // Reader F80LE
@@ -18460,14 +21296,14 @@ func (d *D) F80LE() float64 {
 
 // TryFieldScalarF80LE tries to add a field and read 80 bit IEEE 754 float in little-endian
 func (d *D) TryFieldScalarF80LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(80, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF80LE adds a field and reads 80 bit IEEE 754 float in little-endian
@@ -18481,13 +21317,20 @@ func (d *D) FieldScalarF80LE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF80LE tries to add a field and read 80 bit IEEE 754 float in little-endian
 func (d *D) TryFieldF80LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF80LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(LittleEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(80, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF80LE adds a field and reads 80 bit IEEE 754 float in little-endian
 func (d *D) FieldF80LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF80LE(name, sms...).Actual
+	s, err := d.TryFieldF80LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F80LE")
+	}
+	return s
 }
 
 // Reader F16BE
@@ -18506,14 +21349,14 @@ func (d *D) F16BE() float64 {
 
 // TryFieldScalarF16BE tries to add a field and read 16 bit IEEE 754 float in big-endian
 func (d *D) TryFieldScalarF16BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(16, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF16BE adds a field and reads 16 bit IEEE 754 float in big-endian
@@ -18527,13 +21370,20 @@ func (d *D) FieldScalarF16BE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF16BE tries to add a field and read 16 bit IEEE 754 float in big-endian
 func (d *D) TryFieldF16BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF16BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(16, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF16BE adds a field and reads 16 bit IEEE 754 float in big-endian
 func (d *D) FieldF16BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF16BE(name, sms...).Actual
+	s, err := d.TryFieldF16BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F16BE")
+	}
+	return s
 }
 
 // Reader F32BE
@@ -18552,14 +21402,14 @@ func (d *D) F32BE() float64 {
 
 // TryFieldScalarF32BE tries to add a field and read 32 bit IEEE 754 float in big-endian
 func (d *D) TryFieldScalarF32BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(32, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF32BE adds a field and reads 32 bit IEEE 754 float in big-endian
@@ -18573,13 +21423,20 @@ func (d *D) FieldScalarF32BE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF32BE tries to add a field and read 32 bit IEEE 754 float in big-endian
 func (d *D) TryFieldF32BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF32BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(32, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF32BE adds a field and reads 32 bit IEEE 754 float in big-endian
 func (d *D) FieldF32BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF32BE(name, sms...).Actual
+	s, err := d.TryFieldF32BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F32BE")
+	}
+	return s
 }
 
 // Reader F64BE
@@ -18598,14 +21455,14 @@ func (d *D) F64BE() float64 {
 
 // TryFieldScalarF64BE tries to add a field and read 64 bit IEEE 754 float in big-endian
 func (d *D) TryFieldScalarF64BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(64, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF64BE adds a field and reads 64 bit IEEE 754 float in big-endian
@@ -18619,13 +21476,20 @@ func (d *D) FieldScalarF64BE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF64BE tries to add a field and read 64 bit IEEE 754 float in big-endian
 func (d *D) TryFieldF64BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF64BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(64, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF64BE adds a field and reads 64 bit IEEE 754 float in big-endian
 func (d *D) FieldF64BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF64BE(name, sms...).Actual
+	s, err := d.TryFieldF64BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F64BE")
+	}
+	return s
 }
 
 // Reader F80BE
@@ -18644,14 +21508,14 @@ func (d *D) F80BE() float64 {
 
 // TryFieldScalarF80BE tries to add a field and read 80 bit IEEE 754 float in big-endian
 func (d *D) TryFieldScalarF80BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFEndian(80, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarF80BE adds a field and reads 80 bit IEEE 754 float in big-endian
@@ -18665,13 +21529,20 @@ func (d *D) FieldScalarF80BE(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldF80BE tries to add a field and read 80 bit IEEE 754 float in big-endian
 func (d *D) TryFieldF80BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarF80BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, fltEncoding(BigEndian), func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFEndian(80, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldF80BE adds a field and reads 80 bit IEEE 754 float in big-endian
 func (d *D) FieldF80BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarF80BE(name, sms...).Actual
+	s, err := d.TryFieldF80BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "F80BE")
+	}
+	return s
 }
 
 // Reader FP
@@ -18692,14 +21563,14 @@ func (d *D) FP(nBits int, fBits int) float64 {
 
 // TryFieldScalarFP tries to add a field and read nBits fixed-point number in current endian
 func (d *D) TryFieldScalarFP(name string, nBits int, fBits int, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(nBits, fBits, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP adds a field and reads nBits fixed-point number in current endian
@@ -18713,13 +21584,20 @@ func (d *D) FieldScalarFP(name string, nBits int, fBits int, sms ...scalar.FltMa
 
 // TryFieldFP tries to add a field and read nBits fixed-point number in current endian
 func (d *D) TryFieldFP(name string, nBits int, fBits int, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP(name, nBits, fBits, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(nBits, fBits, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP adds a field and reads nBits fixed-point number in current endian
 func (d *D) FieldFP(name string, nBits int, fBits int, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP(name, nBits, fBits, sms...).Actual
+	s, err := d.TryFieldFP(name, nBits, fBits, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP")
+	}
+	return s
 }
 
 // Reader FPE
@@ -18740,14 +21618,14 @@ func (d *D) FPE(nBits int, fBits int, endian Endian) float64 {
 
 // TryFieldScalarFPE tries to add a field and read nBits fixed-point number in specified endian
 func (d *D) TryFieldScalarFPE(name string, nBits int, fBits int, endian Endian, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(nBits, fBits, endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFPE adds a field and reads nBits fixed-point number in specified endian
@@ -18761,13 +21639,20 @@ func (d *D) FieldScalarFPE(name string, nBits int, fBits int, endian Endian, sms
 
 // TryFieldFPE tries to add a field and read nBits fixed-point number in specified endian
 func (d *D) TryFieldFPE(name string, nBits int, fBits int, endian Endian, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFPE(name, nBits, fBits, endian, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(nBits, fBits, endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFPE adds a field and reads nBits fixed-point number in specified endian
 func (d *D) FieldFPE(name string, nBits int, fBits int, endian Endian, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFPE(name, nBits, fBits, endian, sms...).Actual
+	s, err := d.TryFieldFPE(name, nBits, fBits, endian, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FPE")
+	}
+	return s
 }
 
 // Reader FP16
@@ -18786,14 +21671,14 @@ func (d *D) FP16() float64 {
 
 // TryFieldScalarFP16 tries to add a field and read 16 bit fixed-point number in current endian
 func (d *D) TryFieldScalarFP16(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(16, 8, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP16 adds a field and reads 16 bit fixed-point number in current endian
@@ -18807,13 +21692,20 @@ func (d *D) FieldScalarFP16(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldFP16 tries to add a field and read 16 bit fixed-point number in current endian
 func (d *D) TryFieldFP16(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP16(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(16, 8, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP16 adds a field and reads 16 bit fixed-point number in current endian
 func (d *D) FieldFP16(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP16(name, sms...).Actual
+	s, err := d.TryFieldFP16(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP16")
+	}
+	return s
 }
 
 // Reader FP32
@@ -18832,14 +21724,14 @@ func (d *D) FP32() float64 {
 
 // TryFieldScalarFP32 tries to add a field and read 32 bit fixed-point number in current endian
 func (d *D) TryFieldScalarFP32(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(32, 16, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP32 adds a field and reads 32 bit fixed-point number in current endian
@@ -18853,13 +21745,20 @@ func (d *D) FieldScalarFP32(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldFP32 tries to add a field and read 32 bit fixed-point number in current endian
 func (d *D) TryFieldFP32(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP32(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(32, 16, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP32 adds a field and reads 32 bit fixed-point number in current endian
 func (d *D) FieldFP32(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP32(name, sms...).Actual
+	s, err := d.TryFieldFP32(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP32")
+	}
+	return s
 }
 
 // Reader FP64
@@ -18878,14 +21777,14 @@ func (d *D) FP64() float64 {
 
 // TryFieldScalarFP64 tries to add a field and read 64 bit fixed-point number in current endian
 func (d *D) TryFieldScalarFP64(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(64, 32, d.Endian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP64 adds a field and reads 64 bit fixed-point number in current endian
@@ -18899,13 +21798,20 @@ func (d *D) FieldScalarFP64(name string, sms ...scalar.FltMapper) *scalar.Flt {
 
 // TryFieldFP64 tries to add a field and read 64 bit fixed-point number in current endian
 func (d *D) TryFieldFP64(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP64(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(64, 32, d.Endian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP64 adds a field and reads 64 bit fixed-point number in current endian
 func (d *D) FieldFP64(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP64(name, sms...).Actual
+	s, err := d.TryFieldFP64(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP64")
+	}
+	return s
 }
 
 // Reader FP16LE
@@ -18924,14 +21830,14 @@ func (d *D) FP16LE() float64 {
 
 // TryFieldScalarFP16LE tries to add a field and read 16 bit fixed-point number in little-endian
 func (d *D) TryFieldScalarFP16LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(16, 8, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP16LE adds a field and reads 16 bit fixed-point number in little-endian
@@ -18945,13 +21851,20 @@ func (d *D) FieldScalarFP16LE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP16LE tries to add a field and read 16 bit fixed-point number in little-endian
 func (d *D) TryFieldFP16LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP16LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(16, 8, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP16LE adds a field and reads 16 bit fixed-point number in little-endian
 func (d *D) FieldFP16LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP16LE(name, sms...).Actual
+	s, err := d.TryFieldFP16LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP16LE")
+	}
+	return s
 }
 
 // Reader FP32LE
@@ -18970,14 +21883,14 @@ func (d *D) FP32LE() float64 {
 
 // TryFieldScalarFP32LE tries to add a field and read 32 bit fixed-point number in little-endian
 func (d *D) TryFieldScalarFP32LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(32, 16, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP32LE adds a field and reads 32 bit fixed-point number in little-endian
@@ -18991,13 +21904,20 @@ func (d *D) FieldScalarFP32LE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP32LE tries to add a field and read 32 bit fixed-point number in little-endian
 func (d *D) TryFieldFP32LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP32LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(32, 16, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP32LE adds a field and reads 32 bit fixed-point number in little-endian
 func (d *D) FieldFP32LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP32LE(name, sms...).Actual
+	s, err := d.TryFieldFP32LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP32LE")
+	}
+	return s
 }
 
 // Reader FP64LE
@@ -19016,14 +21936,14 @@ func (d *D) FP64LE() float64 {
 
 // TryFieldScalarFP64LE tries to add a field and read 64 bit fixed-point number in little-endian
 func (d *D) TryFieldScalarFP64LE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(64, 32, LittleEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP64LE adds a field and reads 64 bit fixed-point number in little-endian
@@ -19037,13 +21957,20 @@ func (d *D) FieldScalarFP64LE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP64LE tries to add a field and read 64 bit fixed-point number in little-endian
 func (d *D) TryFieldFP64LE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP64LE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(64, 32, LittleEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP64LE adds a field and reads 64 bit fixed-point number in little-endian
 func (d *D) FieldFP64LE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP64LE(name, sms...).Actual
+	s, err := d.TryFieldFP64LE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP64LE")
+	}
+	return s
 }
 
 // Reader FP16BE
@@ -19062,14 +21989,14 @@ func (d *D) FP16BE() float64 {
 
 // TryFieldScalarFP16BE tries to add a field and read 16 bit fixed-point number in big-endian
 func (d *D) TryFieldScalarFP16BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(16, 8, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP16BE adds a field and reads 16 bit fixed-point number in big-endian
@@ -19083,13 +22010,20 @@ func (d *D) FieldScalarFP16BE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP16BE tries to add a field and read 16 bit fixed-point number in big-endian
 func (d *D) TryFieldFP16BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP16BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(16, 8, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP16BE adds a field and reads 16 bit fixed-point number in big-endian
 func (d *D) FieldFP16BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP16BE(name, sms...).Actual
+	s, err := d.TryFieldFP16BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP16BE")
+	}
+	return s
 }
 
 // Reader FP32BE
@@ -19108,14 +22042,14 @@ func (d *D) FP32BE() float64 {
 
 // TryFieldScalarFP32BE tries to add a field and read 32 bit fixed-point number in big-endian
 func (d *D) TryFieldScalarFP32BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(32, 16, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP32BE adds a field and reads 32 bit fixed-point number in big-endian
@@ -19129,13 +22063,20 @@ func (d *D) FieldScalarFP32BE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP32BE tries to add a field and read 32 bit fixed-point number in big-endian
 func (d *D) TryFieldFP32BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP32BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(32, 16, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP32BE adds a field and reads 32 bit fixed-point number in big-endian
 func (d *D) FieldFP32BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP32BE(name, sms...).Actual
+	s, err := d.TryFieldFP32BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP32BE")
+	}
+	return s
 }
 
 // Reader FP64BE
@@ -19154,14 +22095,14 @@ func (d *D) FP64BE() float64 {
 
 // TryFieldScalarFP64BE tries to add a field and read 64 bit fixed-point number in big-endian
 func (d *D) TryFieldScalarFP64BE(name string, sms ...scalar.FltMapper) (*scalar.Flt, error) {
-	s, err := d.TryFieldScalarFltFn(name, func(d *D) (scalar.Flt, error) {
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
 		v, err := d.tryFPEndian(64, 32, BigEndian)
 		return scalar.Flt{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarFP64BE adds a field and reads 64 bit fixed-point number in big-endian
@@ -19175,13 +22116,20 @@ func (d *D) FieldScalarFP64BE(name string, sms ...scalar.FltMapper) *scalar.Flt
 
 // TryFieldFP64BE tries to add a field and read 64 bit fixed-point number in big-endian
 func (d *D) TryFieldFP64BE(name string, sms ...scalar.FltMapper) (float64, error) {
-	s, err := d.TryFieldScalarFP64BE(name, sms...)
+	s, err := d.tryFieldScalarFltFn(name, nil, func(d *D) (scalar.Flt, error) {
+		v, err := d.tryFPEndian(64, 32, BigEndian)
+		return scalar.Flt{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldFP64BE adds a field and reads 64 bit fixed-point number in big-endian
 func (d *D) FieldFP64BE(name string, sms ...scalar.FltMapper) float64 {
-	return d.FieldScalarFP64BE(name, sms...).Actual
+	s, err := d.TryFieldFP64BE(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "FP64BE")
+	}
+	return s
 }
 
 // Reader Unary
@@ -19200,14 +22148,14 @@ func (d *D) Unary(ov uint64) uint64 {
 
 // TryFieldScalarUnary tries to add a field and read unary integer using ov as "one" value
 func (d *D) TryFieldScalarUnary(name string, ov uint64, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, nil, func(d *D) (scalar.Uint, error) {
 		v, err := d.tryUnary(ov)
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUnary adds a field and reads unary integer using ov as "one" value
@@ -19221,13 +22169,20 @@ func (d *D) FieldScalarUnary(name string, ov uint64, sms ...scalar.UintMapper) *
 
 // TryFieldUnary tries to add a field and read unary integer using ov as "one" value
 func (d *D) TryFieldUnary(name string, ov uint64, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarUnary(name, ov, sms...)
+	s, err := d.tryFieldScalarUintFn(name, nil, func(d *D) (scalar.Uint, error) {
+		v, err := d.tryUnary(ov)
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUnary adds a field and reads unary integer using ov as "one" value
 func (d *D) FieldUnary(name string, ov uint64, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarUnary(name, ov, sms...).Actual
+	s, err := d.TryFieldUnary(name, ov, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "Unary")
+	}
+	return s
 }
 
 // Reader ULEB128
@@ -19246,14 +22201,14 @@ func (d *D) ULEB128() uint64 {
 
 // TryFieldScalarULEB128 tries to add a field and read unsigned LEB128 integer
 func (d *D) TryFieldScalarULEB128(name string, sms ...scalar.UintMapper) (*scalar.Uint, error) {
-	s, err := d.TryFieldScalarUintFn(name, func(d *D) (scalar.Uint, error) {
+	s, err := d.tryFieldScalarUintFn(name, nil, func(d *D) (scalar.Uint, error) {
 		v, err := d.tryULEB128()
 		return scalar.Uint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarULEB128 adds a field and reads unsigned LEB128 integer
@@ -19267,13 +22222,20 @@ func (d *D) FieldScalarULEB128(name string, sms ...scalar.UintMapper) *scalar.Ui
 
 // TryFieldULEB128 tries to add a field and read unsigned LEB128 integer
 func (d *D) TryFieldULEB128(name string, sms ...scalar.UintMapper) (uint64, error) {
-	s, err := d.TryFieldScalarULEB128(name, sms...)
+	s, err := d.tryFieldScalarUintFn(name, nil, func(d *D) (scalar.Uint, error) {
+		v, err := d.tryULEB128()
+		return scalar.Uint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldULEB128 adds a field and reads unsigned LEB128 integer
 func (d *D) FieldULEB128(name string, sms ...scalar.UintMapper) uint64 {
-	return d.FieldScalarULEB128(name, sms...).Actual
+	s, err := d.TryFieldULEB128(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "ULEB128")
+	}
+	return s
 }
 
 // Reader SLEB128
@@ -19292,14 +22254,14 @@ func (d *D) SLEB128() int64 {
 
 // TryFieldScalarSLEB128 tries to add a field and read signed LEB128 integer
 func (d *D) TryFieldScalarSLEB128(name string, sms ...scalar.SintMapper) (*scalar.Sint, error) {
-	s, err := d.TryFieldScalarSintFn(name, func(d *D) (scalar.Sint, error) {
+	s, err := d.tryFieldScalarSintFn(name, nil, func(d *D) (scalar.Sint, error) {
 		v, err := d.trySLEB128()
 		return scalar.Sint{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarSLEB128 adds a field and reads signed LEB128 integer
@@ -19313,13 +22275,20 @@ func (d *D) FieldScalarSLEB128(name string, sms ...scalar.SintMapper) *scalar.Si
 
 // TryFieldSLEB128 tries to add a field and read signed LEB128 integer
 func (d *D) TryFieldSLEB128(name string, sms ...scalar.SintMapper) (int64, error) {
-	s, err := d.TryFieldScalarSLEB128(name, sms...)
+	s, err := d.tryFieldScalarSintFn(name, nil, func(d *D) (scalar.Sint, error) {
+		v, err := d.trySLEB128()
+		return scalar.Sint{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldSLEB128 adds a field and reads signed LEB128 integer
 func (d *D) FieldSLEB128(name string, sms ...scalar.SintMapper) int64 {
-	return d.FieldScalarSLEB128(name, sms...).Actual
+	s, err := d.TryFieldSLEB128(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "SLEB128")
+	}
+	return s
 }
 
 // Reader UTF8
@@ -19338,14 +22307,14 @@ func (d *D) UTF8(nBytes int) string {
 
 // TryFieldScalarUTF8 tries to add a field and read nBytes bytes UTF8 string
 func (d *D) TryFieldScalarUTF8(name string, nBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF8BOM), func(d *D) (scalar.Str, error) {
 		v, err := d.tryText(nBytes, UTF8BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF8 adds a field and reads nBytes bytes UTF8 string
@@ -19359,13 +22328,20 @@ func (d *D) FieldScalarUTF8(name string, nBytes int, sms ...scalar.StrMapper) *s
 
 // TryFieldUTF8 tries to add a field and read nBytes bytes UTF8 string
 func (d *D) TryFieldUTF8(name string, nBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF8(name, nBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF8BOM), func(d *D) (scalar.Str, error) {
+		v, err := d.tryText(nBytes, UTF8BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF8 adds a field and reads nBytes bytes UTF8 string
 func (d *D) FieldUTF8(name string, nBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF8(name, nBytes, sms...).Actual
+	s, err := d.TryFieldUTF8(name, nBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF8")
+	}
+	return s
 }
 
 // Reader UTF16
@@ -19384,14 +22360,14 @@ func (d *D) UTF16(nBytes int) string {
 
 // TryFieldScalarUTF16 tries to add a field and read nBytes bytes UTF16 string, default big-endian and accepts BOM
 func (d *D) TryFieldScalarUTF16(name string, nBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryText(nBytes, UTF16BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16 adds a field and reads nBytes bytes UTF16 string, default big-endian and accepts BOM
@@ -19405,13 +22381,20 @@ func (d *D) FieldScalarUTF16(name string, nBytes int, sms ...scalar.StrMapper) *
 
 // TryFieldUTF16 tries to add a field and read nBytes bytes UTF16 string, default big-endian and accepts BOM
 func (d *D) TryFieldUTF16(name string, nBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16(name, nBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryText(nBytes, UTF16BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16 adds a field and reads nBytes bytes UTF16 string, default big-endian and accepts BOM
 func (d *D) FieldUTF16(name string, nBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16(name, nBytes, sms...).Actual
+	s, err := d.TryFieldUTF16(name, nBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16")
+	}
+	return s
 }
 
 // Reader UTF16LE
@@ -19430,14 +22413,14 @@ func (d *D) UTF16LE(nBytes int) string {
 
 // TryFieldScalarUTF16LE tries to add a field and read nBytes bytes UTF16 little-endian string
 func (d *D) TryFieldScalarUTF16LE(name string, nBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF16LE), func(d *D) (scalar.Str, error) {
 		v, err := d.tryText(nBytes, UTF16LE)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16LE adds a field and reads nBytes bytes UTF16 little-endian string
@@ -19451,13 +22434,20 @@ func (d *D) FieldScalarUTF16LE(name string, nBytes int, sms ...scalar.StrMapper)
 
 // TryFieldUTF16LE tries to add a field and read nBytes bytes UTF16 little-endian string
 func (d *D) TryFieldUTF16LE(name string, nBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16LE(name, nBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF16LE), func(d *D) (scalar.Str, error) {
+		v, err := d.tryText(nBytes, UTF16LE)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16LE adds a field and reads nBytes bytes UTF16 little-endian string
 func (d *D) FieldUTF16LE(name string, nBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16LE(name, nBytes, sms...).Actual
+	s, err := d.TryFieldUTF16LE(name, nBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16LE")
+	}
+	return s
 }
 
 // Reader UTF16BE
@@ -19476,14 +22466,14 @@ func (d *D) UTF16BE(nBytes int) string {
 
 // TryFieldScalarUTF16BE tries to add a field and read nBytes bytes UTF16 big-endian string
 func (d *D) TryFieldScalarUTF16BE(name string, nBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF16BE), func(d *D) (scalar.Str, error) {
 		v, err := d.tryText(nBytes, UTF16BE)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16BE adds a field and reads nBytes bytes UTF16 big-endian string
@@ -19497,13 +22487,20 @@ func (d *D) FieldScalarUTF16BE(name string, nBytes int, sms ...scalar.StrMapper)
 
 // TryFieldUTF16BE tries to add a field and read nBytes bytes UTF16 big-endian string
 func (d *D) TryFieldUTF16BE(name string, nBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16BE(name, nBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF16BE), func(d *D) (scalar.Str, error) {
+		v, err := d.tryText(nBytes, UTF16BE)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16BE adds a field and reads nBytes bytes UTF16 big-endian string
 func (d *D) FieldUTF16BE(name string, nBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16BE(name, nBytes, sms...).Actual
+	s, err := d.TryFieldUTF16BE(name, nBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16BE")
+	}
+	return s
 }
 
 // Reader UTF8ShortString
@@ -19522,14 +22519,14 @@ func (d *D) UTF8ShortString() string {
 
 // TryFieldScalarUTF8ShortString tries to add a field and read one byte length fixed UTF8 string
 func (d *D) TryFieldScalarUTF8ShortString(name string, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextLenPrefixed(1, -1, UTF8BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF8ShortString adds a field and reads one byte length fixed UTF8 string
@@ -19543,13 +22540,20 @@ func (d *D) FieldScalarUTF8ShortString(name string, sms ...scalar.StrMapper) *sc
 
 // TryFieldUTF8ShortString tries to add a field and read one byte length fixed UTF8 string
 func (d *D) TryFieldUTF8ShortString(name string, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF8ShortString(name, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextLenPrefixed(1, -1, UTF8BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF8ShortString adds a field and reads one byte length fixed UTF8 string
 func (d *D) FieldUTF8ShortString(name string, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF8ShortString(name, sms...).Actual
+	s, err := d.TryFieldUTF8ShortString(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF8ShortString")
+	}
+	return s
 }
 
 // Reader UTF8ShortStringFixedLen
@@ -19570,14 +22574,14 @@ func (d *D) UTF8ShortStringFixedLen(fixedBytes int) string {
 
 // TryFieldScalarUTF8ShortStringFixedLen tries to add a field and read fixedBytes bytes long one byte length prefixed UTF8 string
 func (d *D) TryFieldScalarUTF8ShortStringFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextLenPrefixed(1, fixedBytes, UTF8BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF8ShortStringFixedLen adds a field and reads fixedBytes bytes long one byte length prefixed UTF8 string
@@ -19591,13 +22595,20 @@ func (d *D) FieldScalarUTF8ShortStringFixedLen(name string, fixedBytes int, sms
 
 // TryFieldUTF8ShortStringFixedLen tries to add a field and read fixedBytes bytes long one byte length prefixed UTF8 string
 func (d *D) TryFieldUTF8ShortStringFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF8ShortStringFixedLen(name, fixedBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextLenPrefixed(1, fixedBytes, UTF8BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF8ShortStringFixedLen adds a field and reads fixedBytes bytes long one byte length prefixed UTF8 string
 func (d *D) FieldUTF8ShortStringFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF8ShortStringFixedLen(name, fixedBytes, sms...).Actual
+	s, err := d.TryFieldUTF8ShortStringFixedLen(name, fixedBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF8ShortStringFixedLen")
+	}
+	return s
 }
 
 // Reader UTF8Null
@@ -19616,14 +22627,14 @@ func (d *D) UTF8Null() string {
 
 // TryFieldScalarUTF8Null tries to add a field and read null terminated UTF8 string
 func (d *D) TryFieldScalarUTF8Null(name string, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextNull(1, UTF8BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF8Null adds a field and reads null terminated UTF8 string
@@ -19637,13 +22648,20 @@ func (d *D) FieldScalarUTF8Null(name string, sms ...scalar.StrMapper) *scalar.St
 
 // TryFieldUTF8Null tries to add a field and read null terminated UTF8 string
 func (d *D) TryFieldUTF8Null(name string, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF8Null(name, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextNull(1, UTF8BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF8Null adds a field and reads null terminated UTF8 string
 func (d *D) FieldUTF8Null(name string, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF8Null(name, sms...).Actual
+	s, err := d.TryFieldUTF8Null(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF8Null")
+	}
+	return s
 }
 
 // Reader UTF16Null
@@ -19662,14 +22680,14 @@ func (d *D) UTF16Null() string {
 
 // TryFieldScalarUTF16Null tries to add a field and read null terminated UTF16 string, default big-endian and accepts BOM
 func (d *D) TryFieldScalarUTF16Null(name string, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextNull(2, UTF16BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16Null adds a field and reads null terminated UTF16 string, default big-endian and accepts BOM
@@ -19683,13 +22701,20 @@ func (d *D) FieldScalarUTF16Null(name string, sms ...scalar.StrMapper) *scalar.S
 
 // TryFieldUTF16Null tries to add a field and read null terminated UTF16 string, default big-endian and accepts BOM
 func (d *D) TryFieldUTF16Null(name string, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16Null(name, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextNull(2, UTF16BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16Null adds a field and reads null terminated UTF16 string, default big-endian and accepts BOM
 func (d *D) FieldUTF16Null(name string, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16Null(name, sms...).Actual
+	s, err := d.TryFieldUTF16Null(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16Null")
+	}
+	return s
 }
 
 // Reader UTF16LENull
@@ -19708,14 +22733,14 @@ func (d *D) UTF16LENull() string {
 
 // TryFieldScalarUTF16LENull tries to add a field and read null terminated UTF16LE string
 func (d *D) TryFieldScalarUTF16LENull(name string, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextNull(2, UTF16LE)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16LENull adds a field and reads null terminated UTF16LE string
@@ -19729,13 +22754,20 @@ func (d *D) FieldScalarUTF16LENull(name string, sms ...scalar.StrMapper) *scalar
 
 // TryFieldUTF16LENull tries to add a field and read null terminated UTF16LE string
 func (d *D) TryFieldUTF16LENull(name string, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16LENull(name, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextNull(2, UTF16LE)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16LENull adds a field and reads null terminated UTF16LE string
 func (d *D) FieldUTF16LENull(name string, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16LENull(name, sms...).Actual
+	s, err := d.TryFieldUTF16LENull(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16LENull")
+	}
+	return s
 }
 
 // Reader UTF16BENull
@@ -19754,14 +22786,14 @@ func (d *D) UTF16BENull() string {
 
 // TryFieldScalarUTF16BENull tries to add a field and read null terminated UTF16BE string
 func (d *D) TryFieldScalarUTF16BENull(name string, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextNull(2, UTF16BE)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF16BENull adds a field and reads null terminated UTF16BE string
@@ -19775,13 +22807,20 @@ func (d *D) FieldScalarUTF16BENull(name string, sms ...scalar.StrMapper) *scalar
 
 // TryFieldUTF16BENull tries to add a field and read null terminated UTF16BE string
 func (d *D) TryFieldUTF16BENull(name string, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF16BENull(name, sms...)
+	s, err := d.tryFieldScalarStrFn(name, nil, func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextNull(2, UTF16BE)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF16BENull adds a field and reads null terminated UTF16BE string
 func (d *D) FieldUTF16BENull(name string, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF16BENull(name, sms...).Actual
+	s, err := d.TryFieldUTF16BENull(name, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF16BENull")
+	}
+	return s
 }
 
 // Reader UTF8NullFixedLen
@@ -19802,14 +22841,14 @@ func (d *D) UTF8NullFixedLen(fixedBytes int) string {
 
 // TryFieldScalarUTF8NullFixedLen tries to add a field and read fixedBytes bytes long null terminated UTF8 string
 func (d *D) TryFieldScalarUTF8NullFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF8BOM), func(d *D) (scalar.Str, error) {
 		v, err := d.tryTextNullLen(fixedBytes, UTF8BOM)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarUTF8NullFixedLen adds a field and reads fixedBytes bytes long null terminated UTF8 string
@@ -19823,13 +22862,20 @@ func (d *D) FieldScalarUTF8NullFixedLen(name string, fixedBytes int, sms ...scal
 
 // TryFieldUTF8NullFixedLen tries to add a field and read fixedBytes bytes long null terminated UTF8 string
 func (d *D) TryFieldUTF8NullFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarUTF8NullFixedLen(name, fixedBytes, sms...)
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(UTF8BOM), func(d *D) (scalar.Str, error) {
+		v, err := d.tryTextNullLen(fixedBytes, UTF8BOM)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldUTF8NullFixedLen adds a field and reads fixedBytes bytes long null terminated UTF8 string
 func (d *D) FieldUTF8NullFixedLen(name string, fixedBytes int, sms ...scalar.StrMapper) string {
-	return d.FieldScalarUTF8NullFixedLen(name, fixedBytes, sms...).Actual
+	s, err := d.TryFieldUTF8NullFixedLen(name, fixedBytes, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "UTF8NullFixedLen")
+	}
+	return s
 }
 
 // Reader Str
@@ -19848,14 +22894,14 @@ func (d *D) Str(nBytes int, e encoding.Encoding) string {
 
 // TryFieldScalarStr tries to add a field and read nBytes bytes using encoding e
 func (d *D) TryFieldScalarStr(name string, nBytes int, e encoding.Encoding, sms ...scalar.StrMapper) (*scalar.Str, error) {
-	s, err := d.TryFieldScalarStrFn(name, func(d *D) (scalar.Str, error) {
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(e), func(d *D) (scalar.Str, error) {
 		v, err := d.tryText(nBytes, e)
 		return scalar.Str{Actual: v}, err
 	}, sms...)
 	if err != nil {
 		return nil, err
 	}
-	return s, err
+	return &s, err
 }
 
 // FieldScalarStr adds a field and reads nBytes bytes using encoding e
@@ -19869,11 +22915,18 @@ func (d *D) FieldScalarStr(name string, nBytes int, e encoding.Encoding, sms ...
 
 // TryFieldStr tries to add a field and read nBytes bytes using encoding e
 func (d *D) TryFieldStr(name string, nBytes int, e encoding.Encoding, sms ...scalar.StrMapper) (string, error) {
-	s, err := d.TryFieldScalarStr(name, nBytes, e, sms...)
+	s, err := d.tryFieldScalarStrFn(name, textEncoding(e), func(d *D) (scalar.Str, error) {
+		v, err := d.tryText(nBytes, e)
+		return scalar.Str{Actual: v}, err
+	}, sms...)
 	return s.Actual, err
 }
 
 // FieldStr adds a field and reads nBytes bytes using encoding e
 func (d *D) FieldStr(name string, nBytes int, e encoding.Encoding, sms ...scalar.StrMapper) string {
-	return d.FieldScalarStr(name, nBytes, e, sms...).Actual
+	s, err := d.TryFieldStr(name, nBytes, e, sms...)
+	if err != nil {
+		d.IOPanic(err, name, "Str")
+	}
+	return s
 }
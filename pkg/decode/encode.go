@@ -0,0 +1,305 @@
+package decode
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/internal/mathx"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/scalar"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+)
+
+type EncodingKind int
+
+const (
+	// EncodingUint fixed bit width unsigned integer
+	EncodingUint EncodingKind = iota + 1
+	// EncodingSint fixed bit width two's complement signed integer
+	EncodingSint
+	// EncodingFlt IEEE 754 float
+	EncodingFlt
+	// EncodingBool one bit boolean
+	EncodingBool
+	// EncodingRaw raw bits
+	EncodingRaw
+	// EncodingText fixed byte length text, zero padded if shorter
+	EncodingText
+)
+
+// Encoding describes how a scalar value was read so that a new value can be
+// written back using the same bit width, endian and text encoding.
+// Values read with variable length readers, ex: LEB128 or null terminated
+// strings, has no encoding.
+type Encoding struct {
+	Kind   EncodingKind
+	Endian Endian
+	Text   encoding.Encoding
+}
+
+// shared instances to not have to allocate per value
+var (
+	encodingUintBE   = &Encoding{Kind: EncodingUint, Endian: BigEndian}
+	encodingUintLE   = &Encoding{Kind: EncodingUint, Endian: LittleEndian}
+	encodingSintBE   = &Encoding{Kind: EncodingSint, Endian: BigEndian}
+	encodingSintLE   = &Encoding{Kind: EncodingSint, Endian: LittleEndian}
+	encodingFltBE    = &Encoding{Kind: EncodingFlt, Endian: BigEndian}
+	encodingFltLE    = &Encoding{Kind: EncodingFlt, Endian: LittleEndian}
+	encodingBool     = &Encoding{Kind: EncodingBool}
+	encodingRaw      = &Encoding{Kind: EncodingRaw}
+	encodingTextUTF8 = &Encoding{Kind: EncodingText, Text: unicode.UTF8}
+	encodingUTF16LE  = &Encoding{Kind: EncodingText, Text: UTF16LE}
+	encodingUTF16BE  = &Encoding{Kind: EncodingText, Text: UTF16BE}
+)
+
+func uintEncoding(endian Endian) *Encoding {
+	if endian == LittleEndian {
+		return encodingUintLE
+	}
+	return encodingUintBE
+}
+
+func sintEncoding(endian Endian) *Encoding {
+	if endian == LittleEndian {
+		return encodingSintLE
+	}
+	return encodingSintBE
+}
+
+func fltEncoding(endian Endian) *Encoding {
+	if endian == LittleEndian {
+		return encodingFltLE
+	}
+	return encodingFltBE
+}
+
+func textEncoding(e encoding.Encoding) *Encoding {
+	switch e {
+	case UTF8BOM:
+		// encode without BOM
+		return encodingTextUTF8
+	case UTF16LE:
+		return encodingUTF16LE
+	case UTF16BE:
+		return encodingUTF16BE
+	case UTF16BOM:
+		// can't know if a BOM should be written or not
+		return nil
+	default:
+		return &Encoding{Kind: EncodingText, Text: e}
+	}
+}
+
+func encodeBigInt(a any) (*big.Int, error) {
+	switch a := a.(type) {
+	case int:
+		return big.NewInt(int64(a)), nil
+	case int64:
+		return big.NewInt(a), nil
+	case uint64:
+		return new(big.Int).SetUint64(a), nil
+	case float64:
+		if a != math.Trunc(a) || math.IsInf(a, 0) {
+			return nil, fmt.Errorf("expected an integer but got %v", a)
+		}
+		n, _ := big.NewFloat(a).Int(nil)
+		return n, nil
+	case *big.Int:
+		return a, nil
+	default:
+		return nil, fmt.Errorf("expected an integer but got %T", a)
+	}
+}
+
+func encodeFloat(a any) (float64, error) {
+	switch a := a.(type) {
+	case int:
+		return float64(a), nil
+	case int64:
+		return float64(a), nil
+	case uint64:
+		return float64(a), nil
+	case float64:
+		return a, nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(a).Float64()
+		return f, nil
+	default:
+		return 0, fmt.Errorf("expected a number but got %T", a)
+	}
+}
+
+// Encode encodes a using the same encoding as the value was decoded with.
+// Returns a buffer with the encoded bits, most significant bit first, of the
+// same bit length as the value range.
+func (v *Value) Encode(a any) ([]byte, error) {
+	e := v.Encoding
+	if e == nil {
+		return nil, fmt.Errorf("%s: value has no known encoding", v.Name)
+	}
+
+	nBits := v.Range.Len
+	buf := make([]byte, bitio.BitsByteCount(nBits))
+
+	switch e.Kind {
+	case EncodingUint, EncodingSint:
+		if nBits > 64 {
+			return nil, fmt.Errorf("%s: integer of %d bits not supported", v.Name, nBits)
+		}
+		n, err := encodeBigInt(a)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.Name, err)
+		}
+		lo := big.NewInt(0)
+		hi := new(big.Int).Lsh(big.NewInt(1), uint(nBits))
+		if e.Kind == EncodingSint {
+			hi.Rsh(hi, 1)
+			lo.Neg(hi)
+		}
+		if n.Cmp(lo) < 0 || n.Cmp(hi) >= 0 {
+			return nil, fmt.Errorf("%s: %s does not fit in %d bits", v.Name, n, nBits)
+		}
+		// two's complement for negative values
+		mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(nBits)), big.NewInt(1))
+		u := new(big.Int).And(n, mask).Uint64()
+		if e.Endian == LittleEndian {
+			u = bitio.ReverseBytes64(int(nBits), u)
+		}
+		bitio.Write64(u, nBits, buf, 0)
+	case EncodingFlt:
+		f, err := encodeFloat(a)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.Name, err)
+		}
+		var u uint64
+		switch nBits {
+		case 16:
+			u = uint64(mathx.NewFloat16(float32(f)))
+		case 32:
+			u = uint64(math.Float32bits(float32(f)))
+		case 64:
+			u = math.Float64bits(f)
+		default:
+			return nil, fmt.Errorf("%s: float of %d bits not supported", v.Name, nBits)
+		}
+		if e.Endian == LittleEndian {
+			u = bitio.ReverseBytes64(int(nBits), u)
+		}
+		bitio.Write64(u, nBits, buf, 0)
+	case EncodingBool:
+		b, ok := a.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a boolean but got %T", v.Name, a)
+		}
+		var u uint64
+		if b {
+			u = 1
+		}
+		bitio.Write64(u, nBits, buf, 0)
+	case EncodingRaw:
+		var b []byte
+		switch a := a.(type) {
+		case []byte:
+			b = a
+		case string:
+			b = []byte(a)
+		default:
+			return nil, fmt.Errorf("%s: expected bytes but got %T", v.Name, a)
+		}
+		if int64(len(b)) != int64(len(buf)) || nBits%8 != 0 {
+			return nil, fmt.Errorf("%s: expected %d bits but got %d bytes", v.Name, nBits, len(b))
+		}
+		copy(buf, b)
+	case EncodingText:
+		s, ok := a.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a string but got %T", v.Name, a)
+		}
+		b, err := e.Text.NewEncoder().Bytes([]byte(s))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.Name, err)
+		}
+		if len(b) > len(buf) {
+			return nil, fmt.Errorf("%s: string is %d bytes, does not fit in %d bytes", v.Name, len(b), len(buf))
+		}
+		copy(buf, b)
+	default:
+		return nil, fmt.Errorf("%s: unknown encoding %d", v.Name, e.Kind)
+	}
+
+	return buf, nil
+}
+
+// patchEqual compares a new value with a decoded actual or symbolic value
+func patchEqual(a any, dv any) bool {
+	switch dv := dv.(type) {
+	case string:
+		s, ok := a.(string)
+		return ok && s == dv
+	case bool:
+		b, ok := a.(bool)
+		return ok && b == dv
+	case int, int64, uint64, *big.Int:
+		an, aErr := encodeBigInt(a)
+		dn, dErr := encodeBigInt(dv)
+		return aErr == nil && dErr == nil && an.Cmp(dn) == 0
+	case float64:
+		f, err := encodeFloat(a)
+		return err == nil && (f == dv || (math.IsNaN(f) && math.IsNaN(dv)))
+	case bitio.ReaderAtSeeker:
+		var b []byte
+		switch a := a.(type) {
+		case []byte:
+			b = a
+		case string:
+			b = []byte(a)
+		default:
+			return false
+		}
+		nBits, err := bitiox.Len(dv)
+		if err != nil || nBits != int64(len(b))*8 {
+			return false
+		}
+		db := make([]byte, len(b))
+		if _, err := bitio.ReadAtFull(dv, db, nBits, 0); err != nil {
+			return false
+		}
+		return bytes.Equal(b, db)
+	}
+	return false
+}
+
+// Patch encodes a and writes it into buf at the range of the value.
+// buf is expected to be the bytes of the value root reader.
+// Patching with the current actual or symbolic value leaves the bytes as is,
+// the value might not be possible to encode, ex: trimmed or converted string.
+func (v *Value) Patch(buf []byte, a any) error {
+	sv, _ := v.V.(scalar.Scalarable)
+	if sv != nil && (patchEqual(a, sv.ScalarActual()) || patchEqual(a, sv.ScalarSym())) {
+		return nil
+	}
+
+	if sv != nil && sv.ScalarFlags().IsSynthetic() {
+		return fmt.Errorf("%s: synthetic value can't be patched", v.Name)
+	}
+
+	b, err := v.Encode(a)
+	if err != nil {
+		if sv != nil && sv.ScalarSym() != nil && v.Encoding != nil {
+			return fmt.Errorf("%w (value has symbolic value %v, patch using an actual value like %v)", err, sv.ScalarSym(), sv.ScalarActual())
+		}
+		return err
+	}
+	if v.Range.Stop() > int64(len(buf))*8 {
+		return fmt.Errorf("%s: range %s outside buffer", v.Name, v.Range)
+	}
+	for off := int64(0); off < v.Range.Len; off += 64 {
+		n := min(64, v.Range.Len-off)
+		bitio.Write64(bitio.Read64(b, off, n), n, buf, v.Range.Start+off)
+	}
+	return nil
+}
@@ -78,6 +78,15 @@ func (e IOError) Unwrap() error { return e.Err }
 
 func (IOError) IsRecoverableError() bool { return true }
 
+// ValidateError is returned by validate mappers, and assert mappers when forced,
+// if the value is not valid. It does not fail the decode, instead the value
+// will have a warning.
+type ValidateError struct {
+	Reason string
+}
+
+func (e ValidateError) Error() string { return e.Reason }
+
 type DecoderError struct {
 	Reason string
 	Pos    int64
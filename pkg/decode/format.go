@@ -23,6 +23,14 @@ type Format struct {
 	Dependencies       []Dependency
 	Functions          []string
 	SkipDecodeFunction bool
+	SkipFromFunction   bool        // skip add from_<name> function, defined elsewhere
+	Signatures         []Signature // used to find possible start offsets when carving
+}
+
+// Signature is a byte pattern found at a byte offset from the start of a format
+type Signature struct {
+	Offset int64
+	Bytes  []byte
 }
 
 func FormatFn(fn func(d *D) any) *Group {
@@ -0,0 +1,107 @@
+package decode
+
+import (
+	"context"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+// Lazy is a placeholder value for a sub format with known range that has not
+// been decoded yet. Use Value.Resolve to decode it.
+type Lazy struct {
+	group *Group
+	opts  Options
+	orRaw bool // on decode error become raw bits without error, same as FieldFormatOrRaw*
+}
+
+// Resolve decodes a lazy value and replaces it in place. Does nothing if the
+// value is not lazy.
+// On decode error the value will be raw bits, and if the value was not added
+// with a FieldFormatOrRaw* function the error is set as Err. If the context is
+// done the value is left lazy and the context error is returned.
+func (v *Value) Resolve() error {
+	l, ok := v.V.(*Lazy)
+	if !ok {
+		return nil
+	}
+
+	ctx := context.Background()
+	if l.opts.LazyCtxFn != nil {
+		ctx = l.opts.LazyCtxFn()
+	}
+	opts := l.opts
+	// value range is absolute in root reader at this point
+	opts.Range = v.Range
+	dv, _, err := decode(ctx, v.RootReader, l.group, opts)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if dv == nil || (l.orRaw && dv.errors(false) != nil) {
+		br, brErr := bitiox.Range(v.RootReader, v.Range.Start, v.Range.Len)
+		if brErr != nil {
+			err = brErr
+			br = nil
+		}
+		v.V = &scalar.BitBuf{Actual: br}
+		v.Encoding = encodingRaw
+		if !l.orRaw || br == nil {
+			v.Err = err
+		}
+		return nil
+	}
+
+	dv.postProcess()
+
+	v.V = dv.V
+	v.Format = dv.Format
+	v.Err = dv.Err
+	if c, ok := dv.V.(*Compound); ok {
+		for _, f := range c.Children {
+			f.Parent = v
+		}
+		for i, w := range c.Warnings {
+			if w.Value == dv {
+				c.Warnings[i].Value = v
+			}
+		}
+	}
+
+	return nil
+}
+
+// tryFieldFormatLazy adds a lazy value if lazy decoding is enabled and range is
+// inside the buffer, otherwise returns nil and caller should decode as usual
+func (d *D) tryFieldFormatLazy(name string, firstBit int64, nBits int64, group *Group, inArg any, orRaw bool) *Value {
+	if !d.Options.Lazy || nBits <= 0 || firstBit < 0 || firstBit+nBits > d.Len() {
+		return nil
+	}
+
+	v := &Value{
+		Name: name,
+		V: &Lazy{
+			group: group,
+			// range is set on resolve
+			opts:  d.subFormatOptions(name, ranges.Range{}, inArg),
+			orRaw: orRaw,
+		},
+		RootReader: d.bitBuf,
+		Range:      ranges.Range{Start: firstBit, Len: nBits},
+	}
+	d.AddChild(v)
+
+	return v
+}
+
+// EagerFn decodes with lazy decoding disabled and with sub formats skipped
+// because of depth or include/exclude options still decoded to get their out
+// value, they are still shown as not decoded. Use when the out value of a
+// FieldFormatLen or FieldFormatRange sub format is needed.
+func (d *D) EagerFn(fn func(d *D)) {
+	lazy, eager := d.Options.Lazy, d.eager
+	d.Options.Lazy = false
+	d.eager = true
+	defer func() { d.Options.Lazy, d.eager = lazy, eager }()
+	fn(d)
+}
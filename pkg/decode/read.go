@@ -262,7 +262,8 @@ func (d *D) tryULEB128() (uint64, error) {
 
 	for {
 		b := d.U8()
-		if shift >= 63 && b != 0 {
+		// last byte can only have bit 63 and no continuation
+		if shift >= 63 && b > 1 {
 			return 0, fmt.Errorf("overflow when reading unsigned leb128, shift %d >= 63", shift)
 		}
 		result |= (b & 0b01111111) << shift
@@ -90,7 +90,7 @@ func assertBitBuf(s scalar.BitBuf, isErr bool, bss ...[]byte) (scalar.BitBuf, er
 	if isErr {
 		return s, errors.New("failed to validate raw")
 	}
-	return s, nil
+	return s, ValidateError{Reason: "failed to validate raw"}
 }
 
 func (d *D) AssertBitBuf(bss ...[]byte) scalar.BitBufMapper {
@@ -141,7 +141,7 @@ func UintAssertBytes(s scalar.Uint, isErr bool, endian Endian, bss ...[]byte) (s
 	if isErr {
 		return s, errors.New("failed to validate raw")
 	}
-	return s, nil
+	return s, ValidateError{Reason: "failed to validate raw"}
 }
 
 func (d *D) UintAssertBytes(bss ...[]byte) scalar.UintMapper {
@@ -0,0 +1,138 @@
+package decode
+
+import (
+	"slices"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+// subFormatOptions returns options to decode a sub format one level deeper
+func (d *D) subFormatOptions(name string, r ranges.Range, inArg any) Options {
+	return Options{
+		Name:           name,
+		Force:          d.Options.Force,
+		Lazy:           d.Options.Lazy,
+		LazyCtxFn:      d.Options.LazyCtxFn,
+		FillGaps:       true,
+		IsRoot:         false,
+		Range:          r,
+		InArg:          inArg,
+		ParseOptsFn:    d.Options.ParseOptsFn,
+		ReadBuf:        d.readBuf,
+		Depth:          d.Options.Depth,
+		IncludeFormats: d.Options.IncludeFormats,
+		ExcludeFormats: d.Options.ExcludeFormats,
+		depth:          d.Options.depth + 1,
+	}
+}
+
+// subFormatGroup returns group with the formats that should be tried for a sub
+// format or nil if it should not be decoded because of depth or
+// include/exclude options
+func (d *D) subFormatGroup(group *Group) *Group {
+	o := d.Options
+	if o.Depth > 0 && o.depth+1 >= o.Depth {
+		return nil
+	}
+	if len(o.IncludeFormats) == 0 && len(o.ExcludeFormats) == 0 {
+		return group
+	}
+
+	var formats []*Format
+	for _, f := range group.Formats {
+		if formatMatches(group, f, o.ExcludeFormats) {
+			continue
+		}
+		if len(o.IncludeFormats) > 0 && !formatMatches(group, f, o.IncludeFormats) {
+			continue
+		}
+		formats = append(formats, f)
+	}
+	switch len(formats) {
+	case 0:
+		return nil
+	case len(group.Formats):
+		return group
+	}
+
+	g := *group
+	g.Formats = formats
+	return &g
+}
+
+// formatMatches returns true if format, group or any group the format is in
+// has one of the names
+func formatMatches(group *Group, f *Format, names []string) bool {
+	if slices.Contains(names, f.Name) || slices.Contains(names, group.Name) {
+		return true
+	}
+	for _, g := range f.Groups {
+		if slices.Contains(names, g.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+const skippedDescription = "not decoded"
+
+// decodeSkippedFormat decodes a sub format that should not be decoded to find
+// out its length and out value. Nested sub formats are not decoded and the
+// returned value is raw bits.
+func (d *D) decodeSkippedFormat(name string, r ranges.Range, group *Group, inArg any) (*Value, any, error) {
+	opts := d.subFormatOptions(name, r, inArg)
+	opts.FillGaps = false
+	opts.Lazy = false
+	opts.Depth = opts.depth + 1
+	dv, v, err := decode(d.Ctx, d.bitBuf, group, opts)
+	if dv == nil || dv.errors(false) != nil {
+		return nil, nil, err
+	}
+
+	br, err := bitiox.Range(d.bitBuf, dv.Range.Start, dv.Range.Len)
+	if err != nil {
+		d.IOPanic(err, name, "decodeSkippedFormat: bitiox.Range")
+	}
+	dv.V = &scalar.BitBuf{Actual: br, Description: skippedDescription}
+	dv.Format = nil
+	dv.Encoding = encodingRaw
+
+	return dv, v, nil
+}
+
+// skippedFormatOut returns the out value of a sub format with known range that
+// should not be decoded. Only decodes if inside EagerFn, otherwise nil.
+func (d *D) skippedFormatOut(name string, r ranges.Range, group *Group, inArg any) (any, error) {
+	if !d.eager {
+		return nil, nil
+	}
+	dv, v, err := d.decodeSkippedFormat(name, r, group, inArg)
+	if dv == nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// fieldSkippedFormat adds a sub format that should not be decoded as raw bits
+func (d *D) fieldSkippedFormat(name string, firstBit int64, nBits int64) *Value {
+	br, err := bitiox.Range(d.bitBuf, firstBit, nBits)
+	if err != nil {
+		d.IOPanic(err, name, "fieldSkippedFormat: bitiox.Range")
+	}
+
+	v := &Value{
+		Name: name,
+		V: &scalar.BitBuf{
+			Actual:      br,
+			Description: skippedDescription,
+		},
+		RootReader: d.bitBuf,
+		Range:      ranges.Range{Start: firstBit, Len: nBits},
+		Encoding:   encodingRaw,
+	}
+	d.AddChild(v)
+
+	return v
+}
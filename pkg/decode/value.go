@@ -16,13 +16,36 @@ type Compound struct {
 	Description string
 	Children    []*Value
 	IsArray     bool
+	Warnings    []Warning  // warnings about this value or its scalar children
+	Checksums   []Checksum // checksum fields among the children
 }
 
-// TODO: Encoding, u16le, varint etc, encode?
-// TODO: Value/Compound interface? can have per type and save memory
-// TODO: Make some fields optional somehow? map/slice?
+func (c *Compound) add(v *Value) {
+	c.Children = append(c.Children, v)
+	if c.IsArray {
+		return
+	}
+	if c.ByName == nil {
+		c.ByName = make(map[string]*Value)
+	}
+	c.ByName[v.Name] = v
+}
+
+func (c *Compound) remove(v *Value) bool {
+	i := slices.Index(c.Children, v)
+	if i == -1 {
+		return false
+	}
+	c.Children = slices.Delete(c.Children, i, i+1)
+	if !c.IsArray {
+		delete(c.ByName, v.Name)
+	}
+	return true
+}
+
+// TODO: Make some fields optional somehow?
 type Value struct {
-	V           any // scalar.S or Compound (array/struct)
+	V           any // scalar.Scalarable, Compound (array/struct) or Lazy
 	RootReader  bitio.ReaderAtSeeker
 	Err         error
 	Parent      *Value
@@ -30,8 +53,9 @@ type Value struct {
 	Name        string
 	Description string
 	Range       ranges.Range
-	Index       int  // index in parent array/struct
-	IsRoot      bool // TODO: rework?
+	Encoding    *Encoding // nil if value can't be encoded, ex: variable length or synthetic
+	Index       int       // index in parent array/struct
+	IsRoot      bool      // TODO: rework?
 }
 
 type WalkFn func(v *Value, rootV *Value, depth int, rootDepth int) error
@@ -163,9 +187,21 @@ func (v *Value) Root() *Value       { return v.root(false, false) }
 func (v *Value) BufferRoot() *Value { return v.root(true, false) }
 func (v *Value) FormatRoot() *Value { return v.root(true, true) }
 
-func (v *Value) Errors() []error {
+// Errors returns errors of value and all its children. Lazy values are resolved
+// so that errors in them are found.
+func (v *Value) Errors() []error { return v.errors(true) }
+
+// errors returns errors of value and all its children and resolves lazy values
+// if resolveLazy is true. While decoding lazy values should be left as is.
+func (v *Value) errors(resolveLazy bool) []error {
 	var errs []error
 	_ = v.WalkPreOrder(func(v *Value, _ *Value, _ int, _ int) error {
+		if resolveLazy {
+			if err := v.Resolve(); err != nil {
+				errs = append(errs, err)
+				return ErrWalkSkipChildren
+			}
+		}
 		if v.Err != nil {
 			errs = append(errs, v.Err)
 		}
@@ -185,6 +221,11 @@ func (v *Value) postProcess() {
 	if err := v.WalkRootPostOrder(func(v *Value, _ *Value, _ int, _ int) error {
 		switch vv := v.V.(type) {
 		case *Compound:
+			// shrink to fit as children are appended while decoding
+			if cap(vv.Children) > len(vv.Children) {
+				vv.Children = slices.Clone(vv.Children)
+			}
+
 			first := true
 			for _, f := range vv.Children {
 				if f.IsRoot {
@@ -229,13 +270,17 @@ func (v *Value) postProcess() {
 // TODO: rethink this
 func (v *Value) TryUintScalarFn(sms ...scalar.UintMapper) error {
 	var err error
-	sr, ok := v.V.(*scalar.Uint)
+	s, ok := scalar.AsUint(v.V)
 	if !ok {
 		panic("not a scalar value")
 	}
-	s := *sr
 	for _, sm := range sms {
 		s, err = sm.MapUint(s)
+		if ve, ok := err.(ValidateError); ok {
+			v.addWarning(ve.Reason)
+			err = nil
+			continue
+		}
 		if err != nil {
 			break
 		}
@@ -246,13 +291,17 @@ func (v *Value) TryUintScalarFn(sms ...scalar.UintMapper) error {
 
 func (v *Value) TryBitBufScalarFn(sms ...scalar.BitBufMapper) error {
 	var err error
-	sr, ok := v.V.(*scalar.BitBuf)
+	s, ok := scalar.AsBitBuf(v.V)
 	if !ok {
 		panic("not a scalar value")
 	}
-	s := *sr
 	for _, sm := range sms {
 		s, err = sm.MapBitBuf(s)
+		if ve, ok := err.(ValidateError); ok {
+			v.addWarning(ve.Reason)
+			err = nil
+			continue
+		}
 		if err != nil {
 			break
 		}
@@ -267,27 +316,54 @@ func (v *Value) Remove() error {
 		return fmt.Errorf("d has no parent")
 	}
 
-	switch fv := p.V.(type) {
-	case *Compound:
-		if !fv.IsArray {
-			if _, ok := fv.ByName[v.Name]; !ok {
-				return fmt.Errorf("d not in parent ByName")
+	if fv, ok := p.V.(*Compound); ok {
+		if !fv.remove(v) {
+			return fmt.Errorf("d not in parent children")
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of v without parent. Children, warnings and
+// checksums refer to the copied values. Scalars and readers are shared.
+func (v *Value) Copy() *Value {
+	copies := map[*Value]*Value{}
+	var copyFn func(v *Value, parent *Value) *Value
+	copyFn = func(v *Value, parent *Value) *Value {
+		cv := *v
+		cv.Parent = parent
+		copies[v] = &cv
+		if c, ok := v.V.(*Compound); ok {
+			cc := *c
+			cc.Children = nil
+			cc.ByName = nil
+			for _, f := range c.Children {
+				cc.add(copyFn(f, &cv))
 			}
-			delete(fv.ByName, p.Name)
+			cv.V = &cc
 		}
-		found := false
-		var cs []*Value
-		for _, c := range fv.Children {
-			if c == v {
-				found = true
-				continue
+		return &cv
+	}
+	root := copyFn(v, nil)
+
+	for _, cv := range copies {
+		c, ok := cv.V.(*Compound)
+		if !ok {
+			continue
+		}
+		c.Warnings = slices.Clone(c.Warnings)
+		for i, w := range c.Warnings {
+			if nv, ok := copies[w.Value]; ok {
+				c.Warnings[i].Value = nv
 			}
-			cs = append(cs, c)
 		}
-		if !found {
-			return fmt.Errorf("d not in parent children")
+		c.Checksums = slices.Clone(c.Checksums)
+		for i, cs := range c.Checksums {
+			if nv, ok := copies[cs.Value]; ok {
+				c.Checksums[i].Value = nv
+			}
 		}
-		fv.Children = cs
 	}
-	return nil
+
+	return root
 }
@@ -0,0 +1,54 @@
+package decode
+
+import "fmt"
+
+// Warning is a non-fatal problem found while decoding, ex: a checksum mismatch
+type Warning struct {
+	Value  *Value
+	Reason string
+}
+
+// addWarning adds a warning about v. Warnings are stored in the compound
+// value itself or for scalars in the parent compound value.
+func (v *Value) addWarning(reason string) {
+	cv := v
+	if _, ok := v.V.(*Compound); !ok {
+		cv = v.Parent
+	}
+	if cv == nil {
+		return
+	}
+	c, ok := cv.V.(*Compound)
+	if !ok {
+		return
+	}
+	c.Warnings = append(c.Warnings, Warning{Value: v, Reason: reason})
+}
+
+// Warnings returns reasons for warnings about v
+func (v *Value) Warnings() []string {
+	cv := v
+	if _, ok := v.V.(*Compound); !ok {
+		cv = v.Parent
+	}
+	if cv == nil {
+		return nil
+	}
+	c, ok := cv.V.(*Compound)
+	if !ok {
+		return nil
+	}
+	var reasons []string
+	for _, w := range c.Warnings {
+		if w.Value == v {
+			reasons = append(reasons, w.Reason)
+		}
+	}
+	return reasons
+}
+
+// Warnf adds a warning to the current value but continues decoding, use for
+// data that is broken but can still be decoded, ex: counts that don't add up
+func (d *D) Warnf(format string, a ...any) {
+	d.Value.addWarning(fmt.Sprintf(format, a...))
+}
@@ -0,0 +1,179 @@
+package interp
+
+// Side-by-side hexdump used by bdiff_dump, see funcs.jq
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wader/fq/internal/mathx"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	RegisterIter2("_bdiff_hexdump", (*Interp)._bdiffHexdump)
+}
+
+type bdiffSide struct {
+	buf   []byte
+	start int64 // byte address of first byte
+	ok    bool  // false if value is missing on this side
+}
+
+// bdiffSideFromValue reads the bytes covering the bit range of a value.
+// Synthetic values have no bytes and are treated as missing.
+func bdiffSideFromValue(v any) (bdiffSide, error) {
+	if v == nil {
+		return bdiffSide{}, nil
+	}
+	if dv, ok := v.(DecodeValue); ok {
+		if s, ok := dv.DecodeValue().V.(scalar.Scalarable); ok && s.ScalarFlags().IsSynthetic() {
+			return bdiffSide{}, nil
+		}
+	}
+	bv, err := toBinary(v)
+	if err != nil {
+		return bdiffSide{}, err
+	}
+	start := bv.r.Start / 8
+	stop := (bv.r.Stop() + 7) / 8
+	buf, err := bv.toBytesBuffer(ranges.Range{Start: start * 8, Len: (stop - start) * 8})
+	if err != nil {
+		return bdiffSide{}, err
+	}
+	return bdiffSide{buf: buf.Bytes(), start: start, ok: true}, nil
+}
+
+// _bdiffHexdump outputs a side-by-side hexdump of input and b where differing
+// bytes are highlighted. Input or b is null if missing on that side.
+func (i *Interp) _bdiffHexdump(c any, b any, v any) gojq.Iter {
+	opts, err := OptionsFromValue(v)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	as, err := bdiffSideFromValue(c)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	bs, err := bdiffSideFromValue(b)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	if err := bdiffHexdump(i.EvalInstance.Output, as, bs, opts); err != nil {
+		return gojq.NewIter(err)
+	}
+
+	return gojq.NewIter()
+}
+
+func isBdiffDiff(a bdiffSide, b bdiffSide, i int) bool {
+	return i >= len(a.buf) || i >= len(b.buf) || a.buf[i] != b.buf[i]
+}
+
+// bdiffHexdump writes rows with half of line_bytes bytes from each side starting
+// at the first byte of each side. Rows without differences are collapsed into
+// a "*" row and at most display_bytes bytes of rows with differences are shown.
+// Without color differing bytes are marked with a "^" row.
+func bdiffHexdump(w io.Writer, a bdiffSide, b bdiffSide, opts *Options) error {
+	deco := opts.Decorator
+	lineBytes := max(1, opts.LineBytes/2)
+	n := max(len(a.buf), len(b.buf))
+	// max number of rows with differences to show
+	maxRows := -1
+	if opts.DisplayBytes > 0 {
+		maxRows = max(1, (opts.DisplayBytes+lineBytes-1)/lineBytes)
+	}
+	addrWidth := len(mathx.PadFormatInt(max(a.start+int64(len(a.buf)), b.start+int64(len(b.buf))), opts.Addrbase, true, 0))
+
+	writeSide := func(sb *strings.Builder, s bdiffSide, off int, mark bool) {
+		addr := strings.Repeat(" ", addrWidth)
+		if s.ok && !mark && off < len(s.buf) {
+			addr = deco.DumpAddr.Wrap(mathx.PadFormatInt(s.start+int64(off), opts.Addrbase, true, addrWidth))
+		}
+		sb.WriteString(addr)
+		sb.WriteString(deco.Column)
+
+		var ascii strings.Builder
+		for j := off; j < off+lineBytes; j++ {
+			if j > off {
+				sb.WriteString(" ")
+			}
+			if !s.ok || j >= len(s.buf) {
+				sb.WriteString("  ")
+				ascii.WriteString(" ")
+				continue
+			}
+			diff := isBdiffDiff(a, b, j)
+			if mark {
+				if diff {
+					sb.WriteString("^^")
+					ascii.WriteString("^")
+				} else {
+					sb.WriteString("  ")
+					ascii.WriteString(" ")
+				}
+				continue
+			}
+
+			c := s.buf[j]
+			color := deco.ByteColor(c)
+			if diff {
+				color = deco.Error
+			}
+			sb.WriteString(color.Wrap(fmt.Sprintf("%02x", c)))
+			ch := "."
+			if c >= 32 && c <= 126 {
+				ch = string(rune(c))
+			}
+			ascii.WriteString(color.Wrap(ch))
+		}
+		sb.WriteString(deco.Column)
+		sb.WriteString(ascii.String())
+		sb.WriteString(deco.Column)
+	}
+
+	collapsed := false
+	for off := 0; off < n; off += lineBytes {
+		hasDiff := false
+		for j := off; j < min(off+lineBytes, n); j++ {
+			if isBdiffDiff(a, b, j) {
+				hasDiff = true
+				break
+			}
+		}
+		if !hasDiff {
+			if !collapsed {
+				if _, err := fmt.Fprintln(w, "*"); err != nil {
+					return err
+				}
+				collapsed = true
+			}
+			continue
+		}
+		collapsed = false
+		if maxRows == 0 {
+			_, err := fmt.Fprintln(w, "...")
+			return err
+		}
+		maxRows--
+
+		rows := []bool{false}
+		if !opts.Color && a.ok && b.ok {
+			rows = append(rows, true)
+		}
+		for _, mark := range rows {
+			var sb strings.Builder
+			writeSide(&sb, a, off, mark)
+			sb.WriteString(" ")
+			writeSide(&sb, b, off, mark)
+			if _, err := fmt.Fprintln(w, strings.TrimRight(sb.String(), " ")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -252,7 +252,11 @@ func (i *Interp) _open(c any) any {
 	// a regular file should be seekable but fallback below to read whole file if not
 	if fFI.Mode().IsRegular() {
 		if rs, ok := f.(io.ReadSeeker); ok {
-			fRS = ctxreadseeker.New(i.EvalInstance.Ctx, rs)
+			ctx := i.EvalInstance.Ctx
+			if i.EvalInstance.openCtx != nil {
+				ctx = i.EvalInstance.openCtx
+			}
+			fRS = ctxreadseeker.New(ctx, rs)
 			bEnd = fFI.Size()
 		}
 	}
@@ -0,0 +1,245 @@
+package interp
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	RegisterIter1("_carve", (*Interp)._carve)
+}
+
+type carveOpts struct {
+	Formats []string
+}
+
+type carveCandidate struct {
+	offset int64 // in bytes
+	order  int   // order of format in formats option
+	format *decode.Format
+}
+
+// carveFormats resolves format and group names to formats with signatures.
+// A format without signatures is an error if named directly, formats without
+// signatures in a group are skipped.
+func (i *Interp) carveFormats(names []string) ([]*decode.Format, error) {
+	var formats []*decode.Format
+	seen := map[*decode.Format]bool{}
+	for _, name := range names {
+		g, err := i.Registry.Group(name)
+		if err != nil {
+			return nil, err
+		}
+		isFormat := len(g.Formats) == 1 && g.Formats[0].Name == name
+		for _, f := range g.Formats {
+			if len(f.Signatures) == 0 {
+				if isFormat {
+					return nil, fmt.Errorf("%s: format has no signatures and can't be carved", name)
+				}
+				continue
+			}
+			if seen[f] {
+				continue
+			}
+			seen[f] = true
+			formats = append(formats, f)
+		}
+	}
+
+	return formats, nil
+}
+
+// carveScanBufSize is how many bytes are searched for signatures at a time
+const carveScanBufSize = 64 * 1024
+
+// carveScanner reads r in chunks and finds candidate offsets for formats using
+// their signatures. Chunks overlap so that signatures spanning two chunks are
+// found. Scanning is done as candidates are needed and only candidates for the
+// current chunk are kept.
+type carveScanner struct {
+	r            io.Reader
+	formats      []*decode.Format
+	maxSigLen    int
+	maxSigOffset int64
+	buf          []byte
+	n            int   // bytes in buf
+	bufOffset    int64 // offset in input of buf[0]
+	scannedEnd   int64 // end of scanned input, matches ending before are already found
+	eof          bool
+	pending      []carveCandidate // sorted by offset and order
+	last         *carveCandidate
+}
+
+func newCarveScanner(r io.Reader, formats []*decode.Format, bufSize int) *carveScanner {
+	maxSigLen := 1
+	var maxSigOffset int64
+	for _, f := range formats {
+		for _, s := range f.Signatures {
+			maxSigLen = max(maxSigLen, len(s.Bytes))
+			maxSigOffset = max(maxSigOffset, s.Offset)
+		}
+	}
+
+	return &carveScanner{
+		r:            r,
+		formats:      formats,
+		maxSigLen:    maxSigLen,
+		maxSigOffset: maxSigOffset,
+		buf:          make([]byte, bufSize+maxSigLen-1),
+	}
+}
+
+// next returns next candidate ordered by offset and then format order, false if
+// there are no more candidates
+func (s *carveScanner) next() (carveCandidate, bool, error) {
+	for {
+		// a signature found later ends after scannedEnd so it can't be for an
+		// offset before safeOffset
+		safeOffset := s.scannedEnd - int64(s.maxSigLen) + 1 - s.maxSigOffset
+		for len(s.pending) > 0 && (s.eof || s.pending[0].offset < safeOffset) {
+			cc := s.pending[0]
+			s.pending = s.pending[1:]
+			// same format can be found using different signatures
+			if s.last != nil && s.last.offset == cc.offset && s.last.order == cc.order {
+				continue
+			}
+			s.last = &cc
+			return cc, true, nil
+		}
+		if s.eof {
+			return carveCandidate{}, false, nil
+		}
+		if err := s.scan(); err != nil {
+			return carveCandidate{}, false, err
+		}
+	}
+}
+
+func (s *carveScanner) scan() error {
+	rn, err := io.ReadFull(s.r, s.buf[s.n:])
+	s.n += rn
+	s.eof = errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	if err != nil && !s.eof {
+		return err
+	}
+
+	bs := s.buf[0:s.n]
+	for order, f := range s.formats {
+		for _, sig := range f.Signatures {
+			for pos := 0; pos < len(bs); {
+				i := bytes.Index(bs[pos:], sig.Bytes)
+				if i == -1 {
+					break
+				}
+				pos += i
+				matchPos := s.bufOffset + int64(pos)
+				// skip matches already found in previous chunk
+				if matchPos+int64(len(sig.Bytes)) > s.scannedEnd {
+					if off := matchPos - sig.Offset; off >= 0 {
+						s.pending = append(s.pending, carveCandidate{offset: off, order: order, format: f})
+					}
+				}
+				pos++
+			}
+		}
+	}
+	s.scannedEnd = s.bufOffset + int64(s.n)
+
+	slices.SortFunc(s.pending, func(a, b carveCandidate) int {
+		if a.offset == b.offset {
+			return cmp.Compare(a.order, b.order)
+		}
+		return cmp.Compare(a.offset, b.offset)
+	})
+
+	if s.eof {
+		return nil
+	}
+
+	// keep end of buffer in case a signature continues in next chunk
+	keep := s.maxSigLen - 1
+	copy(s.buf, s.buf[s.n-keep:s.n])
+	s.bufOffset += int64(s.n - keep)
+	s.n = keep
+
+	return nil
+}
+
+// _carve finds candidate offsets for formats using their signatures and tries
+// to decode at each offset, skipping offsets inside already decoded ranges.
+// Input is scanned as candidates are needed so memory use does not depend on
+// input size.
+func (i *Interp) _carve(c any, opts carveOpts) gojq.Iter {
+	bv, err := toBinary(c)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	formats, err := i.carveFormats(opts.Formats)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	br, err := bitiox.Range(bv.br, bv.r.Start, bv.r.Len)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	scanner := newCarveScanner(bitio.NewIOReader(br), formats, carveScanBufSize)
+
+	ctx := i.EvalInstance.Ctx
+	// end of last decoded range in bytes
+	var claimedEnd int64
+
+	return iterFn(func() (any, bool) {
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err(), false
+			}
+
+			cc, ok, err := scanner.next()
+			if err != nil {
+				return err, false
+			}
+			if !ok {
+				return nil, false
+			}
+			if cc.offset < claimedEnd {
+				continue
+			}
+
+			g, err := i.Registry.Group(cc.format.Name)
+			if err != nil {
+				return err, false
+			}
+
+			// decode to the end of the input but only fill gaps up to where
+			// the format ended as length is not known
+			dv, _, err := decode.Decode(ctx, bv.br, g, decode.Options{
+				IsRoot:      true,
+				FillGaps:    true,
+				FillGapsEnd: true,
+				Range:       ranges.Range{Start: bv.r.Start + cc.offset*8, Len: bv.r.Len - cc.offset*8},
+			})
+			if err != nil || dv.Range.Len == 0 {
+				continue
+			}
+			length := (dv.Range.Len + 7) / 8
+			claimedEnd = cc.offset + length
+
+			return map[string]any{
+				"offset": int(cc.offset),
+				"length": int(length),
+				"format": cc.format.Name,
+				"value":  makeDecodeValueOut(dv, decodeValueValue, nil),
+			}, true
+		}
+	})
+}
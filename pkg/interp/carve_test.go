@@ -0,0 +1,72 @@
+package interp
+
+import (
+	"bytes"
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/wader/fq/pkg/decode"
+)
+
+func TestCarveScanner(t *testing.T) {
+	formats := []*decode.Format{
+		{Name: "a", Signatures: []decode.Signature{{Bytes: []byte("ab")}, {Offset: 1, Bytes: []byte("b")}}},
+		{Name: "b", Signatures: []decode.Signature{{Offset: 3, Bytes: []byte("abca")}}},
+		{Name: "c", Signatures: []decode.Signature{{Bytes: []byte("c")}}},
+	}
+
+	// all candidates found by searching the whole input
+	expectedCandidates := func(input []byte) []carveCandidate {
+		var ccs []carveCandidate
+		for order, f := range formats {
+			for _, s := range f.Signatures {
+				for pos := range input {
+					if !bytes.HasPrefix(input[pos:], s.Bytes) {
+						continue
+					}
+					off := int64(pos) - s.Offset
+					if off < 0 || slices.Contains(ccs, carveCandidate{offset: off, order: order, format: f}) {
+						continue
+					}
+					ccs = append(ccs, carveCandidate{offset: off, order: order, format: f})
+				}
+			}
+		}
+		slices.SortFunc(ccs, func(a, b carveCandidate) int {
+			if a.offset == b.offset {
+				return cmp.Compare(a.order, b.order)
+			}
+			return cmp.Compare(a.offset, b.offset)
+		})
+		return ccs
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for range 100 {
+		input := make([]byte, r.Intn(200))
+		for i := range input {
+			input[i] = "abcd"[r.Intn(4)]
+		}
+		expected := expectedCandidates(input)
+
+		for _, bufSize := range []int{1, 2, 3, 7, 64, 1024} {
+			s := newCarveScanner(bytes.NewReader(input), formats, bufSize)
+			var actual []carveCandidate
+			for {
+				cc, ok, err := s.next()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !ok {
+					break
+				}
+				actual = append(actual, cc)
+			}
+			if !slices.Equal(expected, actual) {
+				t.Fatalf("input %q buf size %d\nexpected %v\nactual   %v", input, bufSize, expected, actual)
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package interp
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +15,7 @@ import (
 	"github.com/wader/fq/internal/gojqx"
 	"github.com/wader/fq/internal/iox"
 	"github.com/wader/fq/internal/mapstruct"
+	"github.com/wader/fq/internal/mathx"
 	"github.com/wader/fq/pkg/bitio"
 	"github.com/wader/fq/pkg/decode"
 	"github.com/wader/fq/pkg/scalar"
@@ -25,6 +27,7 @@ func init() {
 	RegisterFunc0("_registry", (*Interp)._registry)
 	RegisterFunc1("_tovalue", (*Interp)._toValue)
 	RegisterFunc2("_decode", (*Interp)._decode)
+	RegisterFunc1("_patch", (*Interp)._patch)
 }
 
 // TODO: redo/rename
@@ -64,6 +67,7 @@ func (i *Interp) _registry(c any) any {
 			"root_name":            f.RootName,
 			"root_array":           f.RootArray,
 			"skip_decode_function": f.SkipDecodeFunction,
+			"skip_from_function":   f.SkipFromFunction,
 		}
 
 		var dependenciesVs []any
@@ -168,10 +172,132 @@ func (i *Interp) _toValue(c any, om map[string]any) any {
 	return v
 }
 
+func isRawValue(v *decode.Value) bool {
+	if v.Encoding != nil {
+		return v.Encoding.Kind == decode.EncodingRaw
+	}
+	sv, ok := v.V.(scalar.Scalarable)
+	if !ok {
+		return false
+	}
+	_, ok = sv.ScalarActual().(bitio.ReaderAtSeeker)
+	return ok
+}
+
+// _patch takes an array of [decode value, new value] pairs and returns a copy of
+// the input binary with the decode values re-encoded with the new values
+func (i *Interp) _patch(c any, patches []any) any {
+	cdv, ok := c.(DecodeValue)
+	if !ok {
+		return fmt.Errorf("expected a decode value but got: %s", gojqx.TypeErrorPreview(c))
+	}
+	dv := cdv.DecodeValue()
+
+	br := dv.RootReader
+	brLen, err := bitiox.Len(br)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, bitio.BitsByteCount(brLen))
+	if _, err := bitio.ReadAtFull(br, buf, brLen, 0); err != nil {
+		return err
+	}
+
+	for _, p := range patches {
+		pp, ok := p.([]any)
+		if !ok || len(pp) != 2 {
+			return fmt.Errorf("expected a [decode value, value] pair")
+		}
+		pdv, ok := pp[0].(DecodeValue)
+		if !ok {
+			return fmt.Errorf("expected a decode value but got: %s", gojqx.TypeErrorPreview(pp[0]))
+		}
+		v := pdv.DecodeValue()
+		if v.RootReader != br {
+			return fmt.Errorf("%s: not in same buffer as input", v.Name)
+		}
+
+		var a any
+		var isBytes bool
+		if isRawValue(v) {
+			// raw bytes might not be valid UTF-8 so use binary directly,
+			// can fail for ex: symbolic string value of raw value
+			var b []byte
+			if b, err = toBytes(pp[1]); err == nil {
+				a, isBytes = b, true
+			}
+		}
+		if !isBytes {
+			if a, err = toValue(nil, pp[1]); err != nil {
+				return err
+			}
+		}
+		if err := v.Patch(buf, a); err != nil {
+			return err
+		}
+	}
+
+	return Binary{
+		br:   bitio.NewBitReader(buf, brLen),
+		r:    dv.InnerRange(),
+		unit: 8,
+	}
+}
+
 type decodeOpts struct {
-	Force    bool
-	Progress string
-	Remain   map[string]any `mapstruct:",remain"`
+	Force          bool
+	Lazy           bool
+	Progress       string
+	ProbeTrace     bool           // print each format tried to stderr
+	Explain        bool           // output formats tried instead of decode value
+	DecodeDepth    int            // sub formats deeper than this are raw bits, see decode.Options
+	IncludeFormats []string       // only decode these sub formats
+	ExcludeFormats []string       // don't decode these sub formats
+	Remain         map[string]any `mapstruct:",remain"`
+}
+
+// attemptsValue returns formats in group order with how they were tried.
+// Formats after the decoded one are not tried.
+func attemptsValue(group *decode.Group, attempts []decode.Attempt, dv *decode.Value) []any {
+	byFormat := map[*decode.Format]decode.Attempt{}
+	for _, a := range attempts {
+		byFormat[a.Format] = a
+	}
+
+	var vs []any
+	for _, f := range group.Formats {
+		v := map[string]any{
+			"format":      f.Name,
+			"probe_order": f.ProbeOrder,
+			"result":      "not_tried",
+			"error":       nil,
+			"position":    nil,
+			"duration":    nil,
+		}
+		if a, ok := byFormat[f]; ok {
+			v["result"] = "error"
+			if dv != nil && dv.Format == f {
+				v["result"] = "decoded"
+			}
+			if a.Err != nil {
+				v["error"] = a.Err.Error()
+			}
+			v["position"] = int(a.Pos)
+			v["duration"] = a.Duration.Seconds()
+		}
+		vs = append(vs, v)
+	}
+
+	return vs
+}
+
+func attemptTraceString(group *decode.Group, a decode.Attempt) string {
+	if a.Err != nil {
+		return fmt.Sprintf("%s: %s: failed at %s after %s: %s",
+			group.Name, a.Format.Name, mathx.Bits(a.Pos).StringByteBits(16), a.Duration, a.Err)
+	}
+	return fmt.Sprintf("%s: %s: decoded to %s after %s",
+		group.Name, a.Format.Name, mathx.Bits(a.Pos).StringByteBits(16), a.Duration)
 }
 
 func (i *Interp) _decode(c any, format string, opts decodeOpts) any {
@@ -232,11 +358,24 @@ func (i *Interp) _decode(c any, format string, opts decodeOpts) any {
 		return err
 	}
 
+	var attempts []decode.Attempt
+	var attemptFn func(a decode.Attempt)
+	if opts.ProbeTrace || opts.Explain {
+		attemptFn = func(a decode.Attempt) {
+			if opts.ProbeTrace {
+				fmt.Fprintln(i.OS.Stderr(), attemptTraceString(decodeGroup, a))
+			}
+			attempts = append(attempts, a)
+		}
+	}
+
 	dv, formatOut, err := decode.Decode(i.EvalInstance.Ctx, bv.br, decodeGroup,
 		decode.Options{
 			IsRoot:      true,
 			FillGaps:    true,
 			Force:       opts.Force,
+			Lazy:        opts.Lazy,
+			LazyCtxFn:   i.interruptCtx,
 			Range:       bv.r,
 			Description: filename,
 			ParseOptsFn: func(init any) any {
@@ -258,8 +397,15 @@ func (i *Interp) _decode(c any, format string, opts decodeOpts) any {
 
 				return v
 			},
+			AttemptFn:      attemptFn,
+			Depth:          opts.DecodeDepth,
+			IncludeFormats: opts.IncludeFormats,
+			ExcludeFormats: opts.ExcludeFormats,
 		},
 	)
+	if opts.Explain {
+		return attemptsValue(decodeGroup, attempts, dv)
+	}
 	if dv == nil {
 		var decodeFormatsErr decode.FormatsError
 		if errors.As(err, &decodeFormatsErr) {
@@ -332,6 +478,11 @@ func makeDecodeValue(dv *decode.Value, kind decodeValueKind) any {
 }
 
 func makeDecodeValueOut(dv *decode.Value, kind decodeValueKind, out any) any {
+	// decode lazy sub format value on first access
+	if err := dv.Resolve(); err != nil {
+		return err
+	}
+
 	switch vv := dv.V.(type) {
 	case *decode.Compound:
 		if vv.IsArray {
@@ -459,6 +610,7 @@ func (dvb decodeValueBase) ExtKeys() []string {
 		"_bits",
 		"_buffer_root",
 		"_bytes",
+		"_checksum",
 		"_description",
 		"_error",
 		"_format_root",
@@ -474,6 +626,7 @@ func (dvb decodeValueBase) ExtKeys() []string {
 		"_start",
 		"_stop",
 		"_sym",
+		"_warnings",
 	}
 }
 
@@ -488,6 +641,7 @@ func (dvb decodeValueBase) JQValueHas(key any) any {
 		"_bits",
 		"_buffer_root",
 		"_bytes",
+		"_checksum",
 		"_description",
 		"_error",
 		"_format_root",
@@ -502,7 +656,8 @@ func (dvb decodeValueBase) JQValueHas(key any) any {
 		"_root",
 		"_start",
 		"_stop",
-		"_sym":
+		"_sym",
+		"_warnings":
 		return true
 	}
 
@@ -597,7 +752,27 @@ func (dvb decodeValueBase) JQValueKey(name string) any {
 		if errors.As(dv.Err, &formatErr) {
 			return formatErr.Value()
 		}
+		if dv.Err != nil {
+			return map[string]any{"error": dv.Err.Error()}
+		}
 		return nil
+	case "_checksum":
+		c, ok := dv.Checksum()
+		if !ok {
+			return nil
+		}
+		return map[string]any{
+			"algorithm":  c.Algorithm,
+			"expected":   hex.EncodeToString(c.Expected),
+			"calculated": hex.EncodeToString(c.Calculated),
+			"valid":      c.Valid(),
+		}
+	case "_warnings":
+		vs := []any{}
+		for _, w := range dv.Warnings() {
+			vs = append(vs, w)
+		}
+		return vs
 	case "_format":
 		if dv.Format != nil {
 			return dv.Format.Name
@@ -762,18 +937,12 @@ func (v StructDecodeValue) JQValueKey(name string) any {
 			if !ok {
 				return false
 			}
-			if v.Compound.ByName != nil {
-				if _, ok := v.Compound.ByName[stringKey]; ok {
-					return true
-				}
-			}
-			return false
+			_, ok = v.Compound.ByName[stringKey]
+			return ok
 		},
 		func(name string) any {
-			if v.Compound.ByName != nil {
-				if f, ok := v.Compound.ByName[name]; ok {
-					return makeDecodeValue(f, decodeValueValue)
-				}
+			if f, ok := v.Compound.ByName[name]; ok {
+				return makeDecodeValue(f, decodeValueValue)
 			}
 
 			return nil
@@ -804,13 +973,8 @@ func (v StructDecodeValue) JQValueHas(key any) any {
 				return gojqx.HasKeyTypeError{L: gojq.JQTypeObject, R: fmt.Sprintf("%v", key)}
 			}
 
-			if v.Compound.ByName != nil {
-				if _, ok := v.Compound.ByName[stringKey]; ok {
-					return true
-				}
-			}
-
-			return false
+			_, ok = v.Compound.ByName[stringKey]
+			return ok
 		},
 	)
 }
@@ -0,0 +1,364 @@
+package interp
+
+// Support functions for decoders written in jq, see decoder.jq
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/internal/gojqx"
+	"github.com/wader/fq/internal/mapstruct"
+	"github.com/wader/fq/pkg/bitio"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/ranges"
+	"github.com/wader/fq/pkg/scalar"
+)
+
+func init() {
+	RegisterFunc1("_decoder_read", (*Interp)._decoderRead)
+	RegisterFunc2("_decoder_tree", (*Interp)._decoderTree)
+}
+
+type decoderReadOpts struct {
+	Type   string
+	Pos    int64
+	Bits   int
+	Endian string
+}
+
+// decoderNode is a field collected by a jq decoder. Type is u, s, f, bool,
+// utf8, raw, format, struct or array. Start and Bits are relative to the start
+// of the decoded binary.
+type decoderNode struct {
+	Name        string
+	Type        string
+	Start       int64
+	Bits        int64
+	Endian      string
+	Format      string
+	Sym         any
+	Description string
+	Fields      []decoderNode
+	// decode value already decoded by subformat
+	Value any
+}
+
+type decoderTreeOpts struct {
+	Fields []decoderNode
+}
+
+func decoderEndian(s string) decode.Endian {
+	if s == "le" {
+		return decode.LittleEndian
+	}
+	return decode.BigEndian
+}
+
+// decoderReader is a read decoder for a binary, a jq decoder does many small
+// reads from the same binary so it is reused instead of doing a decode per read
+type decoderReader struct {
+	br bitio.ReaderAtSeeker
+	r  ranges.Range
+	d  *decode.D
+}
+
+func (i *Interp) decoderReadDecoder(bv Binary) (*decode.D, error) {
+	if dr := i.EvalInstance.decoderReader; dr != nil && dr.br == bv.br && dr.r == bv.r {
+		return dr.d, nil
+	}
+	br, err := bitiox.Range(bv.br, bv.r.Start, bv.r.Len)
+	if err != nil {
+		return nil, err
+	}
+	d := decode.NewReadDecoder(i.EvalInstance.Ctx, br)
+	i.EvalInstance.decoderReader = &decoderReader{br: bv.br, r: bv.r, d: d}
+
+	return d, nil
+}
+
+// _decoderRead reads one value at a bit position in the input binary
+func (i *Interp) _decoderRead(c any, opts decoderReadOpts) any {
+	bv, err := toBinary(c)
+	if err != nil {
+		return err
+	}
+	if opts.Pos < 0 || opts.Bits < 0 || opts.Pos+int64(opts.Bits) > bv.r.Len {
+		return fmt.Errorf("%s%d: read outside of binary at bit %d", opts.Type, opts.Bits, opts.Pos)
+	}
+	d, err := i.decoderReadDecoder(bv)
+	if err != nil {
+		return err
+	}
+	if _, err := d.TrySeekAbs(opts.Pos); err != nil {
+		return err
+	}
+
+	endian := decoderEndian(opts.Endian)
+	switch opts.Type {
+	case "u":
+		n, err := d.TryUE(opts.Bits, endian)
+		if err != nil {
+			return err
+		}
+		if n > math.MaxInt {
+			return new(big.Int).SetUint64(n)
+		}
+		return int(n)
+	case "s":
+		n, err := d.TrySE(opts.Bits, endian)
+		if err != nil {
+			return err
+		}
+		return int(n)
+	case "f":
+		f, err := d.TryFE(opts.Bits, endian)
+		if err != nil {
+			return err
+		}
+		return f
+	case "bool":
+		b, err := d.TryBool()
+		if err != nil {
+			return err
+		}
+		return b
+	case "utf8":
+		s, err := d.TryUTF8(opts.Bits / 8)
+		if err != nil {
+			return err
+		}
+		return s
+	case "raw":
+		br, err := d.TryRawLen(int64(opts.Bits))
+		if err != nil {
+			return err
+		}
+		bb, err := NewBinaryFromBitReader(br, 8, 0)
+		if err != nil {
+			return err
+		}
+		return bb
+	default:
+		return fmt.Errorf("unknown type %q", opts.Type)
+	}
+}
+
+// decoderTree adds fields collected by a jq decoder while decoding bv
+type decoderTree struct {
+	i  *Interp
+	bv Binary
+}
+
+func (t decoderTree) fields(d *decode.D, ns []decoderNode) {
+	for _, n := range ns {
+		t.field(d, n.Name, n)
+	}
+}
+
+func (t decoderTree) field(d *decode.D, name string, n decoderNode) {
+	switch n.Type {
+	case "struct":
+		d.FieldStruct(name, func(d *decode.D) { t.fields(d, n.Fields) })
+		return
+	case "array":
+		d.FieldArray(name, func(d *decode.D) {
+			for _, e := range n.Fields {
+				t.field(d, name, e)
+			}
+		})
+		return
+	}
+
+	d.SeekAbs(n.Start)
+	endian := decoderEndian(n.Endian)
+	switch n.Type {
+	case "u":
+		d.FieldUE(name, int(n.Bits), endian, scalar.UintFn(func(s scalar.Uint) (scalar.Uint, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "s":
+		d.FieldSE(name, int(n.Bits), endian, scalar.SintFn(func(s scalar.Sint) (scalar.Sint, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "f":
+		d.FieldFE(name, int(n.Bits), endian, scalar.FltFn(func(s scalar.Flt) (scalar.Flt, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "bool":
+		d.FieldBool(name, scalar.BoolFn(func(s scalar.Bool) (scalar.Bool, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "utf8":
+		d.FieldUTF8(name, int(n.Bits/8), scalar.StrFn(func(s scalar.Str) (scalar.Str, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "raw":
+		d.FieldRawLen(name, n.Bits, scalar.BitBufFn(func(s scalar.BitBuf) (scalar.BitBuf, error) {
+			s.Sym, s.Description = n.Sym, n.Description
+			return s, nil
+		}))
+	case "format":
+		if dv := t.value(d, n); dv != nil {
+			dv.Name = name
+			d.AddChild(dv)
+			d.SeekRel(n.Bits)
+			return
+		}
+		g, err := t.i.Registry.Group(n.Format)
+		if err != nil {
+			d.Fatalf("%s: %s", name, err)
+		}
+		d.FieldFormatLen(name, n.Bits, g, nil)
+	default:
+		d.Fatalf("%s: unknown type %q", name, n.Type)
+	}
+}
+
+// value returns a copy of the decode value decoded by subformat if it can be
+// reused as a field, nil if it has to be decoded again. subformat decodes a
+// slice of tobits of the input so ranges are already relative to the decode
+// range. A copy is used as the value might be used elsewhere.
+func (t decoderTree) value(d *decode.D, n decoderNode) *decode.Value {
+	dvv, ok := n.Value.(DecodeValue)
+	if !ok {
+		return nil
+	}
+	dv := dvv.DecodeValue()
+	// only reuse a root value, and re-decode if sub formats might be filtered
+	// by decode options
+	if !dv.IsRoot || dv.Parent != nil ||
+		d.Options.Depth != 0 || len(d.Options.IncludeFormats) > 0 || len(d.Options.ExcludeFormats) > 0 {
+		return nil
+	}
+	// must have been decoded from the same bits at the same position
+	if dv.Range.Start != n.Start || dv.Range.Len != n.Bits {
+		return nil
+	}
+	if same, err := decoderSameBits(dv.RootReader, t.bv.br, t.bv.r.Start, n.Start, n.Bits); err != nil || !same {
+		return nil
+	}
+
+	cv := dv.Copy()
+	cv.IsRoot = false
+
+	return cv
+}
+
+// decoderSameBits compares nBits at pos in a with the same bits in b starting
+// at bStart
+func decoderSameBits(a bitio.ReaderAt, b bitio.ReaderAt, bStart int64, pos int64, nBits int64) (bool, error) {
+	const chunkBits = 32 * 1024 * 8
+	aBuf := make([]byte, chunkBits/8)
+	bBuf := make([]byte, chunkBits/8)
+	for off := int64(0); off < nBits; off += chunkBits {
+		n := min(chunkBits, nBits-off)
+		if _, err := bitio.ReadAtFull(a, aBuf, n, pos+off); err != nil {
+			return false, err
+		}
+		if _, err := bitio.ReadAtFull(b, bBuf, n, bStart+pos+off); err != nil {
+			return false, err
+		}
+		nBytes := bitio.BitsByteCount(n)
+		// mask unused bits of last byte
+		if r := n % 8; r != 0 {
+			aBuf[nBytes-1] &= 0xff << (8 - r)
+			bBuf[nBytes-1] &= 0xff << (8 - r)
+		}
+		if !bytes.Equal(aBuf[:nBytes], bBuf[:nBytes]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// decoderTreeGoJQ is like gojqx.ToGoJQValue but keeps decode values
+func decoderTreeGoJQ(v any) (any, error) {
+	switch v := v.(type) {
+	case DecodeValue:
+		return v, nil
+	case []any:
+		vs := make([]any, len(v))
+		for i, e := range v {
+			ev, err := decoderTreeGoJQ(e)
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = ev
+		}
+		return vs, nil
+	case map[string]any:
+		vm := make(map[string]any, len(v))
+		for k, e := range v {
+			ev, err := decoderTreeGoJQ(e)
+			if err != nil {
+				return nil, err
+			}
+			vm[k] = ev
+		}
+		return vm, nil
+	default:
+		return gojqx.ToGoJQValue(v)
+	}
+}
+
+// _decoderTree decodes the input binary as a format named name using fields
+// collected by a jq decoder
+func (i *Interp) _decoderTree(c any, name string, v any) any {
+	// mapped here instead of as a struct argument to keep subformat decode
+	// values as is
+	m, err := decoderTreeGoJQ(v)
+	if err != nil {
+		return err
+	}
+	var opts decoderTreeOpts
+	if err := mapstruct.ToStruct(m, &opts); err != nil {
+		return err
+	}
+	var filename string
+	if bbf, ok := c.(*openFile); ok {
+		filename = bbf.filename
+	}
+	bv, err := toBinary(c)
+	if err != nil {
+		return err
+	}
+
+	group := &decode.Group{
+		Name: name,
+		Formats: []*decode.Format{{
+			Name:        name,
+			Description: "jq decoder",
+			DecodeFn: func(d *decode.D) any {
+				decoderTree{i: i, bv: bv}.fields(d, opts.Fields)
+				return nil
+			},
+		}},
+	}
+	decodeOpts := decode.Options{
+		IsRoot:      true,
+		FillGaps:    true,
+		Range:       bv.r,
+		Description: filename,
+	}
+	if o := i.EvalInstance.decodeOpts; o != nil {
+		decodeOpts.Force = o.Force
+		decodeOpts.Depth = o.DecodeDepth
+		decodeOpts.IncludeFormats = o.IncludeFormats
+		decodeOpts.ExcludeFormats = o.ExcludeFormats
+	}
+	dv, _, err := decode.Decode(i.EvalInstance.Ctx, bv.br, group, decodeOpts)
+	if dv == nil {
+		return valueError{err}
+	}
+
+	return makeDecodeValueOut(dv, decodeValueValue, nil)
+}
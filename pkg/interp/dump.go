@@ -224,6 +224,10 @@ func dumpEx(v *decode.Value, ctx *dumpCtx, depth int, rootV *decode.Value, rootD
 		printErrs(depth, valueErr)
 	}
 
+	for _, w := range v.Warnings() {
+		cfmt(colField, "%s  %s: %s\n", indent, deco.Error.F("warning"), w)
+	}
+
 	rootBitLen, err := bitiox.Len(rootV.RootReader)
 	if err != nil {
 		return err
@@ -344,18 +348,24 @@ func dump(v *decode.Value, w io.Writer, opts *Options) error {
 			if opts.Depth != 0 && depth > opts.Depth {
 				return decode.ErrWalkSkipChildren
 			}
+			// decode lazy sub format value before walking into it
+			if err := v.Resolve(); err != nil {
+				return err
+			}
 
 			return fn(v, rootV, depth, rootDepth)
 		}
 	}
 
-	_ = v.WalkPreOrder(makeWalkFn(func(v *decode.Value, _ *decode.Value, _ int, rootDepth int) error {
+	if err := v.WalkPreOrder(makeWalkFn(func(v *decode.Value, _ *decode.Value, _ int, rootDepth int) error {
 		maxAddrIndentWidth = max(
 			maxAddrIndentWidth,
 			rootIndentWidth*rootDepth+mathx.DigitsInBase(bitio.BitsByteCount(v.InnerRange().Stop()), true, opts.Addrbase),
 		)
 		return nil
-	}))
+	})); err != nil {
+		return err
+	}
 
 	var displayLenFn func(s string) int
 	var displayTruncateFn func(s string, start, stop int) string
@@ -37,6 +37,7 @@ import (
 //go:embed options.jq
 //go:embed binary.jq
 //go:embed decode.jq
+//go:embed decoder.jq
 //go:embed registry_include.jq
 //go:embed format_decode.jq
 //go:embed format_func.jq
@@ -56,6 +57,7 @@ var initSource = `include "@builtin/init"; .`
 func init() {
 	RegisterIter1("_readline", (*Interp)._readline)
 	RegisterIter2("_eval", (*Interp)._eval)
+	RegisterFunc1("_has_include", (*Interp)._hasInclude)
 
 	RegisterIter2("_stdio_read", (*Interp)._stdioRead)
 	RegisterIter1("_stdio_write", (*Interp)._stdioWrite)
@@ -325,6 +327,12 @@ type EvalInstance struct {
 	IsCompleting bool
 
 	includeSeen map[string]struct{}
+	// context for reading opened files, eval context if nil
+	openCtx context.Context
+	// decode options used by decoders written in jq, see _decoderTree
+	decodeOpts *decodeOpts
+	// reused by _decoderRead for reads from the same binary
+	decoderReader *decoderReader
 }
 
 type Interp struct {
@@ -516,15 +524,17 @@ func (i *Interp) _readline(c any, opts readlineOpts) gojq.Iter {
 }
 
 type evalOpts struct {
-	Filename string
+	Filename   string
+	DecodeOpts *decodeOpts
 }
 
 func (i *Interp) _eval(c any, expr string, opts evalOpts) gojq.Iter {
 	var err error
 
 	iter, err := i.Eval(i.EvalInstance.Ctx, c, expr, EvalOpts{
-		filename: opts.Filename,
-		output:   i.EvalInstance.Output,
+		filename:   opts.Filename,
+		output:     i.EvalInstance.Output,
+		decodeOpts: opts.DecodeOpts,
 	})
 	if err != nil {
 		return gojq.NewIter(err)
@@ -533,6 +543,22 @@ func (i *Interp) _eval(c any, expr string, opts evalOpts) gojq.Iter {
 	return iter
 }
 
+// _hasInclude returns true if name.jq can be included
+func (i *Interp) _hasInclude(c any, name string) any {
+	filename := name + ".jq"
+	pr, err := i.lookupPathResolver(filename)
+	if err != nil {
+		return false
+	}
+	f, _, err := pr.open(strings.TrimPrefix(filename, pr.prefix))
+	if err != nil {
+		return false
+	}
+	f.Close()
+
+	return true
+}
+
 func (i *Interp) _extKeys(c any) any {
 	if v, ok := c.(Value); ok {
 		var vs []any
@@ -774,10 +800,28 @@ func (i *Interp) lookupPathResolver(filename string) (pathResolver, error) {
 	return pathResolver{}, fmt.Errorf("could not resolve path: %s", filename)
 }
 
+// interruptCtx returns the context of the innermost running eval, the one that
+// is cancelled on interrupt. Used for decoding that happens outside of a
+// function call, ex: resolving lazy values.
+func (i *Interp) interruptCtx() context.Context {
+	if ctx := i.interruptStack.Top(); ctx != nil {
+		return ctx
+	}
+	if i.EvalInstance.Ctx != nil {
+		return i.EvalInstance.Ctx
+	}
+	return context.Background()
+}
+
 type EvalOpts struct {
 	filename     string
 	output       io.Writer
 	isCompleting bool
+	// opened files are readable until openCtx is done instead of until the
+	// eval is done, inherited by sub evals
+	openCtx context.Context
+	// decode options for decoders written in jq, not inherited by sub evals
+	decodeOpts *decodeOpts
 }
 
 func (i *Interp) Eval(ctx context.Context, c any, expr string, opts EvalOpts) (gojq.Iter, error) {
@@ -967,6 +1011,11 @@ func (i *Interp) Eval(ctx context.Context, c any, expr string, opts EvalOpts) (g
 	ni.EvalInstance.Output = iox.CtxWriter{Writer: output, Ctx: runCtx}
 	// inherit or maybe set
 	ni.EvalInstance.IsCompleting = i.EvalInstance.IsCompleting || opts.isCompleting
+	ni.EvalInstance.openCtx = opts.openCtx
+	if ni.EvalInstance.openCtx == nil {
+		ni.EvalInstance.openCtx = i.EvalInstance.openCtx
+	}
+	ni.EvalInstance.decodeOpts = opts.decodeOpts
 	iter := gc.RunWithContext(runCtx, c, variableValues...)
 
 	iterWrapper := iterFn(func() (any, bool) {
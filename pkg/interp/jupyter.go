@@ -0,0 +1,619 @@
+package interp
+
+// Jupyter kernel used by --jupyter, see init.jq
+// Cells are evaluated with inputs as input, slurps and opened files are kept
+// between cells. Function definitions are only kept from cells with only
+// function definitions.
+// https://jupyter-client.readthedocs.io/en/stable/messaging.html
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wader/fq/internal/ansi"
+	"github.com/wader/fq/internal/gojqx"
+	"github.com/wader/fq/internal/iox"
+	"github.com/wader/fq/internal/mapstruct"
+	"github.com/wader/fq/internal/zmtp"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	RegisterIter2("_jupyter", (*Interp)._jupyter)
+}
+
+const (
+	jupyterProtocolVersion = "5.3"
+	jupyterDelimiter       = "<IDS|MSG>"
+)
+
+type jupyterConnection struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	StdinPort       int    `json:"stdin_port"`
+	ControlPort     int    `json:"control_port"`
+	HBPort          int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+}
+
+type jupyterHeader struct {
+	MsgID    string `json:"msg_id"`
+	Session  string `json:"session"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	MsgType  string `json:"msg_type"`
+	Version  string `json:"version"`
+}
+
+type jupyterMessage struct {
+	identities [][]byte
+	header     jupyterHeader
+	rawHeader  json.RawMessage
+	content    json.RawMessage
+}
+
+// jupyterOutput is stdout that writes to output of current cell
+type jupyterOutput struct {
+	Terminal
+	w io.Writer
+}
+
+func (o *jupyterOutput) Write(p []byte) (int, error) {
+	if o.w == nil {
+		return len(p), nil
+	}
+	return o.w.Write(p)
+}
+
+// jupyterOS makes print etc write to output of current cell
+type jupyterOS struct {
+	OS
+	stdout *jupyterOutput
+}
+
+func (o jupyterOS) Stdout() Output { return o.stdout }
+
+type jupyterKernel struct {
+	i       *Interp
+	stdout  *jupyterOutput
+	inputs  []any
+	version string
+	key     []byte
+	session string
+	iopub   *zmtp.Socket
+
+	executionCount int
+	defs           string
+
+	mu           sync.Mutex
+	execCancelFn context.CancelFunc
+}
+
+func jupyterID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+func (k *jupyterKernel) sign(parts ...[]byte) []byte {
+	if len(k.key) == 0 {
+		return nil
+	}
+	h := hmac.New(sha256.New, k.key)
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return []byte(hex.EncodeToString(h.Sum(nil)))
+}
+
+func (k *jupyterKernel) parse(frames [][]byte) (jupyterMessage, error) {
+	var m jupyterMessage
+	di := slices.IndexFunc(frames, func(f []byte) bool { return string(f) == jupyterDelimiter })
+	if di == -1 || len(frames) < di+6 {
+		return m, errors.New("invalid message")
+	}
+	parts := frames[di+2 : di+6]
+	if !hmac.Equal(k.sign(parts...), frames[di+1]) {
+		return m, errors.New("invalid signature")
+	}
+	if err := json.Unmarshal(parts[0], &m.header); err != nil {
+		return m, err
+	}
+	m.identities = frames[:di]
+	m.rawHeader = parts[0]
+	m.content = parts[3]
+
+	return m, nil
+}
+
+func (k *jupyterKernel) send(sock *zmtp.Socket, identities [][]byte, parent jupyterMessage, msgType string, content any) error {
+	header, err := json.Marshal(jupyterHeader{
+		MsgID:    jupyterID(),
+		Session:  k.session,
+		Username: "fq",
+		Date:     time.Now().UTC().Format(time.RFC3339Nano),
+		MsgType:  msgType,
+		Version:  jupyterProtocolVersion,
+	})
+	if err != nil {
+		return err
+	}
+	parentHeader := parent.rawHeader
+	if parentHeader == nil {
+		parentHeader = json.RawMessage("{}")
+	}
+	metadata := []byte("{}")
+	contentBs, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	frames := append([][]byte{}, identities...)
+	frames = append(frames,
+		[]byte(jupyterDelimiter),
+		k.sign(header, parentHeader, metadata, contentBs),
+		header,
+		parentHeader,
+		metadata,
+		contentBs,
+	)
+	return sock.Send(frames)
+}
+
+func (k *jupyterKernel) reply(sock *zmtp.Socket, m jupyterMessage, msgType string, content any) {
+	_ = k.send(sock, m.identities, m, msgType, content)
+}
+
+// publish on iopub using message type as topic
+func (k *jupyterKernel) publish(m jupyterMessage, msgType string, content any) {
+	_ = k.send(k.iopub, [][]byte{[]byte(msgType)}, m, msgType, content)
+}
+
+// publishOutput publishes output with ANSI codes as HTML and other output as
+// a stdout stream
+func (k *jupyterKernel) publishOutput(m jupyterMessage, s string) {
+	if strings.Contains(s, "\x1b[") {
+		k.publish(m, "display_data", map[string]any{
+			"data": map[string]any{
+				"text/html":  "<pre>" + ansi.ToHTML(s) + "</pre>",
+				"text/plain": ansi.Strip(s),
+			},
+			"metadata": map[string]any{},
+		})
+		return
+	}
+	k.publish(m, "stream", map[string]any{"name": "stdout", "text": s})
+}
+
+func (k *jupyterKernel) kernelInfo() map[string]any {
+	return map[string]any{
+		"status":                 "ok",
+		"protocol_version":       jupyterProtocolVersion,
+		"implementation":         "fq",
+		"implementation_version": k.version,
+		"language_info": map[string]any{
+			"name":           "jq",
+			"version":        k.version,
+			"mimetype":       "text/x-jq",
+			"file_extension": ".jq",
+		},
+		"banner":     "fq " + k.version,
+		"help_links": []any{},
+	}
+}
+
+// interrupt cancels current execution if any
+func (k *jupyterKernel) interrupt() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.execCancelFn != nil {
+		k.execCancelFn()
+	}
+}
+
+func jupyterErrorValue(err error) string {
+	var ve gojq.ValueError
+	if errors.As(err, &ve) {
+		if s, ok := ve.Value().(string); ok {
+			return s
+		}
+		if b, err := gojq.Marshal(ve.Value()); err == nil {
+			return string(b)
+		}
+	}
+	return err.Error()
+}
+
+// eval evaluates a cell and publishes outputs and results. Cells with only
+// function definitions are kept and prepended to later cells.
+func (k *jupyterKernel) eval(ctx context.Context, m jupyterMessage, code string, silent bool) error {
+	q, err := gojq.Parse(code)
+	if err == nil && q.Term == nil && q.Op == gojq.Operator(0) && len(q.Imports) == 0 {
+		if len(q.FuncDefs) > 0 {
+			k.defs += code + "\n"
+		}
+		return nil
+	}
+
+	execCtx, execCancelFn := context.WithCancel(ctx)
+	defer execCancelFn()
+	k.mu.Lock()
+	k.execCancelFn = execCancelFn
+	k.mu.Unlock()
+	defer func() {
+		k.mu.Lock()
+		k.execCancelFn = nil
+		k.mu.Unlock()
+	}()
+
+	output := &bytes.Buffer{}
+	k.stdout.w = output
+	defer func() { k.stdout.w = nil }()
+	flush := func() {
+		if output.Len() > 0 && !silent {
+			k.publishOutput(m, output.String())
+		}
+		output.Reset()
+	}
+
+	// files opened by a cell stay readable until the kernel exits
+	iter, err := k.i.EvalFunc(execCtx, k.inputs, "_jupyter_eval", []any{k.defs, code}, EvalOpts{
+		output:  output,
+		openCtx: ctx,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		flush()
+
+		if err, ok := v.(error); ok {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				return nil
+			}
+			return err
+		}
+		if silent {
+			continue
+		}
+
+		b, err := gojq.Marshal(v)
+		if err != nil {
+			return err
+		}
+		plain := &bytes.Buffer{}
+		if err := json.Indent(plain, b, "", "  "); err != nil {
+			return err
+		}
+		data := map[string]any{"text/plain": plain.String()}
+		// only objects and arrays as some frontends can't render other JSON
+		switch v.(type) {
+		case map[string]any, []any:
+			data["application/json"] = json.RawMessage(b)
+		}
+		k.publish(m, "execute_result", map[string]any{
+			"execution_count": k.executionCount,
+			"data":            data,
+			"metadata":        map[string]any{},
+		})
+	}
+	flush()
+
+	return nil
+}
+
+func (k *jupyterKernel) execute(ctx context.Context, sock *zmtp.Socket, m jupyterMessage) {
+	var req struct {
+		Code         string `json:"code"`
+		Silent       bool   `json:"silent"`
+		StoreHistory *bool  `json:"store_history"`
+	}
+	err := json.Unmarshal(m.content, &req)
+	if err == nil {
+		if !req.Silent && (req.StoreHistory == nil || *req.StoreHistory) {
+			k.executionCount++
+		}
+		if !req.Silent {
+			k.publish(m, "execute_input", map[string]any{
+				"code":            req.Code,
+				"execution_count": k.executionCount,
+			})
+		}
+		err = k.eval(ctx, m, req.Code, req.Silent)
+	}
+
+	r := map[string]any{
+		"status":          "ok",
+		"execution_count": k.executionCount,
+	}
+	if err != nil {
+		ename, evalue := "error", jupyterErrorValue(err)
+		if errors.Is(err, context.Canceled) {
+			ename, evalue = "interrupt", "interrupted"
+		}
+		errContent := map[string]any{
+			"ename":     ename,
+			"evalue":    evalue,
+			"traceback": []string{ansi.Red.Wrap("error") + ": " + evalue},
+		}
+		if !req.Silent {
+			k.publish(m, "error", errContent)
+		}
+		r["status"] = "error"
+		for ek, ev := range errContent {
+			r[ek] = ev
+		}
+	} else {
+		r["payload"] = []any{}
+		r["user_expressions"] = map[string]any{}
+	}
+	k.reply(sock, m, "execute_reply", r)
+}
+
+// complete completes code at cursor, cursor is in code points
+func (k *jupyterKernel) complete(ctx context.Context, m jupyterMessage) (map[string]any, error) {
+	var req struct {
+		Code      string `json:"code"`
+		CursorPos int    `json:"cursor_pos"`
+	}
+	if err := json.Unmarshal(m.content, &req); err != nil {
+		return nil, err
+	}
+	cursor := max(0, min(req.CursorPos, len([]rune(req.Code))))
+
+	vs, err := k.i.EvalFuncValues(ctx, k.inputs, "_complete", []any{req.Code, cursor}, EvalOpts{
+		output:       iox.DiscardCtxWriter{Ctx: ctx},
+		isCompleting: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) < 1 {
+		return nil, fmt.Errorf("no completions")
+	}
+	if err, ok := vs[0].(error); ok {
+		return nil, err
+	}
+	r, ok := gojqx.CastFn[completionResult](vs[0], mapstruct.ToStruct)
+	if !ok {
+		return nil, fmt.Errorf("completion result not a map")
+	}
+	if r.Names == nil {
+		r.Names = []string{}
+	}
+
+	return map[string]any{
+		"status":       "ok",
+		"matches":      r.Names,
+		"cursor_start": cursor - len([]rune(r.Prefix)),
+		"cursor_end":   cursor,
+		"metadata":     map[string]any{},
+	}, nil
+}
+
+func jupyterIsComplete(code string) map[string]any {
+	_, err := gojq.Parse(code)
+	var pe *gojq.ParseError
+	switch {
+	case err == nil:
+		return map[string]any{"status": "complete"}
+	case errors.As(err, &pe) && (pe.Error() == "unexpected EOF" || pe.Error() == "unterminated string literal"):
+		return map[string]any{"status": "incomplete", "indent": ""}
+	default:
+		return map[string]any{"status": "invalid"}
+	}
+}
+
+// handle handles a shell or control request, returns true on shutdown
+func (k *jupyterKernel) handle(ctx context.Context, sock *zmtp.Socket, m jupyterMessage) bool {
+	k.publish(m, "status", map[string]any{"execution_state": "busy"})
+	defer k.publish(m, "status", map[string]any{"execution_state": "idle"})
+
+	switch m.header.MsgType {
+	case "kernel_info_request":
+		k.reply(sock, m, "kernel_info_reply", k.kernelInfo())
+	case "execute_request":
+		k.execute(ctx, sock, m)
+	case "complete_request":
+		r, err := k.complete(ctx, m)
+		if err != nil {
+			r = map[string]any{"status": "error", "ename": "error", "evalue": err.Error(), "traceback": []string{}}
+		}
+		k.reply(sock, m, "complete_reply", r)
+	case "is_complete_request":
+		var req struct {
+			Code string `json:"code"`
+		}
+		_ = json.Unmarshal(m.content, &req)
+		k.reply(sock, m, "is_complete_reply", jupyterIsComplete(req.Code))
+	case "inspect_request":
+		k.reply(sock, m, "inspect_reply", map[string]any{"status": "ok", "found": false, "data": map[string]any{}, "metadata": map[string]any{}})
+	case "history_request":
+		k.reply(sock, m, "history_reply", map[string]any{"status": "ok", "history": []any{}})
+	case "comm_info_request":
+		k.reply(sock, m, "comm_info_reply", map[string]any{"status": "ok", "comms": map[string]any{}})
+	case "interrupt_request":
+		k.interrupt()
+		k.reply(sock, m, "interrupt_reply", map[string]any{"status": "ok"})
+	case "shutdown_request":
+		var req struct {
+			Restart bool `json:"restart"`
+		}
+		_ = json.Unmarshal(m.content, &req)
+		k.reply(sock, m, "shutdown_reply", map[string]any{"status": "ok", "restart": req.Restart})
+		return true
+	}
+
+	return false
+}
+
+func readJupyterConnection(i *Interp, path string) (jupyterConnection, error) {
+	var c jupyterConnection
+	f, err := i.OS.FS().Open(path)
+	if err != nil {
+		var pe *fs.PathError
+		if errors.As(err, &pe) {
+			err = pe.Err
+		}
+		return c, fmt.Errorf("%s: %w", path, err)
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("%s: %w", path, err)
+	}
+	if c.Transport != "tcp" {
+		return c, fmt.Errorf("%s: transport %q not supported", path, c.Transport)
+	}
+	if c.Key != "" && c.SignatureScheme != "hmac-sha256" {
+		return c, fmt.Errorf("%s: signature scheme %q not supported", path, c.SignatureScheme)
+	}
+	return c, nil
+}
+
+// _jupyter runs a Jupyter kernel until shutdown or interrupted, c is array of
+// inputs used as input for cells
+func (i *Interp) _jupyter(c any, path string, version string) gojq.Iter {
+	conn, err := readJupyterConnection(i, path)
+	if err != nil {
+		return gojq.NewIter(fmt.Errorf("jupyter: %w", err))
+	}
+
+	// interrupt or shutdown stops the kernel but not the eval calling _jupyter
+	interruptCtx, interruptCancelFn := i.interruptStack.Push(i.EvalInstance.Ctx)
+	defer interruptCancelFn()
+	ctx, shutdownFn := context.WithCancel(interruptCtx)
+	defer shutdownFn()
+
+	var socks []*zmtp.Socket
+	defer func() {
+		for _, s := range socks {
+			s.Close()
+		}
+	}()
+	listen := func(typ string, port int) (*zmtp.Socket, error) {
+		s, err := zmtp.Listen(typ, fmt.Sprintf("tcp://%s:%d", conn.IP, port))
+		if err != nil {
+			return nil, fmt.Errorf("jupyter: %w", err)
+		}
+		socks = append(socks, s)
+		return s, nil
+	}
+	shell, err := listen(zmtp.Router, conn.ShellPort)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	control, err := listen(zmtp.Router, conn.ControlPort)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	// input requests are not supported but clients expect to connect
+	if _, err := listen(zmtp.Router, conn.StdinPort); err != nil {
+		return gojq.NewIter(err)
+	}
+	iopub, err := listen(zmtp.Pub, conn.IOPubPort)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	hb, err := listen(zmtp.Rep, conn.HBPort)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+
+	// cells and completion expects array of inputs
+	inputs, ok := c.([]any)
+	if !ok || inputs == nil {
+		inputs = []any{}
+	}
+
+	stdout := &jupyterOutput{Terminal: i.OS.Stdout()}
+	ki := *i
+	ki.OS = jupyterOS{OS: i.OS, stdout: stdout}
+
+	k := &jupyterKernel{
+		i:       &ki,
+		stdout:  stdout,
+		inputs:  inputs,
+		version: version,
+		key:     []byte(conn.Key),
+		session: jupyterID(),
+		iopub:   iopub,
+	}
+
+	// close sockets to unblock receives
+	go func() {
+		<-ctx.Done()
+		for _, s := range socks {
+			s.Close()
+		}
+	}()
+	go func() {
+		for {
+			msg, err := hb.Recv()
+			if err != nil {
+				return
+			}
+			_ = hb.Send(msg)
+		}
+	}()
+	// control requests are handled while shell is busy evaluating
+	go func() {
+		for {
+			frames, err := control.Recv()
+			if err != nil {
+				return
+			}
+			m, err := k.parse(frames)
+			if err != nil {
+				continue
+			}
+			switch m.header.MsgType {
+			case "kernel_info_request", "interrupt_request", "shutdown_request":
+				if k.handle(ctx, control, m) {
+					shutdownFn()
+					return
+				}
+			}
+		}
+	}()
+
+	k.publish(jupyterMessage{}, "status", map[string]any{"execution_state": "starting"})
+
+	for {
+		frames, err := shell.Recv()
+		if err != nil {
+			return gojq.NewIter()
+		}
+		m, err := k.parse(frames)
+		if err != nil {
+			continue
+		}
+		if k.handle(ctx, shell, m) {
+			return gojq.NewIter()
+		}
+	}
+}
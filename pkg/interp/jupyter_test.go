@@ -0,0 +1,296 @@
+package interp_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wader/fq/internal/script"
+	"github.com/wader/fq/internal/zmtp"
+	"github.com/wader/fq/pkg/interp"
+)
+
+const jupyterTestKey = "secret"
+
+type jupyterTestMessage struct {
+	header       map[string]any
+	parentHeader map[string]any
+	content      map[string]any
+}
+
+func jupyterTestSign(key string, parts ...[]byte) []byte {
+	h := hmac.New(sha256.New, []byte(key))
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return []byte(hex.EncodeToString(h.Sum(nil)))
+}
+
+func jupyterTestFrames(t *testing.T, key string, msgID string, msgType string, content any) [][]byte {
+	t.Helper()
+	header, err := json.Marshal(map[string]any{
+		"msg_id":   msgID,
+		"session":  "test",
+		"username": "test",
+		"msg_type": msgType,
+		"version":  "5.3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentBs, err := json.Marshal(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := []byte("{}")
+	metadata := []byte("{}")
+	return [][]byte{
+		[]byte("<IDS|MSG>"),
+		jupyterTestSign(key, header, parent, metadata, contentBs),
+		header,
+		parent,
+		metadata,
+		contentBs,
+	}
+}
+
+func jupyterTestParse(t *testing.T, frames [][]byte) jupyterTestMessage {
+	t.Helper()
+	// skip topic or identities
+	di := -1
+	for i, f := range frames {
+		if string(f) == "<IDS|MSG>" {
+			di = i
+			break
+		}
+	}
+	if di == -1 || len(frames) < di+6 {
+		t.Fatalf("invalid message %q", frames)
+	}
+	parts := frames[di+2 : di+6]
+	if string(jupyterTestSign(jupyterTestKey, parts...)) != string(frames[di+1]) {
+		t.Fatalf("invalid signature %q", frames)
+	}
+	var m jupyterTestMessage
+	for _, p := range []struct {
+		b []byte
+		v *map[string]any
+	}{
+		{parts[0], &m.header},
+		{parts[1], &m.parentHeader},
+		{parts[3], &m.content},
+	} {
+		if err := json.Unmarshal(p.b, p.v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+func jupyterTestRecv(t *testing.T, ch chan [][]byte) jupyterTestMessage {
+	t.Helper()
+	select {
+	case frames := <-ch:
+		return jupyterTestParse(t, frames)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for message")
+	}
+	panic("unreachable")
+}
+
+func jupyterTestRecvChan(s *zmtp.Socket) chan [][]byte {
+	ch := make(chan [][]byte, 100)
+	go func() {
+		for {
+			frames, err := s.Recv()
+			if err != nil {
+				close(ch)
+				return
+			}
+			ch <- frames
+		}
+	}()
+	return ch
+}
+
+func jupyterTestFreePorts(t *testing.T, n int) []int {
+	t.Helper()
+	var ports []int
+	var ls []net.Listener
+	for range n {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ls = append(ls, l)
+		ports = append(ports, l.Addr().(*net.TCPAddr).Port)
+	}
+	for _, l := range ls {
+		l.Close()
+	}
+	return ports
+}
+
+func jupyterTestDial(t *testing.T, typ string, port int) *zmtp.Socket {
+	t.Helper()
+	endpoint := fmt.Sprintf("tcp://127.0.0.1:%d", port)
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		s, err := zmtp.Dial(typ, endpoint, nil)
+		if err == nil {
+			t.Cleanup(func() { s.Close() })
+			return s
+		}
+		if time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestJupyterKernel(t *testing.T) {
+	ports := jupyterTestFreePorts(t, 5)
+	conn, err := json.Marshal(map[string]any{
+		"transport":        "tcp",
+		"ip":               "127.0.0.1",
+		"shell_port":       ports[0],
+		"iopub_port":       ports[1],
+		"stdin_port":       ports[2],
+		"control_port":     ports[3],
+		"hb_port":          ports[4],
+		"key":              jupyterTestKey,
+		"signature_scheme": "hmac-sha256",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := script.ParseCases("$ fq -n --jupyter /conn.json\n/conn.json:\n" + string(conn) + "\n")
+	c.Path = "testdata/jupyter.fqtest"
+	var cr *script.CaseRun
+	for _, p := range c.Parts {
+		if r, ok := p.(*script.CaseRun); ok {
+			cr = r
+		}
+	}
+
+	i, err := interp.New(cr, interp.DefaultRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	mainDone := make(chan error, 1)
+	go func() { mainDone <- i.Main(ctx, cr.Stdout(), "testversion") }()
+
+	shell := jupyterTestDial(t, zmtp.Dealer, ports[0])
+	iopub := jupyterTestDial(t, zmtp.Sub, ports[1])
+	if err := iopub.Subscribe(nil); err != nil {
+		t.Fatal(err)
+	}
+	shellCh := jupyterTestRecvChan(shell)
+	iopubCh := jupyterTestRecvChan(iopub)
+
+	// messages published before the subscription is active are dropped so
+	// request kernel info until a status message is received
+	for n := 0; ; n++ {
+		if n == 100 {
+			t.Fatal("no iopub messages received")
+		}
+		if err := shell.Send(jupyterTestFrames(t, jupyterTestKey, fmt.Sprintf("info%d", n), "kernel_info_request", map[string]any{})); err != nil {
+			t.Fatal(err)
+		}
+		if r := jupyterTestRecv(t, shellCh); r.header["msg_type"] != "kernel_info_reply" {
+			t.Fatalf("unexpected reply %v", r.header)
+		}
+		select {
+		case <-iopubCh:
+		case <-time.After(100 * time.Millisecond):
+			continue
+		}
+		break
+	}
+	// drain status messages from kernel info requests
+	for len(iopubCh) > 0 {
+		<-iopubCh
+	}
+
+	execute := map[string]any{"code": `"hello" | println, 1+1`, "silent": false}
+	// bad signature is ignored, no reply and nothing published
+	if err := shell.Send(jupyterTestFrames(t, "wrong", "bad", "execute_request", execute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := shell.Send(jupyterTestFrames(t, jupyterTestKey, "exec", "execute_request", execute)); err != nil {
+		t.Fatal(err)
+	}
+
+	reply := jupyterTestRecv(t, shellCh)
+	if reply.header["msg_type"] != "execute_reply" || reply.parentHeader["msg_id"] != "exec" {
+		t.Fatalf("expected execute_reply for exec, got %v %v", reply.header, reply.parentHeader)
+	}
+	if reply.content["status"] != "ok" {
+		t.Fatalf("expected status ok, got %v", reply.content)
+	}
+
+	var stream string
+	var result any
+	for stream == "" || result == nil {
+		m := jupyterTestRecv(t, iopubCh)
+		if m.parentHeader["msg_id"] != "exec" {
+			t.Fatalf("unexpected message for other request %v %v", m.header, m.parentHeader)
+		}
+		switch m.header["msg_type"] {
+		case "stream":
+			stream, _ = m.content["text"].(string)
+		case "execute_result":
+			result = m.content["data"].(map[string]any)["text/plain"]
+		}
+	}
+	if stream != "hello\n" {
+		t.Errorf("expected stream %q, got %q", "hello\n", stream)
+	}
+	if result != "2" {
+		t.Errorf("expected result %q, got %q", "2", result)
+	}
+
+	// cells with only definitions are kept for later cells
+	for _, e := range []struct {
+		msgID string
+		code  string
+	}{
+		{"def", "def f: 123;"},
+		{"use", "f"},
+	} {
+		if err := shell.Send(jupyterTestFrames(t, jupyterTestKey, e.msgID, "execute_request", map[string]any{"code": e.code, "silent": false})); err != nil {
+			t.Fatal(err)
+		}
+		if r := jupyterTestRecv(t, shellCh); r.content["status"] != "ok" {
+			t.Fatalf("expected status ok for %q, got %v", e.code, r.content)
+		}
+	}
+	result = nil
+	for result == nil {
+		m := jupyterTestRecv(t, iopubCh)
+		if m.parentHeader["msg_id"] == "use" && m.header["msg_type"] == "execute_result" {
+			result = m.content["data"].(map[string]any)["text/plain"]
+		}
+	}
+	if result != "123" {
+		t.Errorf("expected result %q, got %q", "123", result)
+	}
+
+	if err := shell.Send(jupyterTestFrames(t, jupyterTestKey, "shutdown", "shutdown_request", map[string]any{"restart": false})); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-mainDone:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for kernel shutdown")
+	}
+}
@@ -0,0 +1,416 @@
+package interp
+
+// JSON-RPC 2.0 over stdio used by --rpc, see init.jq
+// Messages are one JSON object per line. Shares decode value state with --serve.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/internal/gojqx"
+	"github.com/wader/fq/internal/iox"
+	"github.com/wader/fq/internal/mapstruct"
+	"github.com/wader/gojq"
+)
+
+func init() {
+	RegisterIter1("_rpc", (*Interp)._rpc)
+}
+
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcError          = -32000
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type rpcResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Result  any               `json:"result,omitempty"`
+	Error   *rpcResponseError `json:"error,omitempty"`
+}
+
+type rpcCodeError struct {
+	code int
+	err  error
+}
+
+func (e rpcCodeError) Error() string { return e.err.Error() }
+func (e rpcCodeError) Unwrap() error { return e.err }
+
+type rpcMethod func(s *serveState, ctx context.Context, params json.RawMessage) (any, error)
+
+var rpcMethods = map[string]rpcMethod{
+	"open":     (*serveState).rpcOpen,
+	"close":    (*serveState).rpcClose,
+	"roots":    (*serveState).rpcRoots,
+	"node":     (*serveState).rpcNode,
+	"eval":     (*serveState).rpcEval,
+	"bytes":    (*serveState).rpcBytes,
+	"hexdump":  (*serveState).rpcHexdump,
+	"complete": (*serveState).rpcComplete,
+}
+
+func rpcParams[T any](params json.RawMessage) (T, error) {
+	var p T
+	if len(params) == 0 {
+		return p, nil
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			field := typeErr.Field
+			if field == "" {
+				field = "params"
+			}
+			err = fmt.Errorf("%s: can't be a %s", field, typeErr.Value)
+		}
+		return p, rpcCodeError{code: rpcInvalidParams, err: err}
+	}
+	return p, nil
+}
+
+// evalFuncValue calls function name and returns its first output
+func (s *serveState) evalFuncValue(ctx context.Context, c any, name string, args []any) (any, error) {
+	vs, err := s.i.EvalFuncValues(ctx, c, name, args, EvalOpts{output: iox.DiscardCtxWriter{Ctx: ctx}})
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) < 1 {
+		return nil, fmt.Errorf("%s: no value", name)
+	}
+	if err, ok := vs[0].(error); ok {
+		return nil, err
+	}
+	return vs[0], nil
+}
+
+// rpcOpen opens and decodes a file and adds it as a root
+func (s *serveState) rpcOpen(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		Path    string         `json:"path"`
+		Format  string         `json:"format"`
+		Options map[string]any `json:"options"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	if p.Format == "" {
+		p.Format = "probe"
+	}
+	if p.Options == nil {
+		p.Options = map[string]any{}
+	}
+
+	// open using interp calling _rpc so that the file is not closed when the
+	// eval for this request is done
+	f := s.i._open(p.Path)
+	if err, ok := f.(error); ok {
+		return nil, fmt.Errorf("%s: %w", p.Path, err)
+	}
+	v, err := s.evalFuncValue(ctx, f, "decode", []any{p.Format, p.Options})
+	if err != nil {
+		return nil, err
+	}
+	dv, ok := v.(DecodeValue)
+	if !ok {
+		return nil, fmt.Errorf("%s: not a decode value", p.Path)
+	}
+	id := s.id(dv.DecodeValue())
+	s.roots = append(s.roots, id)
+
+	return s.node(s.values[id]), nil
+}
+
+// rpcClose removes a root and forgets all its values
+func (s *serveState) rpcClose(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		Root int `json:"root"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(s.roots, p.Root) {
+		return nil, fmt.Errorf("%d: no such root", p.Root)
+	}
+	rootV := s.values[p.Root]
+	s.roots = slices.DeleteFunc(s.roots, func(id int) bool { return id == p.Root })
+	for id, v := range s.values {
+		if v != nil && v.Root() == rootV {
+			s.values[id] = nil
+			delete(s.ids, v)
+		}
+	}
+
+	return true, nil
+}
+
+func (s *serveState) rpcRoots(ctx context.Context, params json.RawMessage) (any, error) {
+	return s.rootNodes(), nil
+}
+
+func (s *serveState) rpcNode(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		ID     int `json:"id"`
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.value(p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nodeResult(v, p.Offset, p.Limit), nil
+}
+
+// rpcEval evaluates an expression with a root as input, or null if no root
+func (s *serveState) rpcEval(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		Root *int   `json:"root"`
+		Expr string `json:"expr"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	var c any
+	if p.Root != nil {
+		v, err := s.value(*p.Root)
+		if err != nil {
+			return nil, err
+		}
+		c = makeDecodeValue(v, decodeValueValue)
+	}
+
+	return s.query(ctx, c, p.Expr)
+}
+
+func (s *serveState) rpcBytes(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		Buffer int   `json:"buffer"`
+		Start  int64 `json:"start"`
+		Len    int64 `json:"len"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.value(p.Buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.hexResult(v, p.Start, p.Len)
+}
+
+// rpcHexdump returns a hexdump of the bit range of a value, or a sub range of
+// its buffer if start and stop are given
+func (s *serveState) rpcHexdump(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		ID           int    `json:"id"`
+		Start        *int64 `json:"start"`
+		Stop         *int64 `json:"stop"`
+		LineBytes    int    `json:"line_bytes"`
+		DisplayBytes *int   `json:"display_bytes"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.value(p.ID)
+	if err != nil {
+		return nil, err
+	}
+	r := v.Range
+	if p.Start != nil {
+		r.Start = *p.Start
+	}
+	if p.Stop != nil {
+		r.Len = *p.Stop - r.Start
+	}
+	bitsLen, err := bitiox.Len(v.RootReader)
+	if err != nil {
+		return nil, err
+	}
+	if r.Start < 0 || r.Len < 0 || r.Stop() > bitsLen {
+		return nil, fmt.Errorf("%d-%d: range outside of buffer", r.Start, r.Stop())
+	}
+
+	opts := *s.opts
+	if p.LineBytes > 0 {
+		opts.LineBytes = p.LineBytes
+	}
+	if p.DisplayBytes != nil {
+		opts.DisplayBytes = max(0, *p.DisplayBytes)
+	}
+	buf := &bytes.Buffer{}
+	if err := hexdump(buf, Binary{br: v.RootReader, r: r, unit: 8}, &opts); err != nil {
+		return nil, err
+	}
+
+	return buf.String(), nil
+}
+
+// rpcComplete completes an expression, same as in the REPL
+func (s *serveState) rpcComplete(ctx context.Context, params json.RawMessage) (any, error) {
+	p, err := rpcParams[struct {
+		Root *int   `json:"root"`
+		Line string `json:"line"`
+		Pos  *int   `json:"pos"`
+	}](params)
+	if err != nil {
+		return nil, err
+	}
+	var c any
+	if p.Root != nil {
+		v, err := s.value(*p.Root)
+		if err != nil {
+			return nil, err
+		}
+		c = makeDecodeValue(v, decodeValueValue)
+	}
+	pos := len([]rune(p.Line))
+	if p.Pos != nil {
+		pos = *p.Pos
+	}
+
+	vs, err := s.i.EvalFuncValues(ctx, []any{c}, "_complete", []any{p.Line, pos}, EvalOpts{
+		output:       iox.DiscardCtxWriter{Ctx: ctx},
+		isCompleting: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) < 1 {
+		return nil, fmt.Errorf("no completions")
+	}
+	if err, ok := vs[0].(error); ok {
+		return nil, err
+	}
+	r, ok := gojqx.CastFn[completionResult](vs[0], mapstruct.ToStruct)
+	if !ok {
+		return nil, fmt.Errorf("completion result not a map")
+	}
+	if r.Names == nil {
+		r.Names = []string{}
+	}
+
+	return map[string]any{"prefix": r.Prefix, "names": r.Names}, nil
+}
+
+func (s *serveState) rpcHandle(ctx context.Context, line []byte) *rpcResponse {
+	resp := &rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null")}
+
+	var req rpcRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		resp.Error = &rpcResponseError{Code: rpcParseError, Message: err.Error()}
+		return resp
+	}
+	if req.ID != nil {
+		resp.ID = req.ID
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		resp.Error = &rpcResponseError{Code: rpcInvalidRequest, Message: "invalid request"}
+		return resp
+	}
+	m, ok := rpcMethods[req.Method]
+	var result any
+	var err error
+	if ok {
+		result, err = m(s, ctx, req.Params)
+	} else {
+		err = rpcCodeError{code: rpcMethodNotFound, err: fmt.Errorf("%s: no such method", req.Method)}
+	}
+	// no response for notifications
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		code := rpcError
+		var codeErr rpcCodeError
+		if errors.As(err, &codeErr) {
+			code = codeErr.code
+		}
+		resp.Error = &rpcResponseError{Code: code, Message: err.Error()}
+		return resp
+	}
+	resp.Result = result
+
+	return resp
+}
+
+// _rpc reads JSON-RPC requests from stdin and writes responses to output until
+// end of input or interrupted. Decode values in input are added as roots.
+func (i *Interp) _rpc(c any, v any) gojq.Iter {
+	s, err := newServeState(i, c, v)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+
+	// interrupt stops reading requests but not the eval calling _rpc
+	ctx, cancelFn := i.interruptStack.Push(i.EvalInstance.Ctx)
+	defer cancelFn()
+
+	type readResult struct {
+		line []byte
+		err  error
+	}
+	lineCh := make(chan readResult)
+	go func() {
+		br := bufio.NewReader(i.OS.Stdin())
+		for {
+			line, err := br.ReadBytes('\n')
+			select {
+			case lineCh <- readResult{line: line, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	enc := json.NewEncoder(i.EvalInstance.Output)
+	for {
+		var r readResult
+		select {
+		case r = <-lineCh:
+		case <-ctx.Done():
+			return gojq.NewIter()
+		}
+		if len(bytes.TrimSpace(r.line)) > 0 {
+			if resp := s.rpcHandle(ctx, r.line); resp != nil {
+				if err := enc.Encode(resp); err != nil {
+					return gojq.NewIter(err)
+				}
+			}
+		}
+		if errors.Is(r.err, io.EOF) {
+			return gojq.NewIter()
+		} else if r.err != nil {
+			return gojq.NewIter(r.err)
+		}
+	}
+}
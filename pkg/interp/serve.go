@@ -0,0 +1,572 @@
+package interp
+
+// Local web UI used by --serve, see init.jq
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/wader/fq/internal/bitiox"
+	"github.com/wader/fq/pkg/decode"
+	"github.com/wader/fq/pkg/scalar"
+	"github.com/wader/gojq"
+)
+
+//go:embed serve.html
+var serveHTML []byte
+
+const (
+	serveChildrenLimit = 1000
+	serveHexMaxLen     = 64 * 1024
+	serveQueryLimit    = 1000
+	serveTokenHeader   = "X-Fq-Token"
+)
+
+func init() {
+	RegisterIter2("_serve", (*Interp)._serve)
+}
+
+// serveState keeps track of decode values seen by the UI. Values are
+// referenced by id, which is the index in values.
+// All access to decode values and evals are serialized using mu as lazy
+// values are resolved in place and the interp is not safe for concurrent use.
+type serveState struct {
+	i     *Interp
+	opts  *Options
+	roots []int
+
+	// api requests need the token from the printed URL and a Host header
+	// matching the listen address, otherwise any website or a DNS rebinding
+	// attack could use the query box to run expressions, ex: read files
+	token string
+	hosts []string
+	port  string // any IP host with this port is allowed if listening on all interfaces
+
+	mu     sync.Mutex
+	values []*decode.Value
+	ids    map[*decode.Value]int
+}
+
+type serveNode struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Type        string `json:"type"`
+	Value       string `json:"value,omitempty"`
+	Sym         string `json:"sym,omitempty"`
+	Description string `json:"description,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Buffer      int    `json:"buffer"`
+	Start       int64  `json:"start"`
+	Stop        int64  `json:"stop"`
+	Children    int    `json:"children"`
+	Synthetic   bool   `json:"synthetic,omitempty"`
+	Overlaps    bool   `json:"overlaps,omitempty"`
+}
+
+// newServeState returns state with decode values in c, an array or a value,
+// as roots. Other values are ignored.
+func newServeState(i *Interp, c any, v any) (*serveState, error) {
+	opts, err := OptionsFromValue(v)
+	if err != nil {
+		return nil, err
+	}
+	// no ANSI codes in strings sent to clients
+	opts.Color = false
+	opts.Decorator = decoratorFromOptions(*opts)
+
+	s := &serveState{
+		i:    i,
+		opts: opts,
+		ids:  map[*decode.Value]int{},
+	}
+	vs, ok := c.([]any)
+	if !ok {
+		vs = []any{c}
+	}
+	for _, v := range vs {
+		if dv, ok := v.(DecodeValue); ok {
+			s.roots = append(s.roots, s.id(dv.DecodeValue()))
+		}
+	}
+
+	return s, nil
+}
+
+func (s *serveState) id(v *decode.Value) int {
+	if id, ok := s.ids[v]; ok {
+		return id
+	}
+	id := len(s.values)
+	s.values = append(s.values, v)
+	s.ids[v] = id
+	return id
+}
+
+func (s *serveState) value(id int) (*decode.Value, error) {
+	if id < 0 || id >= len(s.values) || s.values[id] == nil {
+		return nil, fmt.Errorf("%d: no such value", id)
+	}
+	return s.values[id], nil
+}
+
+func (s *serveState) node(v *decode.Value) serveNode {
+	// decode lazy sub format value on first access
+	resolveErr := v.Resolve()
+
+	n := serveNode{
+		ID:     s.id(v),
+		Name:   v.Name,
+		Path:   valuePathExprDecorated(v, Decorator{}),
+		Buffer: s.id(v.BufferRoot()),
+		Start:  v.Range.Start,
+		Stop:   v.Range.Stop(),
+	}
+	if v.Parent != nil {
+		if pc, ok := v.Parent.V.(*decode.Compound); ok && pc.IsArray {
+			n.Name = "[" + strconv.Itoa(v.Index) + "]"
+		}
+	}
+	if v.Format != nil {
+		n.Format = v.Format.Name
+	}
+	if v.Err != nil {
+		n.Error = v.Err.Error()
+	}
+	if resolveErr != nil {
+		n.Error = resolveErr.Error()
+	}
+
+	switch vv := v.V.(type) {
+	case *decode.Compound:
+		n.Type = "struct"
+		if vv.IsArray {
+			n.Type = "array"
+		}
+		n.Description = vv.Description
+		n.Children = len(vv.Children)
+	case scalar.Scalarable:
+		n.Type = "scalar"
+		df := vv.ScalarDisplayFormat()
+		n.Value = previewValue(vv.ScalarActual(), df, s.opts)
+		if sym := vv.ScalarSym(); sym != nil {
+			n.Sym = previewValue(sym, scalar.NumberDecimal, s.opts)
+		}
+		n.Description = vv.ScalarDescription()
+		n.Synthetic = vv.ScalarFlags().IsSynthetic()
+	}
+
+	return n
+}
+
+// markOverlaps sets Overlaps for nodes with a range that overlaps the range of
+// a sibling. Sweeps in start order so it marks at least both values of the
+// overlap with the furthest reaching previous sibling.
+func markOverlaps(ns []serveNode) {
+	idxs := make([]int, 0, len(ns))
+	for i, n := range ns {
+		if n.Synthetic || n.Stop == n.Start {
+			continue
+		}
+		idxs = append(idxs, i)
+	}
+	slices.SortStableFunc(idxs, func(a, b int) int { return int(ns[a].Start - ns[b].Start) })
+
+	maxIdx := -1
+	for _, i := range idxs {
+		if maxIdx != -1 && ns[i].Start < ns[maxIdx].Stop {
+			ns[i].Overlaps = true
+			ns[maxIdx].Overlaps = true
+		}
+		if maxIdx == -1 || ns[i].Stop > ns[maxIdx].Stop {
+			maxIdx = i
+		}
+	}
+}
+
+func (s *serveState) rootNodes() []serveNode {
+	ns := []serveNode{}
+	for _, id := range s.roots {
+		ns = append(ns, s.node(s.values[id]))
+	}
+	return ns
+}
+
+type serveNodeResult struct {
+	Node     serveNode   `json:"node"`
+	Offset   int         `json:"offset"`
+	Children []serveNode `json:"children"`
+	Parents  []int       `json:"parents"`
+}
+
+// nodeResult returns a node and a page of its children
+func (s *serveState) nodeResult(v *decode.Value, offset int, limit int) serveNodeResult {
+	offset = max(0, offset)
+	if limit <= 0 {
+		limit = serveChildrenLimit
+	}
+
+	r := serveNodeResult{
+		Node:     s.node(v),
+		Offset:   offset,
+		Children: []serveNode{},
+		Parents:  []int{},
+	}
+	if c, ok := v.V.(*decode.Compound); ok {
+		for _, cv := range c.Children[min(offset, len(c.Children)):min(offset+limit, len(c.Children))] {
+			r.Children = append(r.Children, s.node(cv))
+		}
+	}
+	markOverlaps(r.Children)
+	for pv := v.Parent; pv != nil; pv = pv.Parent {
+		r.Parents = append([]int{s.id(pv)}, r.Parents...)
+	}
+
+	return r
+}
+
+type serveHexResult struct {
+	Size  int64  `json:"size"`
+	Start int64  `json:"start"`
+	Hex   string `json:"hex"`
+}
+
+// hexResult returns hex encoded bytes of the buffer of a value
+func (s *serveState) hexResult(v *decode.Value, start int64, n int64) (serveHexResult, error) {
+	bitsLen, err := bitiox.Len(v.RootReader)
+	if err != nil {
+		return serveHexResult{}, err
+	}
+	size := (bitsLen + 7) / 8
+	start = min(max(0, start), size)
+	n = min(max(0, n), serveHexMaxLen, size-start)
+
+	br, err := bitiox.Range(v.RootReader, start*8, min(n*8, bitsLen-start*8))
+	if err != nil {
+		return serveHexResult{}, err
+	}
+	buf := &bytes.Buffer{}
+	if _, err := bitiox.CopyBits(buf, br); err != nil {
+		return serveHexResult{}, err
+	}
+
+	return serveHexResult{
+		Size:  size,
+		Start: start,
+		Hex:   hex.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+type serveQueryResult struct {
+	Node  *serveNode      `json:"node,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+type serveQueryResponse struct {
+	Results   []serveQueryResult `json:"results"`
+	Truncated bool               `json:"truncated"`
+	Output    string             `json:"output"`
+}
+
+// query evaluates an expression with c as input. Evaluation stops at first
+// error.
+func (s *serveState) query(ctx context.Context, c any, expr string) (serveQueryResponse, error) {
+	output := &bytes.Buffer{}
+	iter, err := s.i.Eval(ctx, c, expr, EvalOpts{
+		filename: "query",
+		output:   output,
+	})
+	if err != nil {
+		return serveQueryResponse{}, err
+	}
+
+	optsFn := func() (*Options, error) { return s.opts, nil }
+	r := serveQueryResponse{Results: []serveQueryResult{}}
+loop:
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if len(r.Results) >= serveQueryLimit {
+			r.Truncated = true
+			break
+		}
+
+		var res serveQueryResult
+		switch v := v.(type) {
+		case error:
+			var haltErr *gojq.HaltError
+			if errors.As(v, &haltErr) && haltErr.Value() == nil {
+				break loop
+			}
+			res.Error = v.Error()
+		default:
+			if dv, ok := v.(DecodeValue); ok {
+				n := s.node(dv.DecodeValue())
+				res.Node = &n
+			}
+			gv, err := toValue(optsFn, v)
+			if err == nil {
+				var b []byte
+				if b, err = gojq.Marshal(gv); err == nil {
+					res.Value = b
+				}
+			}
+			if err != nil {
+				res.Error = err.Error()
+			}
+		}
+		r.Results = append(r.Results, res)
+		if res.Error != "" {
+			break
+		}
+	}
+	r.Output = output.String()
+
+	return r, nil
+}
+
+func newServeToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// setAddr sets hosts allowed in the Host header for a listen address and the
+// address actually listened on
+func (s *serveState) setAddr(listenAddr string, addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	listenHost, _, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return err
+	}
+	// listen address with actual port in case of port 0
+	s.hosts = []string{addr, net.JoinHostPort(listenHost, port)}
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		s.port = port
+	}
+	return nil
+}
+
+func (s *serveState) allowedHost(h string) bool {
+	if slices.Contains(s.hosts, h) {
+		return true
+	}
+	if s.port == "" {
+		return false
+	}
+	// DNS rebinding needs a domain name so IP addresses are fine
+	host, port, err := net.SplitHostPort(h)
+	return err == nil && port == s.port && net.ParseIP(host) != nil
+}
+
+func (s *serveState) checkHost(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.allowedHost(r.Host) {
+			http.Error(w, "invalid host", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *serveState) checkToken(next http.HandlerFunc) http.HandlerFunc {
+	return s.checkHost(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(serveTokenHeader)), []byte(s.token)) != 1 {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	})
+}
+
+// checkSameOrigin requires a JSON body and an Origin matching Host, a cross
+// origin request with JSON content type needs a preflight which is not allowed
+func checkSameOrigin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mt != "application/json" {
+			http.Error(w, "content type should be application/json", http.StatusUnsupportedMediaType)
+			return
+		}
+		u, err := url.Parse(r.Header.Get("Origin"))
+		if err != nil || u.Scheme != "http" || u.Host != r.Host {
+			http.Error(w, "invalid origin", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *serveState) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.checkHost(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(serveHTML)
+	}))
+	mux.HandleFunc("/api/roots", s.checkToken(s.handleRoots))
+	mux.HandleFunc("/api/node", s.checkToken(s.handleNode))
+	mux.HandleFunc("/api/hex", s.checkToken(s.handleHex))
+	mux.HandleFunc("/api/query", s.checkToken(checkSameOrigin(s.handleQuery)))
+
+	return mux
+}
+
+func serveJSON(w http.ResponseWriter, v any, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		v = map[string]any{"error": err.Error()}
+	}
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *serveState) handleRoots(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	serveJSON(w, s.rootNodes(), nil)
+}
+
+func (s *serveState) handleNode(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := r.URL.Query()
+	id, _ := strconv.Atoi(q.Get("id"))
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	v, err := s.value(id)
+	if err != nil {
+		serveJSON(w, nil, err)
+		return
+	}
+	serveJSON(w, s.nodeResult(v, offset, 0), nil)
+}
+
+func (s *serveState) handleHex(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := r.URL.Query()
+	id, _ := strconv.Atoi(q.Get("buffer"))
+	start, _ := strconv.ParseInt(q.Get("start"), 10, 64)
+	n, _ := strconv.ParseInt(q.Get("len"), 10, 64)
+	v, err := s.value(id)
+	if err != nil {
+		serveJSON(w, nil, err)
+		return
+	}
+	res, err := s.hexResult(v, start, n)
+	serveJSON(w, res, err)
+}
+
+func (s *serveState) handleQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		Root int    `json:"root"`
+		Expr string `json:"expr"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		serveJSON(w, nil, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, err := s.value(req.Root)
+	if err != nil {
+		serveJSON(w, nil, err)
+		return
+	}
+	res, err := s.query(r.Context(), makeDecodeValue(v, decodeValueValue), req.Expr)
+	serveJSON(w, res, err)
+}
+
+func serveListenAddr(addr string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	// only listen on all interfaces if explicitly asked for as the query
+	// box can be used to evaluate any expression, ex: read files
+	if host == "" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// _serve serves a web UI for input decode values until interrupted
+func (i *Interp) _serve(c any, addr string, v any) gojq.Iter {
+	s, err := newServeState(i, c, v)
+	if err != nil {
+		return gojq.NewIter(err)
+	}
+	listenAddr, err := serveListenAddr(addr)
+	if err != nil {
+		return gojq.NewIter(fmt.Errorf("serve: %w", err))
+	}
+
+	if len(s.roots) == 0 {
+		return gojq.NewIter(fmt.Errorf("serve: no decode values to serve"))
+	}
+
+	if s.token, err = newServeToken(); err != nil {
+		return gojq.NewIter(fmt.Errorf("serve: %w", err))
+	}
+
+	l, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return gojq.NewIter(fmt.Errorf("serve: %w", err))
+	}
+	if err := s.setAddr(listenAddr, l.Addr().String()); err != nil {
+		return gojq.NewIter(fmt.Errorf("serve: %w", err))
+	}
+
+	// interrupt stops the server but not the eval calling _serve
+	ctx, cancelFn := i.interruptStack.Push(i.EvalInstance.Ctx)
+	defer cancelFn()
+	srv := &http.Server{
+		Handler:     s.handler(),
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	fmt.Fprintf(i.OS.Stderr(), "Serving on http://%s/?token=%s\n", l.Addr(), s.token)
+	if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return gojq.NewIter(fmt.Errorf("serve: %w", err))
+	}
+
+	return gojq.NewIter()
+}
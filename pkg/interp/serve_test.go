@@ -0,0 +1,110 @@
+package interp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wader/fq/pkg/decode"
+)
+
+func TestServeHandlerChecks(t *testing.T) {
+	s := &serveState{
+		opts:  &Options{},
+		ids:   map[*decode.Value]int{},
+		token: "secret",
+	}
+	if err := s.setAddr("localhost:8080", "127.0.0.1:8080"); err != nil {
+		t.Fatal(err)
+	}
+	h := s.handler()
+
+	queryBody := `{"root": 0, "expr": "env.HOME"}`
+
+	testCases := []struct {
+		name        string
+		method      string
+		path        string
+		host        string
+		token       string
+		contentType string
+		origin      string
+		body        string
+		expected    int
+	}{
+		{name: "html", method: "GET", path: "/", host: "localhost:8080", expected: http.StatusOK},
+		{name: "html rebinding", method: "GET", path: "/", host: "evil.example:8080", expected: http.StatusForbidden},
+		{name: "roots", method: "GET", path: "/api/roots", host: "127.0.0.1:8080", token: "secret", expected: http.StatusOK},
+		{name: "roots no token", method: "GET", path: "/api/roots", host: "localhost:8080", expected: http.StatusForbidden},
+		{name: "roots wrong token", method: "GET", path: "/api/roots", host: "localhost:8080", token: "wrong", expected: http.StatusForbidden},
+		{name: "roots rebinding", method: "GET", path: "/api/roots", host: "evil.example", token: "secret", expected: http.StatusForbidden},
+		{name: "node no token", method: "GET", path: "/api/node?id=0", host: "localhost:8080", expected: http.StatusForbidden},
+		{name: "hex no token", method: "GET", path: "/api/hex?buffer=0", host: "localhost:8080", expected: http.StatusForbidden},
+		{
+			name: "query cross origin simple request", method: "POST", path: "/api/query", host: "localhost:8080",
+			contentType: "text/plain", origin: "http://evil.example", body: queryBody,
+			expected: http.StatusForbidden,
+		},
+		{
+			name: "query cross origin text/plain with token", method: "POST", path: "/api/query", host: "localhost:8080", token: "secret",
+			contentType: "text/plain", origin: "http://localhost:8080", body: queryBody,
+			expected: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "query cross origin", method: "POST", path: "/api/query", host: "localhost:8080", token: "secret",
+			contentType: "application/json", origin: "http://evil.example", body: queryBody,
+			expected: http.StatusForbidden,
+		},
+		{
+			name: "query no origin", method: "POST", path: "/api/query", host: "localhost:8080", token: "secret",
+			contentType: "application/json", body: queryBody,
+			expected: http.StatusForbidden,
+		},
+		{
+			// passes checks, fails as there are no values
+			name: "query same origin", method: "POST", path: "/api/query", host: "localhost:8080", token: "secret",
+			contentType: "application/json; charset=utf-8", origin: "http://localhost:8080", body: queryBody,
+			expected: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			r.Host = tc.host
+			if tc.token != "" {
+				r.Header.Set(serveTokenHeader, tc.token)
+			}
+			if tc.contentType != "" {
+				r.Header.Set("Content-Type", tc.contentType)
+			}
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tc.expected {
+				t.Errorf("expected status %d got %d: %s", tc.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestServeAllowedHost(t *testing.T) {
+	s := &serveState{}
+	if err := s.setAddr("0.0.0.0:8080", "[::]:8080"); err != nil {
+		t.Fatal(err)
+	}
+	for h, expected := range map[string]bool{
+		"0.0.0.0:8080":      true,
+		"192.168.1.2:8080":  true,
+		"[::1]:8080":        true,
+		"192.168.1.2:8081":  false,
+		"evil.example:8080": false,
+		"192.168.1.2":       false,
+	} {
+		if actual := s.allowedHost(h); actual != expected {
+			t.Errorf("%s: expected %v got %v", h, expected, actual)
+		}
+	}
+}
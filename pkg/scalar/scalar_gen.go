@@ -30,6 +30,42 @@ func (s Any) ScalarDescription() string          { return s.Description }
 func (s Any) ScalarFlags() Flags                 { return s.Flags }
 func (s Any) ScalarDisplayFormat() DisplayFormat { return 0 }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Any) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 {
+		return AnyCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// AnyCompact is a Any with only an actual value, uses less memory when stored as a Scalarable
+type AnyCompact struct {
+	Actual any
+}
+
+// interp.Scalarable
+func (s AnyCompact) ScalarActual() any                  { return s.Actual }
+func (s AnyCompact) ScalarValue() any                   { return s.Actual }
+func (s AnyCompact) ScalarSym() any                     { return nil }
+func (s AnyCompact) ScalarDescription() string          { return "" }
+func (s AnyCompact) ScalarFlags() Flags                 { return 0 }
+func (s AnyCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Any returns s as a Any
+func (s AnyCompact) Any() Any { return Any{Actual: s.Actual} }
+
+// AsAny returns scalar as a Any if it's a Any or AnyCompact
+func AsAny(v any) (Any, bool) {
+	switch v := v.(type) {
+	case *Any:
+		return *v, true
+	case AnyCompact:
+		return v.Any(), true
+	default:
+		return Any{}, false
+	}
+}
+
 func AnyActual(v any) AnyMapper {
 	return AnyFn(func(s Any) (Any, error) { s.Actual = v; return s, nil })
 }
@@ -227,6 +263,42 @@ func (s BigInt) ScalarDescription() string          { return s.Description }
 func (s BigInt) ScalarFlags() Flags                 { return s.Flags }
 func (s BigInt) ScalarDisplayFormat() DisplayFormat { return s.DisplayFormat }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s BigInt) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 && s.DisplayFormat == 0 {
+		return BigIntCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// BigIntCompact is a BigInt with only an actual value, uses less memory when stored as a Scalarable
+type BigIntCompact struct {
+	Actual *big.Int
+}
+
+// interp.Scalarable
+func (s BigIntCompact) ScalarActual() any                  { return s.Actual }
+func (s BigIntCompact) ScalarValue() any                   { return s.Actual }
+func (s BigIntCompact) ScalarSym() any                     { return nil }
+func (s BigIntCompact) ScalarDescription() string          { return "" }
+func (s BigIntCompact) ScalarFlags() Flags                 { return 0 }
+func (s BigIntCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// BigInt returns s as a BigInt
+func (s BigIntCompact) BigInt() BigInt { return BigInt{Actual: s.Actual} }
+
+// AsBigInt returns scalar as a BigInt if it's a BigInt or BigIntCompact
+func AsBigInt(v any) (BigInt, bool) {
+	switch v := v.(type) {
+	case *BigInt:
+		return *v, true
+	case BigIntCompact:
+		return v.BigInt(), true
+	default:
+		return BigInt{}, false
+	}
+}
+
 func BigIntActual(v *big.Int) BigIntMapper {
 	return BigIntFn(func(s BigInt) (BigInt, error) { s.Actual = v; return s, nil })
 }
@@ -423,6 +495,42 @@ func (s BitBuf) ScalarDescription() string          { return s.Description }
 func (s BitBuf) ScalarFlags() Flags                 { return s.Flags }
 func (s BitBuf) ScalarDisplayFormat() DisplayFormat { return 0 }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s BitBuf) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 {
+		return BitBufCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// BitBufCompact is a BitBuf with only an actual value, uses less memory when stored as a Scalarable
+type BitBufCompact struct {
+	Actual bitio.ReaderAtSeeker
+}
+
+// interp.Scalarable
+func (s BitBufCompact) ScalarActual() any                  { return s.Actual }
+func (s BitBufCompact) ScalarValue() any                   { return s.Actual }
+func (s BitBufCompact) ScalarSym() any                     { return nil }
+func (s BitBufCompact) ScalarDescription() string          { return "" }
+func (s BitBufCompact) ScalarFlags() Flags                 { return 0 }
+func (s BitBufCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// BitBuf returns s as a BitBuf
+func (s BitBufCompact) BitBuf() BitBuf { return BitBuf{Actual: s.Actual} }
+
+// AsBitBuf returns scalar as a BitBuf if it's a BitBuf or BitBufCompact
+func AsBitBuf(v any) (BitBuf, bool) {
+	switch v := v.(type) {
+	case *BitBuf:
+		return *v, true
+	case BitBufCompact:
+		return v.BitBuf(), true
+	default:
+		return BitBuf{}, false
+	}
+}
+
 func BitBufActual(v bitio.ReaderAtSeeker) BitBufMapper {
 	return BitBufFn(func(s BitBuf) (BitBuf, error) { s.Actual = v; return s, nil })
 }
@@ -619,6 +727,42 @@ func (s Bool) ScalarDescription() string          { return s.Description }
 func (s Bool) ScalarFlags() Flags                 { return s.Flags }
 func (s Bool) ScalarDisplayFormat() DisplayFormat { return 0 }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Bool) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 {
+		return BoolCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// BoolCompact is a Bool with only an actual value, uses less memory when stored as a Scalarable
+type BoolCompact struct {
+	Actual bool
+}
+
+// interp.Scalarable
+func (s BoolCompact) ScalarActual() any                  { return s.Actual }
+func (s BoolCompact) ScalarValue() any                   { return s.Actual }
+func (s BoolCompact) ScalarSym() any                     { return nil }
+func (s BoolCompact) ScalarDescription() string          { return "" }
+func (s BoolCompact) ScalarFlags() Flags                 { return 0 }
+func (s BoolCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Bool returns s as a Bool
+func (s BoolCompact) Bool() Bool { return Bool{Actual: s.Actual} }
+
+// AsBool returns scalar as a Bool if it's a Bool or BoolCompact
+func AsBool(v any) (Bool, bool) {
+	switch v := v.(type) {
+	case *Bool:
+		return *v, true
+	case BoolCompact:
+		return v.Bool(), true
+	default:
+		return Bool{}, false
+	}
+}
+
 func BoolActual(v bool) BoolMapper {
 	return BoolFn(func(s Bool) (Bool, error) { s.Actual = v; return s, nil })
 }
@@ -815,6 +959,42 @@ func (s Flt) ScalarDescription() string          { return s.Description }
 func (s Flt) ScalarFlags() Flags                 { return s.Flags }
 func (s Flt) ScalarDisplayFormat() DisplayFormat { return 0 }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Flt) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 {
+		return FltCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// FltCompact is a Flt with only an actual value, uses less memory when stored as a Scalarable
+type FltCompact struct {
+	Actual float64
+}
+
+// interp.Scalarable
+func (s FltCompact) ScalarActual() any                  { return s.Actual }
+func (s FltCompact) ScalarValue() any                   { return s.Actual }
+func (s FltCompact) ScalarSym() any                     { return nil }
+func (s FltCompact) ScalarDescription() string          { return "" }
+func (s FltCompact) ScalarFlags() Flags                 { return 0 }
+func (s FltCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Flt returns s as a Flt
+func (s FltCompact) Flt() Flt { return Flt{Actual: s.Actual} }
+
+// AsFlt returns scalar as a Flt if it's a Flt or FltCompact
+func AsFlt(v any) (Flt, bool) {
+	switch v := v.(type) {
+	case *Flt:
+		return *v, true
+	case FltCompact:
+		return v.Flt(), true
+	default:
+		return Flt{}, false
+	}
+}
+
 func FltActual(v float64) FltMapper {
 	return FltFn(func(s Flt) (Flt, error) { s.Actual = v; return s, nil })
 }
@@ -1012,6 +1192,42 @@ func (s Sint) ScalarDescription() string          { return s.Description }
 func (s Sint) ScalarFlags() Flags                 { return s.Flags }
 func (s Sint) ScalarDisplayFormat() DisplayFormat { return s.DisplayFormat }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Sint) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 && s.DisplayFormat == 0 {
+		return SintCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// SintCompact is a Sint with only an actual value, uses less memory when stored as a Scalarable
+type SintCompact struct {
+	Actual int64
+}
+
+// interp.Scalarable
+func (s SintCompact) ScalarActual() any                  { return s.Actual }
+func (s SintCompact) ScalarValue() any                   { return s.Actual }
+func (s SintCompact) ScalarSym() any                     { return nil }
+func (s SintCompact) ScalarDescription() string          { return "" }
+func (s SintCompact) ScalarFlags() Flags                 { return 0 }
+func (s SintCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Sint returns s as a Sint
+func (s SintCompact) Sint() Sint { return Sint{Actual: s.Actual} }
+
+// AsSint returns scalar as a Sint if it's a Sint or SintCompact
+func AsSint(v any) (Sint, bool) {
+	switch v := v.(type) {
+	case *Sint:
+		return *v, true
+	case SintCompact:
+		return v.Sint(), true
+	default:
+		return Sint{}, false
+	}
+}
+
 func SintActual(v int64) SintMapper {
 	return SintFn(func(s Sint) (Sint, error) { s.Actual = v; return s, nil })
 }
@@ -1208,6 +1424,42 @@ func (s Str) ScalarDescription() string          { return s.Description }
 func (s Str) ScalarFlags() Flags                 { return s.Flags }
 func (s Str) ScalarDisplayFormat() DisplayFormat { return 0 }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Str) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 {
+		return StrCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// StrCompact is a Str with only an actual value, uses less memory when stored as a Scalarable
+type StrCompact struct {
+	Actual string
+}
+
+// interp.Scalarable
+func (s StrCompact) ScalarActual() any                  { return s.Actual }
+func (s StrCompact) ScalarValue() any                   { return s.Actual }
+func (s StrCompact) ScalarSym() any                     { return nil }
+func (s StrCompact) ScalarDescription() string          { return "" }
+func (s StrCompact) ScalarFlags() Flags                 { return 0 }
+func (s StrCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Str returns s as a Str
+func (s StrCompact) Str() Str { return Str{Actual: s.Actual} }
+
+// AsStr returns scalar as a Str if it's a Str or StrCompact
+func AsStr(v any) (Str, bool) {
+	switch v := v.(type) {
+	case *Str:
+		return *v, true
+	case StrCompact:
+		return v.Str(), true
+	default:
+		return Str{}, false
+	}
+}
+
 func StrActual(v string) StrMapper {
 	return StrFn(func(s Str) (Str, error) { s.Actual = v; return s, nil })
 }
@@ -1405,6 +1657,42 @@ func (s Uint) ScalarDescription() string          { return s.Description }
 func (s Uint) ScalarFlags() Flags                 { return s.Flags }
 func (s Uint) ScalarDisplayFormat() DisplayFormat { return s.DisplayFormat }
 
+// Compact returns a compact scalar if s only has an actual value, otherwise a pointer to a copy of s
+func (s Uint) Compact() Scalarable {
+	if s.Sym == nil && s.Description == "" && s.Flags == 0 && s.DisplayFormat == 0 {
+		return UintCompact{Actual: s.Actual}
+	}
+	return &s
+}
+
+// UintCompact is a Uint with only an actual value, uses less memory when stored as a Scalarable
+type UintCompact struct {
+	Actual uint64
+}
+
+// interp.Scalarable
+func (s UintCompact) ScalarActual() any                  { return s.Actual }
+func (s UintCompact) ScalarValue() any                   { return s.Actual }
+func (s UintCompact) ScalarSym() any                     { return nil }
+func (s UintCompact) ScalarDescription() string          { return "" }
+func (s UintCompact) ScalarFlags() Flags                 { return 0 }
+func (s UintCompact) ScalarDisplayFormat() DisplayFormat { return 0 }
+
+// Uint returns s as a Uint
+func (s UintCompact) Uint() Uint { return Uint{Actual: s.Actual} }
+
+// AsUint returns scalar as a Uint if it's a Uint or UintCompact
+func AsUint(v any) (Uint, bool) {
+	switch v := v.(type) {
+	case *Uint:
+		return *v, true
+	case UintCompact:
+		return v.Uint(), true
+	default:
+		return Uint{}, false
+	}
+}
+
 func UintActual(v uint64) UintMapper {
 	return UintFn(func(s Uint) (Uint, error) { s.Actual = v; return s, nil })
 }